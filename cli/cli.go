@@ -16,13 +16,16 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	mydisBase "github.com/deejross/mydis"
 	mydis "github.com/deejross/mydis/client"
@@ -36,11 +39,21 @@ var help = map[string][]string{
 	"KEYSWITHPREFIX":  []string{"KEYSWITHPREFIX key", "Get a list of keys with the given prefix"},
 	"HAS":             []string{"HAS key", "Checks if the cache has the given key"},
 	"SETEXPIRE":       []string{"SETEXPIRE key duration", "Sets the expiration on a key"},
+	"SETEXPIREMILLIS": []string{"SETEXPIREMILLIS key milliseconds", "Sets the expiration on a key in milliseconds"},
+	"EXPIREAT":        []string{"EXPIREAT key unixtime", "Sets the expiration on a key to a Unix time in seconds"},
+	"EXPIREATMILLIS":  []string{"EXPIREATMILLIS key unixtime", "Sets the expiration on a key to a Unix time in milliseconds"},
+	"TTL":             []string{"TTL key", "Get the number of seconds until a key expires, -1 if it doesn't expire"},
+	"TTLMILLIS":       []string{"TTLMILLIS key", "Get the number of milliseconds until a key expires, -1 if it doesn't expire"},
+	"PERSIST":         []string{"PERSIST key", "Remove the expiration from a key"},
 	"DELETE":          []string{"DELETE key", "Delete a key from the cache"},
 	"CLEAR":           []string{"CLEAR", "Clear the cache"},
 	"GET":             []string{"GET key", "Get a string from the cache"},
 	"SET":             []string{"SET key value", "Set a string in the cache"},
+	"SETWITHEXPIRE":   []string{"SETWITHEXPIRE key value duration", "Set a string in the cache that expires after the number of seconds"},
 	"SETNX":           []string{"SETNX key value", "Set a string in the cache only if the key doesn't already exist"},
+	"GETREV":          []string{"GETREV key", "Get a string from the cache and the revision it was last modified at"},
+	"CAS":             []string{"CAS key expected value", "Set a string in the cache only if the current value is the expected value"},
+	"SETIFREV":        []string{"SETIFREV key revision value", "Set a string in the cache only if the key was last modified at the revision"},
 	"SETINT":          []string{"SETINT key int", "Set an integeer in the cache"},
 	"SETFLOAT":        []string{"SETFLOAT key float", "Set a float in the cache"},
 	"INCREMENTINT":    []string{"INCREMENTINT key by", "Increment an integer by the given number and return the result"},
@@ -66,12 +79,44 @@ var help = map[string][]string{
 	"HASHVALUES":      []string{"HASHVALUES key", "Get a list of the values in a hash"},
 	"SETHASHFIELD":    []string{"SETHASHFIELD key field value", "Set a single value in a hash"},
 	"DELHASHFIELD":    []string{"DELHASHFIELD key field", "Delete a field from a hash"},
+	"TSADD":           []string{"TSADD key value", "Add a sample with the current time to a time series"},
+	"TSRANGE":         []string{"TSRANGE key fromUnix toUnix [aggregation bucketSeconds]", "Get samples in a time range, aggregation can be one of: AVG, MIN, MAX, SUM, COUNT"},
+	"JSONGET":         []string{"JSONGET key [path]", "Get the JSON value at a path in a JSON document, such as $.a.b[0]"},
+	"JSONSET":         []string{"JSONSET key path json", "Set the JSON value at a path in a JSON document, use $ as the path to create a document"},
+	"JSONDEL":         []string{"JSONDEL key path", "Delete the value at a path in a JSON document"},
+	"JSONARRAPPEND":   []string{"JSONARRAPPEND key path json", "Append a JSON value to an array in a JSON document, returns the new length"},
+	"JSONNUMINCRBY":   []string{"JSONNUMINCRBY key path value", "Increment a number in a JSON document, returns the new value"},
+	"VADD":            []string{"VADD key id v1,v2,... [hashKey]", "Add a vector to a vector index, optionally attaching a hash used for filtering"},
+	"VSEARCH":         []string{"VSEARCH key k v1,v2,... [field=value ...]", "Get the k nearest neighbours of a vector, only matching vectors whose hash has the given field values"},
+	"VDELETE":         []string{"VDELETE key id", "Delete a vector from a vector index"},
+	"SEARCHINDEX":     []string{"SEARCHINDEX key prefix field [field ...]", "Create a full-text search index over the given fields of the hashes with the given prefix"},
+	"SEARCH":          []string{"SEARCH key query", "Search an index for words, \"phrases\", and prefixes ending with *"},
+	"FIELDINDEX":      []string{"FIELDINDEX prefix field [NUMERIC]", "Create an index on a field of the hashes with the given prefix"},
+	"FINDBYFIELD":     []string{"FINDBYFIELD prefix field value", "Get the keys of the hashes with the given prefix where the field has the given value"},
+	"FINDBYRANGE":     []string{"FINDBYRANGE prefix field min max", "Get the keys of the hashes with the given prefix where the numeric field is within the given range"},
 	"LOCK":            []string{"LOCK key", "Lock a key"},
 	"LOCKWITHTIMEOUT": []string{"LOCKWITHTIMEOUT key seconds", "Lock a key with custom timeout"},
 	"UNLOCK":          []string{"UNLOCK key", "Unlock a key"},
 	"SETLOCKTIMEOUT":  []string{"SETLOCKTIMEOUT seconds", "Set the default lock timeout"},
-	"WATCH":           []string{"WATCH key", "Watch for changes to a key"},
+	"LOCKS":           []string{"LOCKS [prefix]", "List the locks held on keys with the given prefix"},
+	"FORCEUNLOCK":     []string{"FORCEUNLOCK key", "Release the lock on a key no matter who holds it"},
+	"GRANTLEASE":      []string{"GRANTLEASE name duration", "Create a named lease that expires after the number of seconds unless kept alive"},
+	"REVOKELEASE":     []string{"REVOKELEASE name", "Revoke a named lease, deleting every key attached to it"},
+	"GETLEASE":        []string{"GETLEASE name", "Get the time left on a named lease and the keys attached to it"},
+	"KEEPALIVE":       []string{"KEEPALIVE name", "Refresh a named lease"},
+	"SETLEASE":        []string{"SETLEASE [name]", "Attach keys written after this to a named lease, or stop attaching them if no name is given"},
+	"EVAL":            []string{"EVAL file numkeys [key...] [arg...]", "Run the script in a file atomically, with the first numkeys arguments as KEYS and the rest as ARGV"},
+	"EVALSHA":         []string{"EVALSHA sha numkeys [key...] [arg...]", "Run a loaded script by its SHA1 hash"},
+	"SCRIPTLOAD":      []string{"SCRIPTLOAD file", "Load the script in a file without running it, returning its SHA1 hash"},
+	"COMMAND":         []string{"COMMAND name [arg...]", "Run a custom command registered on the server"},
+	"WATCH":           []string{"WATCH key [reason...]", "Watch for changes to a key, optionally only for the given reasons: WRITTEN, DELETED, EXPIRED, EVICTED, LOCK_RELEASED"},
 	"UNWATCH":         []string{"UNWATCH key", "Unwatch for changes to a key"},
+	"PUBLISH":         []string{"PUBLISH channel message", "Send a message to the subscribers of a channel"},
+	"SUBSCRIBE":       []string{"SUBSCRIBE channel [channel...]", "Subscribe to messages published to channels"},
+	"PSUBSCRIBE":      []string{"PSUBSCRIBE pattern [pattern...]", "Subscribe to messages published to channels matching glob patterns"},
+	"UNSUBSCRIBE":     []string{"UNSUBSCRIBE channel [channel...]", "Unsubscribe from channels"},
+	"PUNSUBSCRIBE":    []string{"PUNSUBSCRIBE pattern [pattern...]", "Unsubscribe from glob patterns"},
+	"PUBSUBCHANNELS":  []string{"PUBSUBCHANNELS [pattern]", "List the channels with subscribers, optionally only those matching a glob pattern"},
 	"AUTHENABLE":      []string{"AUTHENABLE", "Enable authentication"},
 	"AUTHDISABLE":     []string{"AUTHDISABLE", "Disable authentication"},
 	"AUTHENTICATE":    []string{"AUTHENTICATE username password", "Authenticate a user"},
@@ -91,6 +136,7 @@ var help = map[string][]string{
 }
 
 var closeCh = make(chan struct{})
+var channelSub *mydis.ChannelSubscription
 
 func main() {
 	argLen := len(os.Args)
@@ -185,6 +231,63 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			return client.SetExpire(args[0], d)
 		}
 		return errNotEnoughArgs
+	} else if cmd == "SETEXPIREMILLIS" {
+		if len(args) >= 2 {
+			d, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			return client.SetExpireMillis(args[0], d)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "EXPIREAT" {
+		if len(args) >= 2 {
+			t, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			return client.ExpireAt(args[0], t)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "EXPIREATMILLIS" {
+		if len(args) >= 2 {
+			t, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			return client.ExpireAtMillis(args[0], t)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "TTL" {
+		if len(args) >= 1 {
+			result, err := client.TTL(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(result)
+			return err
+		}
+		return errNotEnoughArgs
+	} else if cmd == "TTLMILLIS" {
+		if len(args) >= 1 {
+			result, err := client.TTLMillis(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(result)
+			return err
+		}
+		return errNotEnoughArgs
+	} else if cmd == "PERSIST" {
+		if len(args) >= 1 {
+			result, err := client.Persist(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(result)
+			return err
+		}
+		return errNotEnoughArgs
 	} else if cmd == "DELETE" {
 		if len(args) >= 1 {
 			return client.Delete(args[0])
@@ -217,6 +320,15 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			return client.Set(args[0], args[1])
 		}
 		return errNotEnoughArgs
+	} else if cmd == "SETWITHEXPIRE" {
+		if len(args) >= 3 {
+			d, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			return client.SetWithExpire(args[0], args[1], d)
+		}
+		return errNotEnoughArgs
 	} else if cmd == "SETNX" {
 		if len(args) >= 2 {
 			b, err := client.SetNX(args[0], args[1])
@@ -226,6 +338,44 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			fmt.Println(b)
 		}
 		return errNotEnoughArgs
+	} else if cmd == "GETREV" {
+		if len(args) >= 1 {
+			v, rev := client.GetWithRevision(args[0])
+			result, err := v.String()
+			if err != nil {
+				return err
+			}
+			fmt.Println(result)
+			fmt.Println("Revision:", rev)
+			return err
+		}
+		return errNotEnoughArgs
+	} else if cmd == "CAS" {
+		if len(args) >= 3 {
+			b, rev, err := client.CompareAndSwap(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			fmt.Println(b)
+			fmt.Println("Revision:", rev)
+			return err
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SETIFREV" {
+		if len(args) >= 3 {
+			rev, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			b, rev, err := client.SetIfModRevision(args[0], rev, args[2])
+			if err != nil {
+				return err
+			}
+			fmt.Println(b)
+			fmt.Println("Revision:", rev)
+			return err
+		}
+		return errNotEnoughArgs
 	} else if cmd == "SETINT" {
 		if len(args) >= 2 {
 			i, err := strconv.ParseInt(args[1], 10, 64)
@@ -483,6 +633,195 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			return client.DelHashField(args[0], args[1])
 		}
 		return errNotEnoughArgs
+	} else if cmd == "TSADD" {
+		if len(args) >= 2 {
+			f, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return err
+			}
+			return client.TimeSeriesAdd(args[0], time.Now(), f)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "TSRANGE" {
+		if len(args) >= 3 {
+			from, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			to, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			agg := pb.Aggregation_NONE
+			bucket := int64(0)
+			if len(args) >= 4 {
+				a, ok := pb.Aggregation_value[strings.ToUpper(args[3])]
+				if !ok {
+					return errors.New("Unrecognized aggregation: " + args[3])
+				}
+				agg = pb.Aggregation(a)
+			}
+			if len(args) >= 5 {
+				bucket, err = strconv.ParseInt(args[4], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			samples, err := client.TimeSeriesRange(args[0], time.Unix(from, 0), time.Unix(to, 0), agg, time.Duration(bucket)*time.Second)
+			if err != nil {
+				return err
+			}
+			displaySamples(samples)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "JSONGET" {
+		if len(args) >= 1 {
+			path := ""
+			if len(args) >= 2 {
+				path = args[1]
+			}
+			s, err := client.JSONGet(args[0], path).String()
+			if err != nil {
+				return err
+			}
+			fmt.Println(s)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "JSONSET" {
+		if len(args) >= 3 {
+			return client.JSONSet(args[0], args[1], json.RawMessage(strings.Join(args[2:], " ")))
+		}
+		return errNotEnoughArgs
+	} else if cmd == "JSONDEL" {
+		if len(args) >= 2 {
+			return client.JSONDel(args[0], args[1])
+		}
+		return errNotEnoughArgs
+	} else if cmd == "JSONARRAPPEND" {
+		if len(args) >= 3 {
+			i, err := client.JSONArrAppend(args[0], args[1], json.RawMessage(strings.Join(args[2:], " ")))
+			if err != nil {
+				return err
+			}
+			fmt.Println(i)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "JSONNUMINCRBY" {
+		if len(args) >= 3 {
+			by, err := strconv.ParseFloat(args[2], 64)
+			if err != nil {
+				return err
+			}
+			f, err := client.JSONNumIncrBy(args[0], args[1], by)
+			if err != nil {
+				return err
+			}
+			fmt.Println(f)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "VADD" {
+		if len(args) >= 3 {
+			vector, err := parseVector(args[2])
+			if err != nil {
+				return err
+			}
+			hashKey := ""
+			if len(args) >= 4 {
+				hashKey = args[3]
+			}
+			return client.VectorAdd(args[0], args[1], vector, hashKey)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "VSEARCH" {
+		if len(args) >= 3 {
+			k, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			}
+			vector, err := parseVector(args[2])
+			if err != nil {
+				return err
+			}
+			filter := map[string]util.Value{}
+			for _, arg := range args[3:] {
+				parts := strings.SplitN(arg, "=", 2)
+				if len(parts) != 2 {
+					return errors.New("Filters must be in the form field=value")
+				}
+				filter[parts[0]] = util.NewValue(parts[1])
+			}
+
+			matches, err := client.VectorSearch(args[0], vector, k, filter)
+			if err != nil {
+				return err
+			}
+			displayVectorMatches(matches)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "VDELETE" {
+		if len(args) >= 2 {
+			return client.VectorDelete(args[0], args[1])
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SEARCHINDEX" {
+		if len(args) >= 3 {
+			return client.SearchIndexCreate(args[0], args[1], args[2:]...)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SEARCH" {
+		if len(args) >= 2 {
+			results, total, err := client.Search(args[0], strings.Join(args[1:], " "), 0, 0)
+			if err != nil {
+				return err
+			}
+			for _, res := range results {
+				fmt.Println(res.Key+":", res.Score)
+			}
+			fmt.Println("Total:", total)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "FIELDINDEX" {
+		if len(args) >= 2 {
+			numeric := len(args) >= 3 && strings.ToUpper(args[2]) == "NUMERIC"
+			return client.FieldIndexCreate(args[0], args[1], numeric)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "FINDBYFIELD" {
+		if len(args) >= 3 {
+			lst, err := client.FindByField(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			displayList(lst)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "FINDBYRANGE" {
+		if len(args) >= 4 {
+			min, err := strconv.ParseFloat(args[2], 64)
+			if err != nil {
+				return err
+			}
+			max, err := strconv.ParseFloat(args[3], 64)
+			if err != nil {
+				return err
+			}
+			lst, err := client.FindByFieldRange(args[0], args[1], min, max)
+			if err != nil {
+				return err
+			}
+			displayList(lst)
+			return nil
+		}
+		return errNotEnoughArgs
 	} else if cmd == "SETLOCKTIMEOUT" {
 		if len(args) >= 1 {
 			d, err := strconv.ParseInt(args[0], 10, 64)
@@ -493,9 +832,130 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			return nil
 		}
 		return errNotEnoughArgs
+	} else if cmd == "LOCKS" {
+		prefix := ""
+		if len(args) >= 1 {
+			prefix = args[0]
+		}
+		result, err := client.ListLocks(prefix)
+		if err != nil {
+			return err
+		}
+		displayLocks(result)
+		return nil
+	} else if cmd == "FORCEUNLOCK" {
+		if len(args) >= 1 {
+			return client.ForceUnlock(args[0])
+		}
+		return errNotEnoughArgs
+	} else if cmd == "GRANTLEASE" {
+		if len(args) >= 2 {
+			d, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			return client.GrantLease(args[0], d)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "REVOKELEASE" {
+		if len(args) >= 1 {
+			return client.RevokeLease(args[0])
+		}
+		return errNotEnoughArgs
+	} else if cmd == "GETLEASE" {
+		if len(args) >= 1 {
+			result, err := client.GetLease(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("ttl=%ds granted=%ds\n", result.Ttl, result.GrantedTTL)
+			displayList(result.Keys)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "KEEPALIVE" {
+		if len(args) >= 1 {
+			stop, err := client.KeepAlive(args[0], time.Minute)
+			if err != nil {
+				return err
+			}
+			stop()
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SETLEASE" {
+		name := ""
+		if len(args) >= 1 {
+			name = args[0]
+		}
+		client.SetLease(name)
+		return nil
+	} else if cmd == "EVAL" || cmd == "EVALSHA" {
+		if len(args) >= 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil {
+				return err
+			} else if n < 0 || len(args) < 2+n {
+				return errNotEnoughArgs
+			}
+			keys, scriptArgs := args[2:2+n], args[2+n:]
+
+			var result util.Value
+			if cmd == "EVALSHA" {
+				result = client.EvalSHA(args[0], keys, scriptArgs...)
+			} else {
+				script, err := ioutil.ReadFile(args[0])
+				if err != nil {
+					return err
+				}
+				result = client.Eval(string(script), keys, scriptArgs...)
+			}
+			if err := result.Error(); err != nil {
+				return err
+			}
+			fmt.Println(util.BytesToString(result.RawBytes()))
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SCRIPTLOAD" {
+		if len(args) >= 1 {
+			script, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			sha, err := client.ScriptLoad(string(script))
+			if err != nil {
+				return err
+			}
+			fmt.Println(sha)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "COMMAND" {
+		if len(args) >= 1 {
+			cmdArgs := []interface{}{}
+			for _, arg := range args[1:] {
+				cmdArgs = append(cmdArgs, arg)
+			}
+			result := client.Command(args[0], cmdArgs...)
+			if err := result.Error(); err != nil {
+				return err
+			}
+			fmt.Println(util.BytesToString(result.RawBytes()))
+			return nil
+		}
+		return errNotEnoughArgs
 	} else if cmd == "WATCH" {
 		if len(args) >= 1 {
-			client.Watch(args[0], false)
+			reasons := []pb.Event_Reason{}
+			for _, arg := range args[1:] {
+				reason, ok := pb.Event_Reason_value[strings.ToUpper(arg)]
+				if !ok {
+					return errors.New("Unknown event reason: " + arg)
+				}
+				reasons = append(reasons, pb.Event_Reason(reason))
+			}
+			client.WatchReasons(args[0], false, reasons...)
 			return nil
 		}
 		return errNotEnoughArgs
@@ -505,6 +965,57 @@ func command(client *mydis.Client, cmd string, args []string) error {
 			return nil
 		}
 		return errNotEnoughArgs
+	} else if cmd == "PUBLISH" {
+		if len(args) >= 2 {
+			count, err := client.Publish(args[0], strings.Join(args[1:], " "))
+			if err != nil {
+				return err
+			}
+			fmt.Println(count)
+			return nil
+		}
+		return errNotEnoughArgs
+	} else if cmd == "SUBSCRIBE" || cmd == "PSUBSCRIBE" {
+		if len(args) >= 1 {
+			if channelSub == nil {
+				sub, err := client.SubscribeChannels()
+				if err != nil {
+					return err
+				}
+				channelSub = sub
+				startMessageHandler(sub)
+			}
+			if cmd == "PSUBSCRIBE" {
+				return channelSub.SubscribePatterns(args...)
+			}
+			return channelSub.Subscribe(args...)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "UNSUBSCRIBE" || cmd == "PUNSUBSCRIBE" {
+		if len(args) >= 1 {
+			if channelSub == nil {
+				return nil
+			}
+			if cmd == "PUNSUBSCRIBE" {
+				return channelSub.UnsubscribePatterns(args...)
+			}
+			return channelSub.Unsubscribe(args...)
+		}
+		return errNotEnoughArgs
+	} else if cmd == "PUBSUBCHANNELS" {
+		pattern := ""
+		if len(args) >= 1 {
+			pattern = args[0]
+		}
+		result, err := client.PubSubChannels(pattern)
+		if err != nil {
+			return err
+		}
+		for _, ch := range result.Channels {
+			fmt.Printf("%s subscribers=%d\n", ch.Channel, ch.Subscribers)
+		}
+		fmt.Printf("patterns=%d\n", result.Patterns)
+		return nil
 	} else if cmd == "AUTHENABLE" {
 		return client.AuthEnable()
 	} else if cmd == "AUTHDISABLE" {
@@ -710,6 +1221,62 @@ func displayMap(result map[string]util.Value) {
 	}
 }
 
+func displaySamples(result []*pb.Sample) {
+	if len(result) == 0 {
+		fmt.Println("")
+	}
+
+	for _, sample := range result {
+		t := time.Unix(0, sample.Timestamp*int64(time.Millisecond))
+		fmt.Println(t.Format(time.RFC3339)+":", sample.Value)
+	}
+}
+
+func displayVectorMatches(result []*pb.VectorMatch) {
+	if len(result) == 0 {
+		fmt.Println("")
+	}
+
+	for _, match := range result {
+		fmt.Println(match.Id+":", match.Score)
+	}
+}
+
+func displayLocks(result []*pb.LockInfo) {
+	if len(result) == 0 {
+		fmt.Println("")
+	}
+
+	for _, lock := range result {
+		owner := lock.Owner
+		if owner == "" {
+			owner = "(no owner)"
+		}
+		acquired := "unknown"
+		if lock.Acquired > 0 {
+			acquired = time.Unix(0, lock.Acquired*int64(time.Millisecond)).Format(time.RFC3339)
+		}
+		ttl := "none"
+		if lock.Ttl >= 0 {
+			ttl = strconv.FormatInt(lock.Ttl, 10) + "s"
+		}
+		fmt.Printf("%s: owner=%s count=%d acquired=%s lease=%s\n", lock.Key, owner, lock.Count, acquired, ttl)
+	}
+}
+
+func parseVector(s string) ([]float32, error) {
+	parts := strings.Split(s, ",")
+	vector := make([]float32, len(parts))
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(part, 32)
+		if err != nil {
+			return nil, err
+		}
+		vector[i] = float32(f)
+	}
+	return vector, nil
+}
+
 func displayPerms(result []*pb.Permission) {
 	if len(result) == 0 {
 		fmt.Println("")
@@ -744,12 +1311,30 @@ func startEventHandler(client *mydis.Client) {
 				return
 			case e := <-ch:
 				t := pb.Event_EventType_name[int32(e.Type)]
-				if len(e.Current.Value) > 0 {
-					fmt.Println("EVENT", t, e.Current.Key, util.BytesToString(e.Current.Value))
-				} else {
+				r := pb.Event_Reason_name[int32(e.Reason)]
+				if e.Type == pb.Event_COMPACTED {
 					fmt.Println("EVENT", t, e.Current.Key)
+				} else if len(e.Current.Value) > 0 {
+					fmt.Println("EVENT", t, r, e.Current.Key, util.BytesToString(e.Current.Value))
+				} else {
+					fmt.Println("EVENT", t, r, e.Current.Key)
 				}
 			}
 		}
 	}()
 }
+
+func startMessageHandler(sub *mydis.ChannelSubscription) {
+	go func() {
+		for msg := range sub.Messages() {
+			if len(msg.Pattern) > 0 {
+				fmt.Println("MESSAGE", msg.Pattern, msg.Channel, util.BytesToString(msg.Message))
+			} else {
+				fmt.Println("MESSAGE", msg.Channel, util.BytesToString(msg.Message))
+			}
+		}
+		if err := sub.Err(); err != nil {
+			writeErr(err)
+		}
+	}()
+}
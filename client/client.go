@@ -17,14 +17,15 @@ package client
 import (
 	"io/ioutil"
 	"log"
+	"math"
 	"sync"
 
-	"strings"
-
 	"strconv"
 
 	"crypto/tls"
 
+	"time"
+
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
 	"golang.org/x/net/context"
@@ -41,13 +42,38 @@ var (
 )
 
 var knownErrors = map[string]error{
-	util.ErrKeyNotFound.Error():         util.ErrKeyNotFound,
-	util.ErrKeyLocked.Error():           util.ErrKeyLocked,
-	util.ErrListEmpty.Error():           util.ErrListEmpty,
-	util.ErrListIndexOutOfRange.Error(): util.ErrListIndexOutOfRange,
-	util.ErrHashFieldNotFound.Error():   util.ErrHashFieldNotFound,
-	util.ErrTypeMismatch.Error():        util.ErrTypeMismatch,
-	util.ErrInvalidKey.Error():          util.ErrInvalidKey,
+	util.ErrKeyNotFound.Error():             util.ErrKeyNotFound,
+	util.ErrKeyLocked.Error():               util.ErrKeyLocked,
+	util.ErrListEmpty.Error():               util.ErrListEmpty,
+	util.ErrListIndexOutOfRange.Error():     util.ErrListIndexOutOfRange,
+	util.ErrHashFieldNotFound.Error():       util.ErrHashFieldNotFound,
+	util.ErrTypeMismatch.Error():            util.ErrTypeMismatch,
+	util.ErrInvalidKey.Error():              util.ErrInvalidKey,
+	util.ErrInvalidRetention.Error():        util.ErrInvalidRetention,
+	util.ErrInvalidDownsampleRule.Error():   util.ErrInvalidDownsampleRule,
+	util.ErrInvalidJSON.Error():             util.ErrInvalidJSON,
+	util.ErrInvalidJSONPath.Error():         util.ErrInvalidJSONPath,
+	util.ErrJSONPathNotFound.Error():        util.ErrJSONPathNotFound,
+	util.ErrInvalidVectorIndex.Error():      util.ErrInvalidVectorIndex,
+	util.ErrVectorDimensionMismatch.Error(): util.ErrVectorDimensionMismatch,
+	util.ErrVectorNotFound.Error():          util.ErrVectorNotFound,
+	util.ErrVectorIndexExists.Error():       util.ErrVectorIndexExists,
+	util.ErrInvalidSearchIndex.Error():      util.ErrInvalidSearchIndex,
+	util.ErrInvalidSearchQuery.Error():      util.ErrInvalidSearchQuery,
+	util.ErrInvalidFieldIndex.Error():       util.ErrInvalidFieldIndex,
+	util.ErrFieldIndexNotFound.Error():      util.ErrFieldIndexNotFound,
+	util.ErrInvalidTxn.Error():              util.ErrInvalidTxn,
+	util.ErrLockNotOwned.Error():            util.ErrLockNotOwned,
+	util.ErrInvalidExpiration.Error():       util.ErrInvalidExpiration,
+	util.ErrLeaseNotFound.Error():           util.ErrLeaseNotFound,
+	util.ErrLeaseExists.Error():             util.ErrLeaseExists,
+	util.ErrSlowConsumer.Error():            util.ErrSlowConsumer,
+	util.ErrInvalidChannel.Error():          util.ErrInvalidChannel,
+	util.ErrScriptNotFound.Error():          util.ErrScriptNotFound,
+	util.ErrScriptTimeout.Error():           util.ErrScriptTimeout,
+	util.ErrScriptTooManySteps.Error():      util.ErrScriptTooManySteps,
+	util.ErrCommandNotFound.Error():         util.ErrCommandNotFound,
+	util.ErrAuthNotSupported.Error():        util.ErrAuthNotSupported,
 }
 
 func normalizeError(err error) error {
@@ -105,11 +131,15 @@ func (sr *staticResolver) Close() {}
 
 // Config object.
 type Config struct {
-	Addresses    []string
-	TLS          *tls.Config
-	AutoTLS      bool
-	creds        *credentials.TransportCredentials
-	transportOpt grpc.DialOption
+	Addresses []string
+	TLS       *tls.Config
+	AutoTLS   bool
+	// WatchBufferSize is the number of events a subscription holds, 100 if not set.
+	WatchBufferSize int
+	// WatchOverflow is what a subscription does when its buffer is full, dropping the oldest event if not set.
+	WatchOverflow OverflowPolicy
+	creds         *credentials.TransportCredentials
+	transportOpt  grpc.DialOption
 }
 
 // NewClientConfig returns a new ClientConfig with default values.
@@ -143,8 +173,11 @@ type Client struct {
 	mc        pb.MydisClient
 	lock      sync.RWMutex
 	newID     int64
-	watching  map[string]struct{}
+	watching  map[string]*pb.WatchRequest
+	delivered map[string]map[string]struct{}
 	watchers  map[int64]chan *pb.Event
+	subs      map[int64]*Subscription
+	nextSub   int64
 }
 
 // NewClient returns a new Client object.
@@ -175,16 +208,18 @@ func NewClient(config Config) (*Client, error) {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	client := &Client{
-		cancel:   cancel,
-		config:   config,
-		ctx:      ctx,
-		closeCh:  make(chan struct{}),
-		reqCh:    make(chan *pb.WatchRequest),
-		resCh:    make(chan struct{}),
-		socket:   socket,
-		mc:       pb.NewMydisClient(socket),
-		watching: map[string]struct{}{},
-		watchers: map[int64]chan *pb.Event{},
+		cancel:    cancel,
+		config:    config,
+		ctx:       ctx,
+		closeCh:   make(chan struct{}),
+		reqCh:     make(chan *pb.WatchRequest),
+		resCh:     make(chan struct{}),
+		socket:    socket,
+		mc:        pb.NewMydisClient(socket),
+		watching:  map[string]*pb.WatchRequest{},
+		delivered: map[string]map[string]struct{}{},
+		watchers:  map[int64]chan *pb.Event{},
+		subs:      map[int64]*Subscription{},
 	}
 
 	go client.backgroundProcess()
@@ -238,14 +273,79 @@ func (c *Client) SetExpire(key string, seconds int64) error {
 	return err
 }
 
+// SetExpireMillis sets the expiration on a key in milliseconds.
+func (c *Client) SetExpireMillis(key string, milliseconds int64) error {
+	_, err := c.mc.SetExpireMillis(c.ctx, &pb.Expiration{Key: key, Exp: milliseconds})
+	err = normalizeError(err)
+	return err
+}
+
+// ExpireAt sets the expiration on a key to the given Unix time in seconds.
+func (c *Client) ExpireAt(key string, unixTime int64) error {
+	_, err := c.mc.ExpireAt(c.ctx, &pb.Expiration{Key: key, Exp: unixTime})
+	err = normalizeError(err)
+	return err
+}
+
+// ExpireAtMillis sets the expiration on a key to the given Unix time in milliseconds.
+func (c *Client) ExpireAtMillis(key string, unixTimeMillis int64) error {
+	_, err := c.mc.ExpireAtMillis(c.ctx, &pb.Expiration{Key: key, Exp: unixTimeMillis})
+	err = normalizeError(err)
+	return err
+}
+
+// TTL gets the number of seconds until a key expires, or -1 if it doesn't expire.
+func (c *Client) TTL(key string) (int64, error) {
+	res, err := c.mc.TTL(c.ctx, &pb.Key{Key: key})
+	if err != nil {
+		err = normalizeError(err)
+		return 0, err
+	}
+	return res.Value, nil
+}
+
+// TTLMillis gets the number of milliseconds until a key expires, or -1 if it doesn't expire.
+func (c *Client) TTLMillis(key string) (int64, error) {
+	res, err := c.mc.TTLMillis(c.ctx, &pb.Key{Key: key})
+	if err != nil {
+		err = normalizeError(err)
+		return 0, err
+	}
+	return res.Value, nil
+}
+
+// Persist removes the expiration from a key, returns true if it had one.
+func (c *Client) Persist(key string) (bool, error) {
+	res, err := c.mc.Persist(c.ctx, &pb.Key{Key: key})
+	if err != nil {
+		err = normalizeError(err)
+		return false, err
+	}
+	return res.Value, nil
+}
+
 // SetLockTimeout sets the default timeout in seconds if already locked.
 func (c *Client) SetLockTimeout(seconds int64) {
 	if seconds < 1 {
 		seconds = 1
 	}
-	md := metadata.New(map[string]string{
-		"maxlockwait": strconv.FormatInt(seconds, 10),
-	})
+	md, ok := metadata.FromContext(c.ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	md["maxlockwait"] = []string{strconv.FormatInt(seconds, 10)}
+	c.ctx = metadata.NewContext(c.ctx, md)
+}
+
+// SetLockOwner sets the owner of the locks taken by this client. Locks with an owner can be acquired again by the
+// same owner without blocking, including by server functions like IncrementInt that lock the key internally, and are
+// released once Unlock has been called the same number of times. Only the owner can release them.
+func (c *Client) SetLockOwner(owner string) {
+	md, ok := metadata.FromContext(c.ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	md["lockowner"] = []string{owner}
 	c.ctx = metadata.NewContext(c.ctx, md)
 }
 
@@ -270,6 +370,38 @@ func (c *Client) Unlock(key string) error {
 	return err
 }
 
+// LockMany locks all of the keys at once, waiting for the given number of seconds if any are already locked
+// before returning an error. Either all of the keys are locked, or none of them are.
+func (c *Client) LockMany(keys []string, seconds int64) error {
+	_, err := c.mc.LockMany(c.ctx, &pb.KeysExpiration{Keys: keys, Exp: seconds})
+	err = normalizeError(err)
+	return err
+}
+
+// UnlockMany unlocks all of the keys at once.
+func (c *Client) UnlockMany(keys []string) error {
+	_, err := c.mc.UnlockMany(c.ctx, &pb.KeysList{Keys: keys})
+	err = normalizeError(err)
+	return err
+}
+
+// ListLocks gets the locks held on the keys with the given prefix.
+func (c *Client) ListLocks(prefix string) ([]*pb.LockInfo, error) {
+	infos, err := c.mc.ListLocks(c.ctx, &pb.Key{Key: prefix})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return infos.Locks, nil
+}
+
+// ForceUnlock releases the lock on a key no matter who holds it, requiring the root role if authentication is enabled.
+func (c *Client) ForceUnlock(key string) error {
+	_, err := c.mc.ForceUnlock(c.ctx, &pb.Key{Key: key})
+	err = normalizeError(err)
+	return err
+}
+
 // UnlockThenSet unlocks a key, then immediately sets its value.
 func (c *Client) UnlockThenSet(key string, v util.Value) error {
 	_, err := c.mc.UnlockThenSet(c.ctx, &pb.ByteValue{Key: key, Value: v.RawBytes()})
@@ -331,6 +463,33 @@ func (c *Client) GetWithPrefix(prefix string) (map[string]util.Value, error) {
 	return m, nil
 }
 
+// GetWithRevision gets a value from the cache along with the revision it was last modified at.
+func (c *Client) GetWithRevision(key string) (util.Value, int64) {
+	rv, err := c.mc.GetWithRevision(c.ctx, &pb.Key{Key: key})
+	if err != nil {
+		err = normalizeError(err)
+		return util.NewValue(err), 0
+	}
+	return util.NewValue(rv.Value), rv.ModRevision
+}
+
+// GetManyWithRevision gets multiple values from the cache along with the revisions they were last modified at.
+func (c *Client) GetManyWithRevision(keys []string) (map[string]util.Value, map[string]int64, error) {
+	rvs, err := c.mc.GetManyWithRevision(c.ctx, &pb.KeysList{Keys: keys})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, nil, err
+	}
+
+	m := map[string]util.Value{}
+	revs := map[string]int64{}
+	for _, rv := range rvs.Values {
+		m[rv.Key] = util.NewValue(rv.Value)
+		revs[rv.Key] = rv.ModRevision
+	}
+	return m, revs, nil
+}
+
 // Set a value in the cache.
 func (c *Client) Set(key string, v interface{}) error {
 	b, err := util.NewValue(v).Bytes()
@@ -346,6 +505,30 @@ func (c *Client) Set(key string, v interface{}) error {
 	return nil
 }
 
+// SetWithExpire sets a value and its expiration in seconds at the same time.
+func (c *Client) SetWithExpire(key string, v interface{}, seconds int64) error {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.mc.SetWithExpire(c.ctx, &pb.ExpiringValue{Key: key, Value: b, Exp: seconds})
+	err = normalizeError(err)
+	return err
+}
+
+// SetWithExpireMillis sets a value and its expiration in milliseconds at the same time.
+func (c *Client) SetWithExpireMillis(key string, v interface{}, milliseconds int64) error {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.mc.SetWithExpireMillis(c.ctx, &pb.ExpiringValue{Key: key, Value: b, Exp: milliseconds})
+	err = normalizeError(err)
+	return err
+}
+
 // SetNX sets a value only if the key doesn't exist, returns true if changed.
 func (c *Client) SetNX(key string, v interface{}) (bool, error) {
 	b, err := util.NewValue(v).Bytes()
@@ -372,6 +555,42 @@ func (c *Client) SetMany(vals map[string]util.Value) (map[string]string, error)
 	return m.Errors, nil
 }
 
+// CompareAndSwap sets a value only if the current value equals the expected value, returns true if changed.
+// The returned revision is the new revision if changed, otherwise the revision the key was last modified at.
+func (c *Client) CompareAndSwap(key string, expected, v interface{}) (bool, int64, error) {
+	eb, err := util.NewValue(expected).Bytes()
+	if err != nil {
+		return false, 0, err
+	}
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return false, 0, err
+	}
+
+	res, err := c.mc.CompareAndSwap(c.ctx, &pb.CompareAndSwapRequest{Key: key, Expected: eb, Value: b})
+	if err != nil {
+		err = normalizeError(err)
+		return false, 0, err
+	}
+	return res.Swapped, res.ModRevision, nil
+}
+
+// SetIfModRevision sets a value only if the key was last modified at the given revision, returns true if changed.
+// A revision of zero means the key must not exist. The returned revision is the same as for CompareAndSwap.
+func (c *Client) SetIfModRevision(key string, rev int64, v interface{}) (bool, int64, error) {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return false, 0, err
+	}
+
+	res, err := c.mc.SetIfModRevision(c.ctx, &pb.RevisionValue{Key: key, Value: b, ModRevision: rev})
+	if err != nil {
+		err = normalizeError(err)
+		return false, 0, err
+	}
+	return res.Swapped, res.ModRevision, nil
+}
+
 // Length returns the byte length of the value for the given key.
 func (c *Client) Length(key string) (int64, error) {
 	iv, err := c.mc.Length(c.ctx, &pb.Key{Key: key})
@@ -653,13 +872,250 @@ func (c *Client) DelHashField(key, field string) error {
 	return err
 }
 
-// NewEventChannel returns a new Event channel.
+// GetTimeSeries gets all samples in a time series.
+func (c *Client) GetTimeSeries(key string) ([]*pb.Sample, error) {
+	ts, err := c.mc.GetTimeSeries(c.ctx, &pb.Key{Key: key})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return ts.Samples, nil
+}
+
+// TimeSeriesCreate creates a time series, or updates the retention and downsampling rules of an existing one.
+// A retention of zero keeps samples forever.
+func (c *Client) TimeSeriesCreate(key string, retention time.Duration, rules ...*pb.DownsampleRule) error {
+	ts := &pb.TimeSeries{Key: key, Retention: durationToMillis(retention), Rules: rules}
+	_, err := c.mc.TimeSeriesCreate(c.ctx, ts)
+	err = normalizeError(err)
+	return err
+}
+
+// TimeSeriesAdd adds a sample to a time series, creates new time series if doesn't exist.
+func (c *Client) TimeSeriesAdd(key string, t time.Time, value float64) error {
+	_, err := c.mc.TimeSeriesAdd(c.ctx, &pb.TimeSeriesSample{Key: key, Timestamp: timeToMillis(t), Value: value})
+	err = normalizeError(err)
+	return err
+}
+
+// TimeSeriesRange gets the samples in a time range, aggregated into buckets unless agg is Aggregation_NONE.
+// A zero to time means no upper bound, a zero bucket aggregates the whole range into one sample.
+func (c *Client) TimeSeriesRange(key string, from, to time.Time, agg pb.Aggregation, bucket time.Duration) ([]*pb.Sample, error) {
+	ts, err := c.mc.TimeSeriesRange(c.ctx, &pb.TimeSeriesQuery{
+		Key:         key,
+		From:        timeToMillis(from),
+		To:          timeToMillis(to),
+		Aggregation: agg,
+		Bucket:      durationToMillis(bucket),
+	})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return ts.Samples, nil
+}
+
+// NewDownsampleRule returns a new DownsampleRule that aggregates samples into buckets stored in destKey.
+func NewDownsampleRule(destKey string, agg pb.Aggregation, bucket time.Duration) *pb.DownsampleRule {
+	return &pb.DownsampleRule{DestKey: destKey, Aggregation: agg, Bucket: durationToMillis(bucket)}
+}
+
+// JSONGet gets the JSON encoded value at the given path in a JSON document, such as "$.a.b[0]".
+func (c *Client) JSONGet(key, path string) util.Value {
+	bv, err := c.mc.JSONGet(c.ctx, &pb.JSONPath{Key: key, Path: path})
+	if err != nil {
+		err = normalizeError(err)
+		return util.NewValue(err)
+	}
+	return util.NewValue(bv.Value)
+}
+
+// JSONSet sets the value at the given path in a JSON document, creates a new document if path is the root.
+// The value is encoded as JSON unless it's a json.RawMessage or []byte.
+func (c *Client) JSONSet(key, path string, v interface{}) error {
+	b, err := toJSON(v)
+	if err != nil {
+		return err
+	}
+	_, err = c.mc.JSONSet(c.ctx, &pb.JSONValue{Key: key, Path: path, Value: b})
+	err = normalizeError(err)
+	return err
+}
+
+// JSONDel deletes the value at the given path in a JSON document.
+func (c *Client) JSONDel(key, path string) error {
+	_, err := c.mc.JSONDel(c.ctx, &pb.JSONPath{Key: key, Path: path})
+	err = normalizeError(err)
+	return err
+}
+
+// JSONArrAppend appends a value to the array at the given path in a JSON document and returns the new length.
+func (c *Client) JSONArrAppend(key, path string, v interface{}) (int64, error) {
+	b, err := toJSON(v)
+	if err != nil {
+		return 0, err
+	}
+	iv, err := c.mc.JSONArrAppend(c.ctx, &pb.JSONValue{Key: key, Path: path, Value: b})
+	if err != nil {
+		err = normalizeError(err)
+		return 0, err
+	}
+	return iv.Value, nil
+}
+
+// JSONNumIncrBy increments the number at the given path in a JSON document and returns the new value.
+func (c *Client) JSONNumIncrBy(key, path string, by float64) (float64, error) {
+	fv, err := c.mc.JSONNumIncrBy(c.ctx, &pb.JSONNumber{Key: key, Path: path, Value: by})
+	if err != nil {
+		err = normalizeError(err)
+		return 0, err
+	}
+	return fv.Value, nil
+}
+
+// VectorIndexCreate creates a vector index, returning ErrVectorIndexExists if it already exists. A dimensions of zero
+// takes the dimensions from the first vector added. If lists is greater than zero, an approximate index is kept
+// that clusters the vectors into the given number of lists.
+func (c *Client) VectorIndexCreate(key string, dimensions int, metric pb.VectorMetric, lists int) error {
+	vi := &pb.VectorIndex{Key: key, Dimensions: int32(dimensions), Metric: metric, Lists: int32(lists)}
+	_, err := c.mc.VectorIndexCreate(c.ctx, vi)
+	err = normalizeError(err)
+	return err
+}
+
+// GetVector gets a single vector from an index.
+func (c *Client) GetVector(key, id string) ([]float32, error) {
+	item, err := c.mc.GetVector(c.ctx, &pb.VectorItem{Key: key, Id: id})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return item.Values, nil
+}
+
+// VectorAdd adds or replaces a vector in an index, creates new index if doesn't exist. If hashKey is not empty,
+// the fields of the hash stored at hashKey can be used to filter search results.
+func (c *Client) VectorAdd(key, id string, vector []float32, hashKey string) error {
+	_, err := c.mc.VectorAdd(c.ctx, &pb.VectorItem{Key: key, Id: id, Values: vector, HashKey: hashKey})
+	err = normalizeError(err)
+	return err
+}
+
+// VectorDelete deletes a vector from an index.
+func (c *Client) VectorDelete(key, id string) error {
+	_, err := c.mc.VectorDelete(c.ctx, &pb.VectorItem{Key: key, Id: id})
+	err = normalizeError(err)
+	return err
+}
+
+// VectorSearch gets the k nearest neighbours of a vector by searching every vector in the index.
+// If filter is given, only vectors whose hash has all of the given field values are returned.
+func (c *Client) VectorSearch(key string, vector []float32, k int, filter map[string]util.Value) ([]*pb.VectorMatch, error) {
+	return c.vectorSearch(&pb.VectorQuery{Key: key, Values: vector, K: int32(k), Filter: util.MapValueToMapBytes(filter)})
+}
+
+// VectorSearchApprox gets the approximate k nearest neighbours of a vector by only searching the given number of
+// lists nearest to it. Falls back to searching every vector if the index doesn't have enough vectors to be trained.
+func (c *Client) VectorSearchApprox(key string, vector []float32, k, probes int, filter map[string]util.Value) ([]*pb.VectorMatch, error) {
+	return c.vectorSearch(&pb.VectorQuery{
+		Key:         key,
+		Values:      vector,
+		K:           int32(k),
+		Approximate: true,
+		Probes:      int32(probes),
+		Filter:      util.MapValueToMapBytes(filter),
+	})
+}
+
+func (c *Client) vectorSearch(q *pb.VectorQuery) ([]*pb.VectorMatch, error) {
+	res, err := c.mc.VectorSearch(c.ctx, q)
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return res.Matches, nil
+}
+
+// SearchIndexCreate creates a full-text search index over the given fields of the hashes whose keys start with prefix.
+// Existing hashes are indexed, and the index is kept up to date as hashes change.
+func (c *Client) SearchIndexCreate(key, prefix string, fields ...string) error {
+	_, err := c.mc.SearchIndexCreate(c.ctx, &pb.SearchIndex{Key: key, Prefix: prefix, Fields: fields})
+	err = normalizeError(err)
+	return err
+}
+
+// SearchIndexDrop deletes a full-text search index.
+func (c *Client) SearchIndexDrop(key string) error {
+	_, err := c.mc.SearchIndexDrop(c.ctx, &pb.Key{Key: key})
+	err = normalizeError(err)
+	return err
+}
+
+// Search gets a page of the keys of the hashes matching a query, best match first, and the total number of matches.
+// A limit of zero returns the default of 10 results.
+func (c *Client) Search(key, query string, offset, limit int) ([]*pb.SearchResult, int64, error) {
+	res, err := c.mc.Search(c.ctx, &pb.SearchQuery{Key: key, Query: query, Offset: int32(offset), Limit: int32(limit)})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, 0, err
+	}
+	return res.Results, res.Total, nil
+}
+
+// FieldIndexCreate creates an index on a field of the hashes whose keys start with prefix. If numeric is true,
+// values are indexed as numbers so they can be queried by range.
+func (c *Client) FieldIndexCreate(prefix, field string, numeric bool) error {
+	_, err := c.mc.FieldIndexCreate(c.ctx, &pb.FieldIndex{Prefix: prefix, Field: field, Numeric: numeric})
+	err = normalizeError(err)
+	return err
+}
+
+// FieldIndexDrop deletes an index on a field of the hashes whose keys start with prefix.
+func (c *Client) FieldIndexDrop(prefix, field string) error {
+	_, err := c.mc.FieldIndexDrop(c.ctx, &pb.FieldIndex{Prefix: prefix, Field: field})
+	err = normalizeError(err)
+	return err
+}
+
+// FindByField gets the keys of the hashes whose keys start with prefix where the field has the given value.
+func (c *Client) FindByField(prefix, field string, v interface{}) ([]string, error) {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.mc.FindByField(c.ctx, &pb.FieldQuery{Prefix: prefix, Field: field, Value: b})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return res.Keys, nil
+}
+
+// FindByFieldRange gets the keys of the hashes whose keys start with prefix where the numeric field is between
+// min and max inclusive, ordered by value. A min of negative infinity or a max of infinity leaves that end unbounded.
+func (c *Client) FindByFieldRange(prefix, field string, min, max float64) ([]string, error) {
+	res, err := c.mc.FindByFieldRange(c.ctx, &pb.FieldQuery{
+		Prefix: prefix,
+		Field:  field,
+		Min:    min,
+		Max:    max,
+		NoMin:  math.IsInf(min, -1),
+		NoMax:  math.IsInf(max, 1),
+	})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return res.Keys, nil
+}
+
+// NewEventChannel returns a new Event channel, which gets the events of every watch. It holds 100 events, and when
+// it's full, the oldest event is dropped to make room, so a channel that isn't read doesn't hold up the client.
 func (c *Client) NewEventChannel() (ch chan *pb.Event, id int64) {
+	ch = make(chan *pb.Event, defaultWatchBufferSize)
+	c.lock.Lock()
 	id = c.newID
 	c.newID++
-
-	ch = make(chan *pb.Event, 100)
-	c.lock.Lock()
 	c.watchers[id] = ch
 	c.lock.Unlock()
 	return
@@ -675,27 +1131,44 @@ func (c *Client) CloseEventChannel(id int64) {
 	c.lock.Unlock()
 }
 
-// Watch for a key change.
-func (c *Client) Watch(key string, prefix bool) {
-	r := &pb.WatchRequest{
-		Key:    key,
-		Prefix: prefix,
-	}
+// Watch watches for a key change, returning a Subscription that gets the watch's events. Events are also sent to
+// every event channel. If prefix is true, watches all keys with the given prefix.
+func (c *Client) Watch(key string, prefix bool) *Subscription {
+	return c.WatchReasons(key, prefix)
+}
 
-	c.reqCh <- r
-	<-c.resCh
+// WatchReasons watches for a key change, only getting events for the given reasons. If no reasons are given, events
+// are sent for every change to the key's value, which leaves out lock releases.
+func (c *Client) WatchReasons(key string, prefix bool, reasons ...pb.Event_Reason) *Subscription {
+	return c.WatchWith(&pb.WatchRequest{
+		Key:     key,
+		Prefix:  prefix,
+		Reasons: reasons,
+	})
 }
 
-// Unwatch stops watching for a key change.
+// WatchWith watches for a key change using a full WatchRequest, which can limit events to certain event types, keys
+// matching a glob and values passing filters such as an int crossing a threshold. If the request has a revision,
+// events from that revision on are sent first, or a COMPACTED event if it has been compacted, which means the key
+// needs to be read again. The subscription uses the buffer size and overflow policy from the client's Config.
+func (c *Client) WatchWith(r *pb.WatchRequest) *Subscription {
+	return c.Subscribe(r, c.config.WatchBufferSize, c.config.WatchOverflow)
+}
+
+// Unwatch stops watching for a key change, canceling every subscription on the key.
 func (c *Client) Unwatch(key string, prefix bool) {
-	r := &pb.WatchRequest{
-		Key:    key,
-		Prefix: prefix,
-		Cancel: true,
+	subs := []*Subscription{}
+	c.lock.RLock()
+	for _, sub := range c.subs {
+		if sub.request.Key == key && sub.request.Prefix == prefix {
+			subs = append(subs, sub)
+		}
 	}
+	c.lock.RUnlock()
 
-	c.reqCh <- r
-	<-c.resCh
+	for _, sub := range subs {
+		sub.Cancel()
+	}
 }
 
 // AuthEnable enables authentication.
@@ -852,17 +1325,20 @@ func (c *Client) backgroundProcess() {
 	}
 	c.stream = stream
 
-	// re-watch keys after a reconnect
+	// re-watch keys after a reconnect, resuming after the last event seen by each watch.
 	go func() {
 		c.lock.RLock()
-		for key := range c.watching {
-			prefix := false
-			if strings.HasSuffix(key, suffixForKeysUsingPrefix) {
-				prefix = true
-			}
-			c.Watch(key, prefix)
+		requests := make([]*pb.WatchRequest, 0, len(c.watching))
+		for _, r := range c.watching {
+			wr := *r
+			requests = append(requests, &wr)
 		}
 		c.lock.RUnlock()
+
+		for _, r := range requests {
+			c.reqCh <- r
+			<-c.resCh
+		}
 	}()
 
 	// receiver
@@ -874,9 +1350,17 @@ func (c *Client) backgroundProcess() {
 				return
 			}
 
+			// progress events only move the revision watches are resumed from.
+			if !c.track(ev) || ev.Type == pb.Event_PROGRESS {
+				continue
+			}
+
 			c.lock.RLock()
 			for _, ch := range c.watchers {
-				ch <- ev
+				sendDropOldest(ch, ev)
+			}
+			if sub, ok := c.subs[ev.Subscription]; ok {
+				sub.send(ev)
 			}
 			c.lock.RUnlock()
 		}
@@ -896,18 +1380,66 @@ func (c *Client) backgroundProcess() {
 				return
 			}
 
-			key := r.Key
-			if r.Prefix {
-				key += suffixForKeysUsingPrefix
-			}
-
+			key := watchID(r.Key, r.Prefix, r.Subscription)
 			c.lock.Lock()
 			if r.Cancel {
 				delete(c.watching, key)
+				delete(c.delivered, key)
 			} else {
-				c.watching[key] = struct{}{}
+				// keep a copy, since its revision is updated as events come in.
+				wr := *r
+				old, ok := c.watching[key]
+				if ok && wr.Rev == 0 {
+					wr.Rev = old.Rev
+				}
+				if !ok || wr.Rev != old.Rev {
+					delete(c.delivered, key)
+				}
+				c.watching[key] = &wr
 			}
 			c.lock.Unlock()
 		}
 	}
 }
+
+// track moves the revision a watch is resumed from as its events come in, returning false if the event was already
+// delivered. A revision can have several events, so a watch is resumed from the revision of its last event, and the
+// events of that revision that were delivered before are dropped. It moves past the revision once a later one or a
+// progress event arrives.
+func (c *Client) track(ev *pb.Event) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := watchID(ev.WatchKey, ev.WatchPrefix, ev.Subscription)
+	r, ok := c.watching[key]
+	if !ok {
+		return true
+	}
+
+	switch {
+	case ev.Type == pb.Event_PROGRESS || ev.Type == pb.Event_COMPACTED:
+		// the watch has every event up to the revision, and a compacted watch carries on after it.
+		if ev.Rev >= r.Rev {
+			r.Rev = ev.Rev + 1
+			delete(c.delivered, key)
+		}
+		return true
+	case ev.Rev < r.Rev:
+		return false
+	case ev.Rev > r.Rev:
+		r.Rev = ev.Rev
+		c.delivered[key] = map[string]struct{}{}
+	}
+
+	delivered, ok := c.delivered[key]
+	if !ok {
+		delivered = map[string]struct{}{}
+		c.delivered[key] = delivered
+	}
+	id := ev.Type.String() + ev.Reason.String() + "\x00" + ev.Current.GetKey()
+	if _, ok := delivered[id]; ok {
+		return false
+	}
+	delivered[id] = struct{}{}
+	return true
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// Command runs a custom command registered on the server, returning its result.
+func (c *Client) Command(name string, args ...interface{}) util.Value {
+	req := &pb.CommandRequest{Name: name, Args: make([][]byte, len(args))}
+	for i, arg := range args {
+		b, err := util.NewValue(arg).Bytes()
+		if err != nil {
+			return util.NewValue(err)
+		}
+		req.Args[i] = b
+	}
+
+	res, err := c.mc.Command(c.ctx, req)
+	if err != nil {
+		err = normalizeError(err)
+		return util.NewValue(err)
+	}
+	return util.NewValue(res.Value)
+}
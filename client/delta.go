@@ -0,0 +1,63 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// ApplyListDeltas applies the deltas from a list watch event to a copy of the list as it was before the event,
+// returning the list as it is after.
+func ApplyListDeltas(list [][]byte, deltas []*pb.Delta) ([][]byte, error) {
+	lst := make([][]byte, len(list), len(list)+len(deltas))
+	copy(lst, list)
+
+	for _, d := range deltas {
+		i := int(d.Index)
+		switch d.Type {
+		case pb.Delta_LIST_PUSH, pb.Delta_LIST_INSERT:
+			if i < 0 || i > len(lst) {
+				return nil, util.ErrListIndexOutOfRange
+			}
+			lst = append(lst, nil)
+			copy(lst[i+1:], lst[i:])
+			lst[i] = d.Value
+		case pb.Delta_LIST_POP, pb.Delta_LIST_DELETE:
+			if i < 0 || i >= len(lst) {
+				return nil, util.ErrListIndexOutOfRange
+			}
+			lst = append(lst[:i], lst[i+1:]...)
+		case pb.Delta_LIST_SET:
+			if i < 0 || i >= len(lst) {
+				return nil, util.ErrListIndexOutOfRange
+			}
+			lst[i] = d.Value
+		}
+	}
+	return lst, nil
+}
+
+// ApplyHashDeltas applies the deltas from a hash watch event to a hash as it was before the event.
+func ApplyHashDeltas(hash map[string][]byte, deltas []*pb.Delta) {
+	for _, d := range deltas {
+		switch d.Type {
+		case pb.Delta_HASH_SET:
+			hash[d.Field] = d.Value
+		case pb.Delta_HASH_DELETE:
+			delete(hash, d.Field)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"time"
+
+	"github.com/deejross/mydis/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// GrantLease creates a named lease that expires after the given number of seconds unless it's kept alive.
+func (c *Client) GrantLease(name string, seconds int64) error {
+	_, err := c.mc.GrantLease(c.ctx, &pb.Expiration{Key: name, Exp: seconds})
+	err = normalizeError(err)
+	return err
+}
+
+// RevokeLease revokes a named lease, deleting every key attached to it.
+func (c *Client) RevokeLease(name string) error {
+	_, err := c.mc.RevokeLease(c.ctx, &pb.Key{Key: name})
+	err = normalizeError(err)
+	return err
+}
+
+// GetLease gets the seconds left on a named lease and the keys attached to it.
+func (c *Client) GetLease(name string) (*pb.LeaseInfo, error) {
+	info, err := c.mc.GetLease(c.ctx, &pb.Key{Key: name})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return info, nil
+}
+
+// SetLease sets the named lease that keys written by the client are attached to. An empty name stops attaching keys
+// to a lease, and keys written without a lease don't expire.
+func (c *Client) SetLease(name string) {
+	md, ok := metadata.FromContext(c.ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	if name == "" {
+		delete(md, "lease")
+	} else {
+		md["lease"] = []string{name}
+	}
+	c.ctx = metadata.NewContext(c.ctx, md)
+}
+
+// KeepAlive refreshes a named lease in the background at the given interval until the returned function is called
+// or a refresh fails. The lease is refreshed once before returning, so an error is returned if it doesn't exist.
+func (c *Client) KeepAlive(name string, interval time.Duration) (func(), error) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	stream, err := c.mc.KeepAlive(ctx)
+	if err != nil {
+		cancel()
+		return nil, normalizeError(err)
+	}
+
+	refresh := func() error {
+		if err := stream.Send(&pb.Key{Key: name}); err != nil {
+			return err
+		}
+		_, err := stream.Recv()
+		return err
+	}
+	if err := refresh(); err != nil {
+		cancel()
+		return nil, normalizeError(err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := refresh(); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return cancel, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"sync"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// ChannelSubscription gets the messages published to the channels and channel patterns it's subscribed to.
+type ChannelSubscription struct {
+	stream   pb.Mydis_SubscribeClient
+	cancel   context.CancelFunc
+	messages chan *pb.PubSubMessage
+	closed   bool
+	err      error
+	lock     sync.Mutex
+}
+
+// Publish sends a message to the subscribers of a channel, returning the number of subscribers it was sent to.
+// Messages reach subscribers connected to any server in the cluster, but the count only includes the ones connected to
+// the server that the message was published to. Subscribers that aren't connected when a message is published never
+// get it.
+func (c *Client) Publish(channel string, v interface{}) (int64, error) {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := c.mc.Publish(c.ctx, &pb.PubSubMessage{Channel: channel, Message: b})
+	if err != nil {
+		err = normalizeError(err)
+		return 0, err
+	}
+	return res.Value, nil
+}
+
+// SubscribeChannels returns a new ChannelSubscription, subscribed to the given channels.
+func (c *Client) SubscribeChannels(channels ...string) (*ChannelSubscription, error) {
+	return c.newChannelSubscription(&pb.SubscribeRequest{Channels: channels})
+}
+
+// SubscribePatterns returns a new ChannelSubscription, subscribed to the channels matching the given glob patterns.
+func (c *Client) SubscribePatterns(patterns ...string) (*ChannelSubscription, error) {
+	return c.newChannelSubscription(&pb.SubscribeRequest{Patterns: patterns})
+}
+
+// PubSubChannels lists the channels with subscribers that match a glob pattern, or every channel if the pattern is
+// empty, along with the number of patterns subscribed to. Only the subscribers connected to the server that handles
+// the request are listed.
+func (c *Client) PubSubChannels(pattern string) (*pb.PubSubChannelList, error) {
+	res, err := c.mc.PubSubChannels(c.ctx, &pb.Key{Key: pattern})
+	if err != nil {
+		err = normalizeError(err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) newChannelSubscription(r *pb.SubscribeRequest) (*ChannelSubscription, error) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	stream, err := c.mc.Subscribe(ctx)
+	if err != nil {
+		cancel()
+		return nil, normalizeError(err)
+	}
+
+	cs := &ChannelSubscription{
+		stream:   stream,
+		cancel:   cancel,
+		messages: make(chan *pb.PubSubMessage, defaultWatchBufferSize),
+	}
+	if err := cs.send(r); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go func() {
+		defer close(cs.messages)
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				cs.lock.Lock()
+				if !cs.closed {
+					cs.err = normalizeError(err)
+				}
+				cs.lock.Unlock()
+				return
+			}
+			cs.messages <- msg
+		}
+	}()
+	return cs, nil
+}
+
+// Messages returns the channel that gets the subscription's messages. It's closed when the subscription is closed
+// or its stream ends.
+func (cs *ChannelSubscription) Messages() <-chan *pb.PubSubMessage {
+	return cs.messages
+}
+
+// Subscribe to more channels.
+func (cs *ChannelSubscription) Subscribe(channels ...string) error {
+	return cs.send(&pb.SubscribeRequest{Channels: channels})
+}
+
+// SubscribePatterns subscribes to the channels matching more glob patterns.
+func (cs *ChannelSubscription) SubscribePatterns(patterns ...string) error {
+	return cs.send(&pb.SubscribeRequest{Patterns: patterns})
+}
+
+// Unsubscribe from channels.
+func (cs *ChannelSubscription) Unsubscribe(channels ...string) error {
+	return cs.send(&pb.SubscribeRequest{Channels: channels, Unsubscribe: true})
+}
+
+// UnsubscribePatterns unsubscribes from glob patterns.
+func (cs *ChannelSubscription) UnsubscribePatterns(patterns ...string) error {
+	return cs.send(&pb.SubscribeRequest{Patterns: patterns, Unsubscribe: true})
+}
+
+// Err returns the error that ended the subscription's stream, if any.
+func (cs *ChannelSubscription) Err() error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return cs.err
+}
+
+// Close the subscription, which closes its channel.
+func (cs *ChannelSubscription) Close() {
+	cs.lock.Lock()
+	cs.closed = true
+	cs.lock.Unlock()
+	cs.cancel()
+}
+
+func (cs *ChannelSubscription) send(r *pb.SubscribeRequest) error {
+	cs.lock.Lock()
+	defer cs.lock.Unlock()
+	return normalizeError(cs.stream.Send(r))
+}
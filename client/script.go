@@ -0,0 +1,51 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// Eval runs a script on the server, committing every change it makes atomically, and returns the script's result.
+// The script gets the keys as KEYS and the args as ARGV.
+func (c *Client) Eval(script string, keys []string, args ...string) util.Value {
+	res, err := c.mc.Eval(c.ctx, &pb.EvalRequest{Script: script, Keys: keys, Args: args})
+	if err != nil {
+		err = normalizeError(err)
+		return util.NewValue(err)
+	}
+	return util.NewValue(res.Value)
+}
+
+// EvalSHA runs a script loaded with ScriptLoad, by its SHA1 hash.
+func (c *Client) EvalSHA(sha string, keys []string, args ...string) util.Value {
+	res, err := c.mc.EvalSHA(c.ctx, &pb.EvalRequest{Sha: sha, Keys: keys, Args: args})
+	if err != nil {
+		err = normalizeError(err)
+		return util.NewValue(err)
+	}
+	return util.NewValue(res.Value)
+}
+
+// ScriptLoad stores a script on the server without running it, returning its SHA1 hash for use with EvalSHA.
+func (c *Client) ScriptLoad(script string) (string, error) {
+	res, err := c.mc.ScriptLoad(c.ctx, &pb.Script{Script: script})
+	if err != nil {
+		err = normalizeError(err)
+		return "", err
+	}
+	return res.Sha, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// defaultWatchBufferSize is the number of events a subscription holds when no buffer size is given.
+var defaultWatchBufferSize = 100
+
+// OverflowPolicy decides what happens when an event arrives for a subscription whose buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowDropOldest drops the oldest event in the buffer to make room for the new one.
+	OverflowDropOldest OverflowPolicy = iota
+	// OverflowBlock waits for room in the buffer, which holds up events for every other subscription of the client.
+	OverflowBlock
+	// OverflowError cancels the subscription, closing its channel, and Err returns ErrWatchOverflow.
+	OverflowError
+)
+
+// Subscription gets the events of a single watch on its own channel.
+type Subscription struct {
+	client       *Client
+	request      *pb.WatchRequest
+	overflow     OverflowPolicy
+	events       chan *pb.Event
+	done         chan struct{}
+	err          error
+	lock         sync.Mutex
+	stopOnce     sync.Once
+	cancelOnce   sync.Once
+	subscription int64
+}
+
+// Events returns the channel that gets the subscription's events. It's closed when the subscription is canceled.
+func (s *Subscription) Events() <-chan *pb.Event {
+	return s.events
+}
+
+// Err returns the error that canceled the subscription, or nil if it's still active or was canceled with Cancel.
+func (s *Subscription) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+// Cancel stops watching and closes the subscription's channel.
+func (s *Subscription) Cancel() {
+	s.stop(nil)
+	s.cancelOnce.Do(func() {
+		c := s.client
+		c.lock.Lock()
+		delete(c.subs, s.subscription)
+		close(s.events)
+		c.lock.Unlock()
+
+		c.reqCh <- &pb.WatchRequest{
+			Key:          s.request.Key,
+			Prefix:       s.request.Prefix,
+			Subscription: s.subscription,
+			Cancel:       true,
+		}
+		<-c.resCh
+	})
+}
+
+// stop keeps any more events from being sent to the subscription, returning false if it was already stopped.
+func (s *Subscription) stop(err error) bool {
+	stopped := false
+	s.stopOnce.Do(func() {
+		s.lock.Lock()
+		s.err = err
+		s.lock.Unlock()
+		close(s.done)
+		stopped = true
+	})
+	return stopped
+}
+
+// send an event to the subscription, following its overflow policy if its buffer is full. It's called with the
+// client's read lock held, which keeps the channel from being closed.
+func (s *Subscription) send(ev *pb.Event) {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	switch s.overflow {
+	case OverflowBlock:
+		select {
+		case s.events <- ev:
+		case <-s.done:
+		}
+	case OverflowError:
+		select {
+		case s.events <- ev:
+		default:
+			// canceling needs the client's write lock, so it's done once the read lock is released.
+			if s.stop(util.ErrWatchOverflow) {
+				go s.Cancel()
+			}
+		}
+	default:
+		sendDropOldest(s.events, ev)
+	}
+}
+
+// sendDropOldest sends an event to a channel without waiting, dropping the oldest event in its buffer if it's full.
+func sendDropOldest(ch chan *pb.Event, ev *pb.Event) {
+	for {
+		select {
+		case ch <- ev:
+			return
+		default:
+		}
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+// Subscribe watches for a key change using a full WatchRequest, returning a Subscription that gets the watch's
+// events on its own channel, which holds the given number of events before the overflow policy is followed.
+// Every subscription is a separate watch, so subscriptions on the same key don't affect each other.
+func (c *Client) Subscribe(r *pb.WatchRequest, bufferSize int, overflow OverflowPolicy) *Subscription {
+	if bufferSize <= 0 {
+		bufferSize = defaultWatchBufferSize
+	}
+
+	c.lock.Lock()
+	c.nextSub++
+	wr := *r
+	wr.Subscription = c.nextSub
+	wr.Cancel = false
+	sub := &Subscription{
+		client:       c,
+		request:      &wr,
+		overflow:     overflow,
+		events:       make(chan *pb.Event, bufferSize),
+		done:         make(chan struct{}),
+		subscription: wr.Subscription,
+	}
+	c.subs[wr.Subscription] = sub
+	c.lock.Unlock()
+
+	c.reqCh <- &wr
+	<-c.resCh
+	return sub
+}
+
+// watchID returns the ID a watch is known by, which is the same on the client and server.
+func watchID(key string, prefix bool, subscription int64) string {
+	id := key
+	if prefix {
+		id += suffixForKeysUsingPrefix
+	}
+	if subscription != 0 {
+		id += "\x00" + strconv.FormatInt(subscription, 10)
+	}
+	return id
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// Txn builds a transaction. Comparisons are added with the If methods, then operations are added to the success
+// branch after calling Then, or to the failure branch after calling Else. Nothing is sent until Commit is called.
+type Txn struct {
+	c    *Client
+	req  *pb.TxnRequest
+	fail bool
+	err  error
+}
+
+// Txn starts building a new transaction.
+func (c *Client) Txn() *Txn {
+	return &Txn{c: c, req: &pb.TxnRequest{}}
+}
+
+// IfExists adds a comparison that passes if the key exists.
+func (t *Txn) IfExists(key string) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_EXISTS, Key: key})
+}
+
+// IfNotExists adds a comparison that passes if the key does not exist.
+func (t *Txn) IfNotExists(key string) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_EXISTS, Key: key, Not: true})
+}
+
+// IfValue adds a comparison that passes if the key has the given value.
+func (t *Txn) IfValue(key string, v interface{}) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_VALUE_EQUAL, Key: key, Value: t.bytes(v)})
+}
+
+// IfNotValue adds a comparison that passes if the key does not exist or has a different value.
+func (t *Txn) IfNotValue(key string, v interface{}) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_VALUE_EQUAL, Key: key, Value: t.bytes(v), Not: true})
+}
+
+// IfIntGreater adds a comparison that passes if the key is an integer greater than i.
+func (t *Txn) IfIntGreater(key string, i int64) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_INT_GREATER, Key: key, Int: i})
+}
+
+// IfModRevision adds a comparison that passes if the key was last modified at the given revision,
+// zero meaning the key does not exist.
+func (t *Txn) IfModRevision(key string, rev int64) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_MOD_REVISION_EQUAL, Key: key, ModRevision: rev})
+}
+
+// IfHashField adds a comparison that passes if the hash field has the given value.
+func (t *Txn) IfHashField(key, field string, v interface{}) *Txn {
+	return t.compare(&pb.TxnCompare{Type: pb.TxnCompare_HASH_FIELD_EQUAL, Key: key, Field: field, Value: t.bytes(v)})
+}
+
+// Then causes the following operations to be added to the branch that runs if all comparisons pass.
+func (t *Txn) Then() *Txn {
+	t.fail = false
+	return t
+}
+
+// Else causes the following operations to be added to the branch that runs if any comparison fails.
+func (t *Txn) Else() *Txn {
+	t.fail = true
+	return t
+}
+
+// Set adds an operation that sets the value of a key.
+func (t *Txn) Set(key string, v interface{}) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_SET, Key: key, Value: t.bytes(v)})
+}
+
+// SetInt adds an operation that sets an integer.
+func (t *Txn) SetInt(key string, i int64) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_SET_INT, Key: key, Int: i})
+}
+
+// IncrementInt adds an operation that increments an integer, which is created if it doesn't exist.
+func (t *Txn) IncrementInt(key string, by int64) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_INCREMENT_INT, Key: key, Int: by})
+}
+
+// ListAppend adds an operation that appends a value to a list, which is created if it doesn't exist.
+func (t *Txn) ListAppend(key string, v interface{}) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_LIST_APPEND, Key: key, Value: t.bytes(v)})
+}
+
+// SetHashField adds an operation that sets a field in a hash, which is created if it doesn't exist.
+func (t *Txn) SetHashField(key, field string, v interface{}) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_SET_HASH_FIELD, Key: key, Field: field, Value: t.bytes(v)})
+}
+
+// Delete adds an operation that deletes a key.
+func (t *Txn) Delete(key string) *Txn {
+	return t.op(&pb.TxnOp{Type: pb.TxnOp_DELETE, Key: key})
+}
+
+// Commit sends the transaction, returning whether the comparisons passed, the value of the key after each
+// operation of the branch that ran, and the revision of the cache after the transaction.
+func (t *Txn) Commit() (bool, []util.Value, int64, error) {
+	if t.err != nil {
+		return false, nil, 0, t.err
+	}
+	res, err := t.c.mc.Txn(t.c.ctx, t.req)
+	if err != nil {
+		err = normalizeError(err)
+		return false, nil, 0, err
+	}
+
+	results := []util.Value{}
+	for _, bv := range res.Results {
+		results = append(results, util.NewValue(bv.Value))
+	}
+	return res.Succeeded, results, res.Revision, nil
+}
+
+func (t *Txn) compare(c *pb.TxnCompare) *Txn {
+	t.req.Compare = append(t.req.Compare, c)
+	return t
+}
+
+func (t *Txn) op(op *pb.TxnOp) *Txn {
+	if t.fail {
+		t.req.Failure = append(t.req.Failure, op)
+	} else {
+		t.req.Success = append(t.req.Success, op)
+	}
+	return t
+}
+
+// bytes converts a value to bytes, keeping the first error so it can be returned by Commit.
+func (t *Txn) bytes(v interface{}) []byte {
+	b, err := util.NewValue(v).Bytes()
+	if err != nil && t.err == nil {
+		t.err = err
+	}
+	return b
+}
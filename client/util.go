@@ -1,7 +1,9 @@
 package client
 
 import (
+	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
@@ -46,3 +48,25 @@ func GetPermission(key string, permName string) *pb.Permission {
 		PermType: pb.Permission_Type(permType),
 	}
 }
+
+func timeToMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func durationToMillis(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
+// toJSON encodes the given value as JSON, json.RawMessage and []byte values are assumed to already be JSON.
+func toJSON(v interface{}) ([]byte, error) {
+	switch t := v.(type) {
+	case json.RawMessage:
+		return t, nil
+	case []byte:
+		return t, nil
+	}
+	return json.Marshal(v)
+}
@@ -15,17 +15,24 @@
 package mydis
 
 import (
+	"bytes"
 	"errors"
 
+	"github.com/coreos/etcd/auth"
 	"github.com/coreos/etcd/auth/authpb"
 	"github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
 	"golang.org/x/net/context"
 )
 
 // AuthEnable enabled authentication.
 func (s *Server) AuthEnable(ctx context.Context, req *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
-	resp, err := s.cache.Server.AuthEnable(ctx, &etcdserverpb.AuthEnableRequest{})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthEnableResponse{}, err
+	}
+	resp, err := a.AuthEnable(ctx, &etcdserverpb.AuthEnableRequest{})
 	if err != nil {
 		return &pb.AuthEnableResponse{}, err
 	}
@@ -36,7 +43,11 @@ func (s *Server) AuthEnable(ctx context.Context, req *pb.AuthEnableRequest) (*pb
 
 // AuthDisable disables authentication.
 func (s *Server) AuthDisable(ctx context.Context, req *pb.AuthDisableRequest) (*pb.AuthDisableResponse, error) {
-	resp, err := s.cache.Server.AuthDisable(ctx, &etcdserverpb.AuthDisableRequest{})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthDisableResponse{}, err
+	}
+	resp, err := a.AuthDisable(ctx, &etcdserverpb.AuthDisableRequest{})
 	if err != nil {
 		return &pb.AuthDisableResponse{}, err
 	}
@@ -47,7 +58,11 @@ func (s *Server) AuthDisable(ctx context.Context, req *pb.AuthDisableRequest) (*
 
 // Authenticate processes an authenticate request.
 func (s *Server) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
-	resp, err := s.cache.Server.Authenticate(ctx, &etcdserverpb.AuthenticateRequest{Name: req.Name, Password: req.Password})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthenticateResponse{}, err
+	}
+	resp, err := a.Authenticate(ctx, &etcdserverpb.AuthenticateRequest{Name: req.Name, Password: req.Password})
 	if err != nil {
 		return &pb.AuthenticateResponse{}, err
 	}
@@ -59,7 +74,11 @@ func (s *Server) Authenticate(ctx context.Context, req *pb.AuthenticateRequest)
 
 // UserAdd adds a new user.
 func (s *Server) UserAdd(ctx context.Context, req *pb.AuthUserAddRequest) (*pb.AuthUserAddResponse, error) {
-	resp, err := s.cache.Server.UserAdd(ctx, &etcdserverpb.AuthUserAddRequest{Name: req.Name, Password: req.Password})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserAddResponse{}, err
+	}
+	resp, err := a.UserAdd(ctx, &etcdserverpb.AuthUserAddRequest{Name: req.Name, Password: req.Password})
 	if err != nil {
 		return &pb.AuthUserAddResponse{}, err
 	}
@@ -70,7 +89,11 @@ func (s *Server) UserAdd(ctx context.Context, req *pb.AuthUserAddRequest) (*pb.A
 
 // UserGet gets detailed information for a user.
 func (s *Server) UserGet(ctx context.Context, req *pb.AuthUserGetRequest) (*pb.AuthUserGetResponse, error) {
-	resp, err := s.cache.Server.UserGet(ctx, &etcdserverpb.AuthUserGetRequest{Name: req.Name})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserGetResponse{}, err
+	}
+	resp, err := a.UserGet(ctx, &etcdserverpb.AuthUserGetRequest{Name: req.Name})
 	if err != nil {
 		return &pb.AuthUserGetResponse{}, err
 	}
@@ -82,7 +105,11 @@ func (s *Server) UserGet(ctx context.Context, req *pb.AuthUserGetRequest) (*pb.A
 
 // UserList gets a list of all users.
 func (s *Server) UserList(ctx context.Context, req *pb.AuthUserListRequest) (*pb.AuthUserListResponse, error) {
-	resp, err := s.cache.Server.UserList(ctx, &etcdserverpb.AuthUserListRequest{})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserListResponse{}, err
+	}
+	resp, err := a.UserList(ctx, &etcdserverpb.AuthUserListRequest{})
 	if err != nil {
 		return &pb.AuthUserListResponse{}, err
 	}
@@ -99,7 +126,11 @@ func (s *Server) UserDelete(ctx context.Context, req *pb.AuthUserDeleteRequest)
 		return &pb.AuthUserDeleteResponse{}, errors.New("Unknown error")
 	}
 
-	resp, err := s.cache.Server.UserDelete(ctx, &etcdserverpb.AuthUserDeleteRequest{Name: req.Name})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserDeleteResponse{}, err
+	}
+	resp, err := a.UserDelete(ctx, &etcdserverpb.AuthUserDeleteRequest{Name: req.Name})
 	if err != nil {
 		return &pb.AuthUserDeleteResponse{}, err
 	}
@@ -110,7 +141,11 @@ func (s *Server) UserDelete(ctx context.Context, req *pb.AuthUserDeleteRequest)
 
 // UserChangePassword changes the password of a specified user.
 func (s *Server) UserChangePassword(ctx context.Context, req *pb.AuthUserChangePasswordRequest) (*pb.AuthUserChangePasswordResponse, error) {
-	resp, err := s.cache.Server.UserChangePassword(ctx, &etcdserverpb.AuthUserChangePasswordRequest{Name: req.Name, Password: req.Password})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserChangePasswordResponse{}, err
+	}
+	resp, err := a.UserChangePassword(ctx, &etcdserverpb.AuthUserChangePasswordRequest{Name: req.Name, Password: req.Password})
 	if err != nil {
 		return &pb.AuthUserChangePasswordResponse{}, err
 	}
@@ -121,7 +156,11 @@ func (s *Server) UserChangePassword(ctx context.Context, req *pb.AuthUserChangeP
 
 // UserGrantRole grants a role to a specified user.
 func (s *Server) UserGrantRole(ctx context.Context, req *pb.AuthUserGrantRoleRequest) (*pb.AuthUserGrantRoleResponse, error) {
-	resp, err := s.cache.Server.UserGrantRole(ctx, &etcdserverpb.AuthUserGrantRoleRequest{Role: req.Role, User: req.User})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserGrantRoleResponse{}, err
+	}
+	resp, err := a.UserGrantRole(ctx, &etcdserverpb.AuthUserGrantRoleRequest{Role: req.Role, User: req.User})
 	if err != nil {
 		return &pb.AuthUserGrantRoleResponse{}, err
 	}
@@ -132,7 +171,11 @@ func (s *Server) UserGrantRole(ctx context.Context, req *pb.AuthUserGrantRoleReq
 
 // UserRevokeRole revokes a role from a specified user.
 func (s *Server) UserRevokeRole(ctx context.Context, req *pb.AuthUserRevokeRoleRequest) (*pb.AuthUserRevokeRoleResponse, error) {
-	resp, err := s.cache.Server.UserRevokeRole(ctx, &etcdserverpb.AuthUserRevokeRoleRequest{Name: req.Name, Role: req.Role})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthUserRevokeRoleResponse{}, err
+	}
+	resp, err := a.UserRevokeRole(ctx, &etcdserverpb.AuthUserRevokeRoleRequest{Name: req.Name, Role: req.Role})
 	if err != nil {
 		return &pb.AuthUserRevokeRoleResponse{}, err
 	}
@@ -143,7 +186,11 @@ func (s *Server) UserRevokeRole(ctx context.Context, req *pb.AuthUserRevokeRoleR
 
 // RoleAdd adds a new role.
 func (s *Server) RoleAdd(ctx context.Context, req *pb.AuthRoleAddRequest) (*pb.AuthRoleAddResponse, error) {
-	resp, err := s.cache.Server.RoleAdd(ctx, &etcdserverpb.AuthRoleAddRequest{Name: req.Name})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthRoleAddResponse{}, err
+	}
+	resp, err := a.RoleAdd(ctx, &etcdserverpb.AuthRoleAddRequest{Name: req.Name})
 	if err != nil {
 		return &pb.AuthRoleAddResponse{}, err
 	}
@@ -154,7 +201,11 @@ func (s *Server) RoleAdd(ctx context.Context, req *pb.AuthRoleAddRequest) (*pb.A
 
 // RoleGet gets detailed role information.
 func (s *Server) RoleGet(ctx context.Context, req *pb.AuthRoleGetRequest) (*pb.AuthRoleGetResponse, error) {
-	resp, err := s.cache.Server.RoleGet(ctx, &etcdserverpb.AuthRoleGetRequest{Role: req.Role})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthRoleGetResponse{}, err
+	}
+	resp, err := a.RoleGet(ctx, &etcdserverpb.AuthRoleGetRequest{Role: req.Role})
 	if err != nil {
 		return &pb.AuthRoleGetResponse{}, err
 	}
@@ -166,7 +217,11 @@ func (s *Server) RoleGet(ctx context.Context, req *pb.AuthRoleGetRequest) (*pb.A
 
 // RoleList gets a list of all rolls.
 func (s *Server) RoleList(ctx context.Context, req *pb.AuthRoleListRequest) (*pb.AuthRoleListResponse, error) {
-	resp, err := s.cache.Server.RoleList(ctx, &etcdserverpb.AuthRoleListRequest{})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthRoleListResponse{}, err
+	}
+	resp, err := a.RoleList(ctx, &etcdserverpb.AuthRoleListRequest{})
 	if err != nil {
 		return &pb.AuthRoleListResponse{}, err
 	}
@@ -178,7 +233,11 @@ func (s *Server) RoleList(ctx context.Context, req *pb.AuthRoleListRequest) (*pb
 
 // RoleDelete deletes a specified role.
 func (s *Server) RoleDelete(ctx context.Context, req *pb.AuthRoleDeleteRequest) (*pb.AuthRoleDeleteResponse, error) {
-	resp, err := s.cache.Server.RoleDelete(ctx, &etcdserverpb.AuthRoleDeleteRequest{Role: req.Role})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthRoleDeleteResponse{}, err
+	}
+	resp, err := a.RoleDelete(ctx, &etcdserverpb.AuthRoleDeleteRequest{Role: req.Role})
 	if err != nil {
 		return &pb.AuthRoleDeleteResponse{}, err
 	}
@@ -189,10 +248,35 @@ func (s *Server) RoleDelete(ctx context.Context, req *pb.AuthRoleDeleteRequest)
 
 // RoleGrantPermission grants a permission of a specified key or range to a specified role.
 func (s *Server) RoleGrantPermission(ctx context.Context, req *pb.AuthRoleGrantPermissionRequest) (*pb.AuthRoleGrantPermissionResponse, error) {
-	resp, err := s.cache.Server.RoleGrantPermission(ctx, &etcdserverpb.AuthRoleGrantPermissionRequest{Name: req.Name, Perm: s.convertPermission(req.Perm)})
+	perm := s.convertPermission(req.Perm)
+	a, err := s.auth()
 	if err != nil {
 		return &pb.AuthRoleGrantPermissionResponse{}, err
 	}
+	resp, err := a.RoleGrantPermission(ctx, &etcdserverpb.AuthRoleGrantPermissionRequest{Name: req.Name, Perm: perm})
+	if err != nil {
+		return &pb.AuthRoleGrantPermissionResponse{}, err
+	}
+
+	// locks and expirations are kept in the reserved namespace, so the role also needs permission on those of the keys.
+	for _, ip := range internalPermissions(perm) {
+		resp, err = a.RoleGrantPermission(ctx, &etcdserverpb.AuthRoleGrantPermissionRequest{Name: req.Name, Perm: ip})
+		if err != nil {
+			return &pb.AuthRoleGrantPermissionResponse{}, err
+		}
+	}
+
+	// writes fail if an index was created or dropped since they read the index registries, so writing any key
+	// means reading them. They're kept when the permission is revoked, since other permissions may need them.
+	if perm.PermType != authpb.READ {
+		for _, key := range []string{fieldIndexRegistry, searchIndexRegistry} {
+			rp := &authpb.Permission{Key: util.StringToBytes(key), PermType: authpb.READ}
+			resp, err = a.RoleGrantPermission(ctx, &etcdserverpb.AuthRoleGrantPermissionRequest{Name: req.Name, Perm: rp})
+			if err != nil {
+				return &pb.AuthRoleGrantPermissionResponse{}, err
+			}
+		}
+	}
 	return &pb.AuthRoleGrantPermissionResponse{
 		Header: s.convertHeader(resp.Header),
 	}, err
@@ -200,10 +284,21 @@ func (s *Server) RoleGrantPermission(ctx context.Context, req *pb.AuthRoleGrantP
 
 // RoleRevokePermission revokes a permission of a specified key or range from a specified role.
 func (s *Server) RoleRevokePermission(ctx context.Context, req *pb.AuthRoleRevokePermissionRequest) (*pb.AuthRoleRevokePermissionResponse, error) {
-	resp, err := s.cache.Server.RoleRevokePermission(ctx, &etcdserverpb.AuthRoleRevokePermissionRequest{Key: req.Key, RangeEnd: req.RangeEnd, Role: req.Role})
+	a, err := s.auth()
+	if err != nil {
+		return &pb.AuthRoleRevokePermissionResponse{}, err
+	}
+	resp, err := a.RoleRevokePermission(ctx, &etcdserverpb.AuthRoleRevokePermissionRequest{Key: req.Key, RangeEnd: req.RangeEnd, Role: req.Role})
 	if err != nil {
 		return &pb.AuthRoleRevokePermissionResponse{}, err
 	}
+
+	// permissions granted before internal keys were moved to the reserved namespace don't have internal permissions.
+	for _, ip := range internalPermissions(&authpb.Permission{Key: util.StringToBytes(req.Key), RangeEnd: util.StringToBytes(req.RangeEnd)}) {
+		if _, err := a.RoleRevokePermission(ctx, &etcdserverpb.AuthRoleRevokePermissionRequest{Key: util.BytesToString(ip.Key), RangeEnd: util.BytesToString(ip.RangeEnd), Role: req.Role}); err != nil && err != auth.ErrPermissionNotGranted {
+			return &pb.AuthRoleRevokePermissionResponse{}, err
+		}
+	}
 	return &pb.AuthRoleRevokePermissionResponse{
 		Header: s.convertHeader(resp.Header),
 	}, err
@@ -230,6 +325,47 @@ func (s *Server) convertPermission(p *pb.Permission) *authpb.Permission {
 	}
 }
 
+// internalPermissions returns the permissions on the locks, expirations, vectors and search entries of the keys covered
+// by the given permission, and on the leases named like them. Writing a key means checking its lock, and writing it with a lease
+// means reading the lease, so any permission that allows writing also allows reading and writing both. Publishing to a
+// channel means writing its key, so the channels named like the keys are covered too.
+func internalPermissions(p *authpb.Permission) []*authpb.Permission {
+	lp := namespacedPermission(p, prefixForLocks)
+	np := namespacedPermission(p, prefixForLeases)
+	if p.PermType != authpb.READ {
+		lp.PermType = authpb.READWRITE
+		np.PermType = authpb.READWRITE
+	}
+
+	// the vectors of a vector index and the entries of a search index are named after it, so a permission on the
+	// index alone covers all of them.
+	vp := namespacedPermission(p, prefixForVectors)
+	sp := namespacedPermission(p, prefixForSearch)
+	if len(p.RangeEnd) == 0 {
+		prefix := getVectorPrefix(util.BytesToString(p.Key))
+		vp.Key = util.StringToBytes(prefix)
+		vp.RangeEnd = getPrefix(prefix)
+		prefix = getSearchPrefix(util.BytesToString(p.Key))
+		sp.Key = util.StringToBytes(prefix)
+		sp.RangeEnd = getPrefix(prefix)
+	}
+	return []*authpb.Permission{lp, namespacedPermission(p, prefixForExpirations), np, vp, sp, namespacedPermission(p, prefixForPubSub)}
+}
+
+// namespacedPermission returns the given permission moved into a namespace of the reserved keyspace.
+func namespacedPermission(p *authpb.Permission, prefix string) *authpb.Permission {
+	np := &authpb.Permission{
+		Key:      util.StringToBytes(prefix + util.BytesToString(p.Key)),
+		PermType: p.PermType,
+	}
+	if bytes.Equal(p.RangeEnd, ZeroByte) {
+		np.RangeEnd = getPrefix(prefix)
+	} else if len(p.RangeEnd) > 0 {
+		np.RangeEnd = util.StringToBytes(prefix + util.BytesToString(p.RangeEnd))
+	}
+	return np
+}
+
 func (s *Server) convertPermissions(p []*authpb.Permission) []*pb.Permission {
 	if p == nil {
 		return []*pb.Permission{}
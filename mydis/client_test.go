@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	myc "github.com/deejross/mydis/client"
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
 )
@@ -88,6 +89,83 @@ func TestClientSetExpire(t *testing.T) {
 	}
 }
 
+func TestClientTTL(t *testing.T) {
+	testReset()
+
+	if err := client.SetWithExpire("key2", "val2", 20); err != nil {
+		t.Error(err)
+	}
+	if ttl, err := client.TTL("key2"); err != nil {
+		t.Error(err)
+	} else if ttl < 19 || ttl > 20 {
+		t.Error("Unexpected TTL:", ttl)
+	}
+
+	if err := client.ExpireAtMillis("key1", time.Now().Add(5*time.Second).UnixNano()/int64(time.Millisecond)); err != nil {
+		t.Error(err)
+	}
+	if ttl, err := client.TTLMillis("key1"); err != nil {
+		t.Error(err)
+	} else if ttl <= 4000 || ttl > 5000 {
+		t.Error("Unexpected TTL:", ttl)
+	}
+
+	if b, err := client.Persist("key1"); err != nil {
+		t.Error(err)
+	} else if !b {
+		t.Error("Expected key with expiration")
+	}
+	if ttl, err := client.TTL("key1"); err != nil {
+		t.Error(err)
+	} else if ttl != -1 {
+		t.Error("Unexpected TTL:", ttl)
+	}
+}
+
+func TestClientLease(t *testing.T) {
+	testReset()
+
+	if err := client.GrantLease("session1", 2); err != nil {
+		t.Fatal(err)
+	}
+	stop, err := client.KeepAlive("session1", 500*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.SetLease("session1")
+	if err := client.Set("lease1", "val1"); err != nil {
+		t.Error(err)
+	}
+	client.SetLease("")
+
+	t.Log("INFO: Waiting three seconds to make sure the lease is kept alive")
+	time.Sleep(3000 * time.Millisecond)
+	if b, err := client.Has("lease1"); err != nil {
+		t.Error(err)
+	} else if !b {
+		t.Error("Expected key not found")
+	}
+	stop()
+
+	if info, err := client.GetLease("session1"); err != nil {
+		t.Error(err)
+	} else if len(info.Keys) != 1 || info.Keys[0] != "lease1" {
+		t.Error("Unexpected keys:", info.Keys)
+	}
+	if err := client.RevokeLease("session1"); err != nil {
+		t.Error(err)
+	}
+	if b, err := client.Has("lease1"); err != nil {
+		t.Error(err)
+	} else if b {
+		t.Error("Unexpected key found")
+	}
+	if _, err := client.KeepAlive("session1", time.Second); err != util.ErrLeaseNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
 func TestClientLock(t *testing.T) {
 	testReset()
 
@@ -113,6 +191,51 @@ func TestClientLock(t *testing.T) {
 	}
 }
 
+func TestClientLockMany(t *testing.T) {
+	if err := client.LockMany([]string{"transfer/from", "transfer/to"}, 1); err != nil {
+		t.Error(err)
+	}
+	if err := client.LockMany([]string{"transfer/to", "transfer/other"}, 0); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if err := client.UnlockMany([]string{"transfer/from", "transfer/to"}); err != nil {
+		t.Error(err)
+	}
+	if err := client.LockMany([]string{"transfer/to", "transfer/other"}, 0); err != nil {
+		t.Error(err)
+	}
+	if err := client.UnlockMany([]string{"transfer/to", "transfer/other"}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClientLockOwner(t *testing.T) {
+	owned, err := myc.NewClient(myc.NewClientConfig("localhost:8383"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer owned.Close()
+	owned.SetLockOwner("worker1")
+
+	if err := owned.Lock("owned/counter"); err != nil {
+		t.Error(err)
+	}
+	if i, err := owned.IncrementInt("owned/counter", 1); err != nil {
+		t.Error(err)
+	} else if i != 1 {
+		t.Error("Unexpected value:", i)
+	}
+	if err := client.Unlock("owned/counter"); err != util.ErrLockNotOwned {
+		t.Error("Unexpected or no error:", err)
+	}
+	if err := owned.Unlock("owned/counter"); err != nil {
+		t.Error(err)
+	}
+	if err := client.Set("owned/counter", 5); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestClientDelete(t *testing.T) {
 	if err := client.Delete("key1"); err != nil {
 		t.Error(err)
@@ -500,6 +623,215 @@ func TestClientHashValues(t *testing.T) {
 	}
 }
 
+func TestClientTimeSeries(t *testing.T) {
+	if err := client.TimeSeriesCreate("ts1", time.Hour, myc.NewDownsampleRule("ts1_sum", pb.Aggregation_SUM, time.Minute)); err != nil {
+		t.Error(err)
+	}
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := client.TimeSeriesAdd("ts1", now.Add(time.Duration(i)*time.Millisecond), 1.5); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if samples, err := client.TimeSeriesRange("ts1", now, time.Time{}, pb.Aggregation_AVG, 0); err != nil {
+		t.Error(err)
+	} else if len(samples) != 1 || samples[0].Value != 1.5 {
+		t.Error("Unexpected value:", samples)
+	}
+
+	if samples, err := client.GetTimeSeries("ts1_sum"); err != nil {
+		t.Error(err)
+	} else if len(samples) == 0 || samples[len(samples)-1].Value < 1.5 {
+		t.Error("Unexpected value:", samples)
+	}
+}
+
+func TestClientJSON(t *testing.T) {
+	doc := map[string]interface{}{"name": "mydis", "tags": []string{"a"}, "count": 1}
+	if err := client.JSONSet("json1", "$", doc); err != nil {
+		t.Error(err)
+	}
+
+	if l, err := client.JSONArrAppend("json1", "$.tags", "b"); err != nil {
+		t.Error(err)
+	} else if l != 2 {
+		t.Error("Unexpected value:", l)
+	}
+
+	if f, err := client.JSONNumIncrBy("json1", "$.count", 2); err != nil {
+		t.Error(err)
+	} else if f != 3 {
+		t.Error("Unexpected value:", f)
+	}
+
+	if err := client.JSONDel("json1", "$.name"); err != nil {
+		t.Error(err)
+	}
+
+	if s, err := client.JSONGet("json1", "").String(); err != nil {
+		t.Error(err)
+	} else if s != `{"count":3,"tags":["a","b"]}` {
+		t.Error("Unexpected value:", s)
+	}
+
+	if err := client.JSONGet("json1", "$.name").Error(); err != util.ErrJSONPathNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestClientVector(t *testing.T) {
+	if err := client.VectorIndexCreate("cvec", 3, pb.VectorMetric_COSINE, 0); err != nil {
+		t.Error(err)
+	}
+
+	client.SetHashField("cvec_meta", "kind", "b")
+	if err := client.VectorAdd("cvec", "a", []float32{1, 0, 0}, ""); err != nil {
+		t.Error(err)
+	}
+	if err := client.VectorAdd("cvec", "b", []float32{0.9, 0.1, 0}, "cvec_meta"); err != nil {
+		t.Error(err)
+	}
+
+	if matches, err := client.VectorSearch("cvec", []float32{1, 0, 0}, 1, nil); err != nil {
+		t.Error(err)
+	} else if len(matches) != 1 || matches[0].Id != "a" {
+		t.Error("Unexpected value:", matches)
+	}
+
+	if matches, err := client.VectorSearchApprox("cvec", []float32{1, 0, 0}, 1, 0, map[string]util.Value{"kind": util.NewValue("b")}); err != nil {
+		t.Error(err)
+	} else if len(matches) != 1 || matches[0].Id != "b" {
+		t.Error("Unexpected value:", matches)
+	}
+
+	if err := client.VectorAdd("cvec", "c", []float32{1}, ""); err != util.ErrVectorDimensionMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestClientSearch(t *testing.T) {
+	client.SetHashField("article:1", "body", "The quick brown fox")
+	if err := client.SearchIndexCreate("articles", "article:", "body"); err != nil {
+		t.Error(err)
+	}
+	client.SetHashField("article:2", "body", "A quick brown dog")
+
+	if results, total, err := client.Search("articles", "quick bro*", 0, 0); err != nil {
+		t.Error(err)
+	} else if total != 2 || len(results) != 2 {
+		t.Error("Unexpected value:", results)
+	}
+
+	if results, _, err := client.Search("articles", `"brown fox"`, 0, 0); err != nil {
+		t.Error(err)
+	} else if len(results) != 1 || results[0].Key != "article:1" {
+		t.Error("Unexpected value:", results)
+	}
+
+	if err := client.SearchIndexDrop("articles"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClientFieldIndex(t *testing.T) {
+	if err := client.FieldIndexCreate("account/", "score", true); err != nil {
+		t.Error(err)
+	}
+	client.SetHashField("account/1", "score", 10)
+	client.SetHashField("account/2", "score", 20)
+
+	if keys, err := client.FindByFieldRange("account/", "score", 15, 100); err != nil {
+		t.Error(err)
+	} else if len(keys) != 1 || keys[0] != "account/2" {
+		t.Error("Unexpected value:", keys)
+	}
+
+	if keys, err := client.FindByField("account/", "score", 10); err != nil {
+		t.Error(err)
+	} else if len(keys) != 1 || keys[0] != "account/1" {
+		t.Error("Unexpected value:", keys)
+	}
+
+	if err := client.FieldIndexDrop("account/", "score"); err != nil {
+		t.Error(err)
+	}
+	if _, err := client.FindByField("account/", "score", 10); err != util.ErrFieldIndexNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestClientCompareAndSwap(t *testing.T) {
+	if ok, rev, err := client.SetIfModRevision("cas/1", 0, "a"); err != nil {
+		t.Error(err)
+	} else if !ok || rev == 0 {
+		t.Error("Unexpected value:", ok, rev)
+	}
+
+	v, rev := client.GetWithRevision("cas/1")
+	if s, err := v.String(); err != nil {
+		t.Error(err)
+	} else if s != "a" {
+		t.Error("Unexpected value:", s)
+	}
+
+	if ok, _, err := client.CompareAndSwap("cas/1", "b", "c"); err != nil {
+		t.Error(err)
+	} else if ok {
+		t.Error("Unexpected value:", ok)
+	}
+	if ok, _, err := client.CompareAndSwap("cas/1", "a", "b"); err != nil {
+		t.Error(err)
+	} else if !ok {
+		t.Error("Unexpected value:", ok)
+	}
+	if ok, current, err := client.SetIfModRevision("cas/1", rev, "d"); err != nil {
+		t.Error(err)
+	} else if ok || current <= rev {
+		t.Error("Unexpected value:", ok, current)
+	}
+
+	if m, revs, err := client.GetManyWithRevision([]string{"cas/1", "cas/2"}); err != nil {
+		t.Error(err)
+	} else if len(m) != 1 || revs["cas/1"] <= rev {
+		t.Error("Unexpected value:", m, revs)
+	}
+}
+
+func TestClientTxn(t *testing.T) {
+	client.Set("txn/status", "open")
+
+	ok, results, rev, err := client.Txn().
+		IfValue("txn/status", "open").
+		IfNotExists("txn/missing").
+		Then().IncrementInt("txn/count", 2).Set("txn/status", "closed").
+		Else().Set("txn/status", "failed").
+		Commit()
+	if err != nil {
+		t.Error(err)
+	} else if !ok || len(results) != 2 || rev == 0 {
+		t.Error("Unexpected value:", ok, results, rev)
+	} else if i, _ := results[0].Int(); i != 2 {
+		t.Error("Unexpected value:", i)
+	}
+
+	if ok, _, _, err := client.Txn().IfValue("txn/status", "open").Then().Delete("txn/status").Else().SetInt("txn/count", 10).Commit(); err != nil {
+		t.Error(err)
+	} else if ok {
+		t.Error("Unexpected value:", ok)
+	}
+	if i, err := client.Get("txn/count").Int(); err != nil {
+		t.Error(err)
+	} else if i != 10 {
+		t.Error("Unexpected value:", i)
+	}
+
+	if _, _, _, err := client.Txn().Then().Set("txn/status", struct{}{}).Commit(); err == nil {
+		t.Error("Expected error")
+	}
+}
+
 func TestClientProto(t *testing.T) {
 	if err := client.Set("proto1", &pb.Event{Current: &pb.ByteValue{Key: "test"}}); err != nil {
 		t.Error(err)
@@ -578,6 +910,31 @@ func TestClientAuthAccess(t *testing.T) {
 	}
 }
 
+func TestClientAuthForceUnlock(t *testing.T) {
+	client.Authenticate("writer", "writer")
+	if err := client.Lock("key6"); err != nil {
+		t.Error(err)
+	}
+	if locks, err := client.ListLocks("key6"); err != nil {
+		t.Error(err)
+	} else if len(locks) != 1 || locks[0].Key != "key6" {
+		t.Error("Unexpected value:", locks)
+	}
+	if err := client.ForceUnlock("key6"); err == nil {
+		t.Error("Expected an auth error")
+	}
+
+	client.Authenticate("root", "root")
+	if err := client.ForceUnlock("key6"); err != nil {
+		t.Error(err)
+	}
+	if locks, err := client.ListLocks("key6"); err != nil {
+		t.Error(err)
+	} else if len(locks) != 0 {
+		t.Error("Unexpected value:", locks)
+	}
+}
+
 func TestClientAuthDisable(t *testing.T) {
 	client.Authenticate("root", "root")
 
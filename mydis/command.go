@@ -0,0 +1,98 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// CommandHandler handles a custom command. The handler is given the context of the call, which carries the
+// caller's authentication token, lock owner and lease, so calling the server's functions with it acts on behalf of
+// the caller. The returned value is encoded the same way the client encodes values.
+type CommandHandler func(ctx context.Context, s *Server, args []util.Value) (interface{}, error)
+
+// commandRegistry holds the custom commands registered on a server.
+type commandRegistry struct {
+	handlers map[string]CommandHandler
+	lock     sync.RWMutex
+}
+
+func newCommandRegistry() *commandRegistry {
+	return &commandRegistry{handlers: map[string]CommandHandler{}}
+}
+
+// RegisterCommand registers a handler for a custom command, which clients can run with the Command function.
+// Commands can be registered before or after the server is started.
+func (s *Server) RegisterCommand(name string, handler CommandHandler) error {
+	if len(name) == 0 || handler == nil {
+		return util.ErrInvalidCommand
+	}
+
+	s.commands.lock.Lock()
+	defer s.commands.lock.Unlock()
+	if _, ok := s.commands.handlers[name]; ok {
+		return util.ErrCommandExists
+	}
+	s.commands.handlers[name] = handler
+	return nil
+}
+
+// UnregisterCommand removes a custom command.
+func (s *Server) UnregisterCommand(name string) {
+	s.commands.lock.Lock()
+	defer s.commands.lock.Unlock()
+	delete(s.commands.handlers, name)
+}
+
+// Commands returns the names of the custom commands registered on the server.
+func (s *Server) Commands() []string {
+	s.commands.lock.RLock()
+	defer s.commands.lock.RUnlock()
+
+	names := []string{}
+	for name := range s.commands.handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Command runs a custom command.
+func (s *Server) Command(ctx context.Context, req *pb.CommandRequest) (*pb.ByteValue, error) {
+	s.commands.lock.RLock()
+	handler, ok := s.commands.handlers[req.Name]
+	s.commands.lock.RUnlock()
+	if !ok {
+		return nil, util.ErrCommandNotFound
+	}
+
+	v, err := handler(ctx, s, util.ListToValues(req.Args))
+	if err != nil {
+		return nil, err
+	} else if v == nil {
+		return &pb.ByteValue{Key: req.Name, Value: []byte{}}, nil
+	}
+
+	b, err := util.NewValue(v).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ByteValue{Key: req.Name, Value: b}, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestCommand(t *testing.T) {
+	testReset()
+
+	// reserve takes one from a stock count while holding its lock.
+	reserve := func(ctx context.Context, s *Server, args []util.Value) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errors.New("reserve takes a key")
+		}
+		key, err := args[0].String()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.Lock(ctx, &pb.Key{Key: key}); err != nil {
+			return nil, err
+		}
+
+		iv, err := s.GetInt(ctx, &pb.Key{Key: key})
+		if err != nil || iv.Value <= 0 {
+			s.Unlock(ctx, &pb.Key{Key: key})
+			return false, err
+		}
+		b, _ := util.NewValue(iv.Value - 1).Bytes()
+		if _, err := s.UnlockThenSet(ctx, &pb.ByteValue{Key: key, Value: b}); err != nil {
+			return nil, err
+		}
+		return true, nil
+	}
+
+	if err := server.RegisterCommand("reserve", reserve); err != nil {
+		t.Fatal(err)
+	}
+	defer server.UnregisterCommand("reserve")
+	if err := server.RegisterCommand("reserve", reserve); err != util.ErrCommandExists {
+		t.Error("Unexpected or no error:", err)
+	}
+	if err := server.RegisterCommand("", reserve); err != util.ErrInvalidCommand {
+		t.Error("Unexpected or no error:", err)
+	}
+	if names := server.Commands(); len(names) != 1 || names[0] != "reserve" {
+		t.Error("Unexpected commands:", names)
+	}
+
+	client.Set("stock", 1)
+	for _, expected := range []bool{true, false} {
+		if ok, err := client.Command("reserve", "stock").Bool(); err != nil {
+			t.Error(err)
+		} else if ok != expected {
+			t.Error("Expected reserve to return", expected)
+		}
+	}
+	if i, err := client.Get("stock").Int(); err != nil {
+		t.Error(err)
+	} else if i != 0 {
+		t.Error("Unexpected value:", i)
+	}
+
+	if v := client.Command("reserve"); grpc.ErrorDesc(v.Error()) != "reserve takes a key" {
+		t.Error("Expected handler error, got:", v.Error())
+	}
+	if v := client.Command("missing"); v.Error() != util.ErrCommandNotFound {
+		t.Error("Unexpected or no error:", v.Error())
+	}
+}
@@ -0,0 +1,526 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/backend"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// EvictionPolicy decides which keys are evicted to make room once the cache is full.
+type EvictionPolicy int
+
+const (
+	// NoEviction never evicts keys, so writes fail once the storage quota is reached.
+	NoEviction EvictionPolicy = iota
+	// AllKeysLRU evicts the keys that were used least recently.
+	AllKeysLRU
+	// AllKeysLFU evicts the keys that are used least often.
+	AllKeysLFU
+	// VolatileTTL evicts the keys closest to expiring, and never evicts keys without an expiration.
+	VolatileTTL
+)
+
+var evictionPolicyNames = []string{"noeviction", "allkeys-lru", "allkeys-lfu", "volatile-ttl"}
+
+// ParseEvictionPolicy returns the eviction policy with the given name: noeviction, allkeys-lru, allkeys-lfu or
+// volatile-ttl.
+func ParseEvictionPolicy(name string) (EvictionPolicy, error) {
+	for i, n := range evictionPolicyNames {
+		if strings.EqualFold(name, n) {
+			return EvictionPolicy(i), nil
+		}
+	}
+	return NoEviction, util.ErrInvalidEvictionPolicy
+}
+
+func (p EvictionPolicy) String() string {
+	if p < 0 || int(p) >= len(evictionPolicyNames) {
+		return "unknown"
+	}
+	return evictionPolicyNames[p]
+}
+
+// WithEviction evicts keys with the given policy once the keys and their values take up more than maxBytes. If
+// maxBytes is 0, the limit is a share of the storage quota, leaving room for the old revisions etcd keeps until
+// they're compacted. Keys are also evicted once etcd's database takes up that share of the quota, and etcd is
+// compacted and defragmented after keys are evicted, so the space they took up is released.
+func WithEviction(policy EvictionPolicy, maxBytes int64) Option {
+	return func(s *Server) {
+		s.evictionPolicy = policy
+		s.evictionMaxBytes = maxBytes
+	}
+}
+
+// evictionQuotaRatio is the share of the storage quota keys and values can take up when no limit is given.
+var evictionQuotaRatio = 0.75
+
+// evictionTargetRatio is the share of the limit a full cache is evicted down to, so eviction doesn't run on every
+// write once the cache is full.
+var evictionTargetRatio = 0.95
+
+// evictionRetryInterval is how long eviction waits before trying again when it couldn't free up enough space.
+var evictionRetryInterval = time.Second
+
+// evictionDecayInterval is how often the access counts used by AllKeysLFU are halved, so keys that were popular a
+// long time ago can still be evicted.
+var evictionDecayInterval = time.Minute
+
+// evictionMarkerTTL is the number of seconds the markers left by evicted keys are kept. Watches read a marker at
+// the revision its key was evicted, so it doesn't need to be around for long.
+var evictionMarkerTTL = int64(60)
+
+// evictionBatchSize is the number of keys read at a time while loading the keys to track.
+var evictionBatchSize = int64(1000)
+
+// evictionCompactWait is how long compaction waits for watches to look up why the evicted keys were deleted, which
+// needs the revisions before they were.
+var evictionCompactWait = time.Second
+
+// evictor tracks the size of the keys and when they're used, evicting keys when the cache is full. Writes are
+// tracked by watching the storage, so writes made by other members of a cluster are tracked as well, while reads
+// are tracked by the server's read functions. Each member evicts keys on its own, based on the reads it serves.
+type evictor struct {
+	s           *Server
+	policy      EvictionPolicy
+	maxBytes    int64
+	maxDBBytes  int64
+	compacted   int64
+	stream      mvcc.WatchStream
+	keys        map[string]*evictionEntry
+	expirations map[string]evictionExpiration
+	size        int64
+	clock       uint64
+	rev         int64
+	next        time.Time
+	failing     bool
+	closeCh     chan struct{}
+	doneCh      chan struct{}
+	lock        sync.Mutex
+}
+
+// evictionEntry is what's tracked for a key.
+type evictionEntry struct {
+	size     int64
+	rev      int64
+	lease    int64
+	accessed uint64
+	hits     uint32
+	decayed  time.Time
+}
+
+// evictionExpiration is the time a key set with an expiration expires, along with the lease it belongs to.
+type evictionExpiration struct {
+	at    time.Time
+	lease int64
+}
+
+// evictionCandidate is a key that can be evicted, ordered by its score and then by when it was last used.
+type evictionCandidate struct {
+	key      string
+	rev      int64
+	score    int64
+	accessed uint64
+}
+
+func newEvictor(s *Server) *evictor {
+	quota := s.config.QuotaBackendBytes
+	if quota <= 0 {
+		quota = backend.DefaultQuotaBytes
+	}
+	maxDBBytes := int64(float64(quota) * evictionQuotaRatio)
+	maxBytes := s.evictionMaxBytes
+	if maxBytes <= 0 {
+		maxBytes = maxDBBytes
+	}
+
+	return &evictor{
+		s:           s,
+		policy:      s.evictionPolicy,
+		maxBytes:    maxBytes,
+		maxDBBytes:  maxDBBytes,
+		keys:        map[string]*evictionEntry{},
+		expirations: map[string]evictionExpiration{},
+		closeCh:     make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// start loads the keys already in the storage, then watches for changes from the revision they were loaded at.
+func (e *evictor) start(ctx context.Context) error {
+	rev := e.s.storage.Rev()
+	expirations := util.StringToBytes(prefixForExpirations)
+	if err := e.load(ctx, firstUserKey, ZeroByte, rev); err != nil {
+		return err
+	}
+	if err := e.load(ctx, expirations, getPrefix(prefixForExpirations), rev); err != nil {
+		return err
+	}
+
+	// an empty range end watches every key after the start.
+	e.stream = e.s.storage.NewWatchStream()
+	e.stream.Watch(firstUserKey, []byte{}, rev+1)
+	e.stream.Watch(expirations, getPrefix(prefixForExpirations), rev+1)
+	e.rev = rev
+
+	go e.run()
+	return nil
+}
+
+// load tracks the keys in a range as they were at the given revision.
+func (e *evictor) load(ctx context.Context, start, end []byte, rev int64) error {
+	for {
+		res, err := e.s.storage.Range(ctx, &etcdpb.RangeRequest{
+			Key:      start,
+			RangeEnd: end,
+			Limit:    evictionBatchSize,
+			Revision: rev,
+		})
+		if err != nil {
+			return err
+		}
+
+		e.lock.Lock()
+		for _, kv := range res.Kvs {
+			e.apply(mvccpb.Event{Type: mvccpb.PUT, Kv: kv}, time.Now())
+		}
+		e.lock.Unlock()
+
+		if !res.More || len(res.Kvs) == 0 {
+			return nil
+		}
+		start = append(append([]byte{}, res.Kvs[len(res.Kvs)-1].Key...), 0)
+	}
+}
+
+func (e *evictor) run() {
+	defer close(e.doneCh)
+	for {
+		select {
+		case r, ok := <-e.stream.Chan():
+			if !ok {
+				return
+			}
+			now := time.Now()
+			e.lock.Lock()
+			for _, ev := range r.Events {
+				e.apply(ev, now)
+			}
+			if r.Revision > e.rev {
+				e.rev = r.Revision
+			}
+			full := (e.size > e.maxBytes || e.dbFull()) && !now.Before(e.next)
+			e.lock.Unlock()
+
+			if full {
+				e.evict(now)
+			}
+		case <-e.closeCh:
+			return
+		}
+	}
+}
+
+// apply tracks a change to a key. Writes count as a use of the key. The lock must be held.
+func (e *evictor) apply(ev mvccpb.Event, now time.Time) {
+	key := string(ev.Kv.Key)
+	if strings.HasPrefix(key, prefixForExpirations) {
+		key = strings.TrimPrefix(key, prefixForExpirations)
+		at := &pb.IntValue{}
+		if ev.Type == mvccpb.DELETE || proto.Unmarshal(ev.Kv.Value, at) != nil {
+			delete(e.expirations, key)
+		} else {
+			e.expirations[key] = evictionExpiration{at: fromMillis(at.Value), lease: ev.Kv.Lease}
+		}
+		return
+	}
+
+	en, ok := e.keys[key]
+	if ev.Type == mvccpb.DELETE {
+		// keys removed by eviction are no longer tracked by the time their deletes are seen.
+		if ok {
+			e.size -= en.size
+			delete(e.keys, key)
+		}
+		return
+	}
+
+	if !ok {
+		en = &evictionEntry{decayed: now}
+		e.keys[key] = en
+	}
+	e.size += int64(len(ev.Kv.Key)+len(ev.Kv.Value)) - en.size
+	en.size = int64(len(ev.Kv.Key) + len(ev.Kv.Value))
+	en.rev = ev.Kv.ModRevision
+	en.lease = ev.Kv.Lease
+	e.access(en, now)
+}
+
+// touch records a read of a key. Keys that aren't tracked, such as internal keys, are ignored.
+func (e *evictor) touch(key []byte) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if en, ok := e.keys[string(key)]; ok {
+		e.access(en, time.Now())
+	}
+}
+
+// access records a use of a key. The lock must be held.
+func (e *evictor) access(en *evictionEntry, now time.Time) {
+	e.clock++
+	en.accessed = e.clock
+	if en.frequency(now) < math.MaxUint32 {
+		en.hits++
+	}
+}
+
+// frequency returns the number of times a key was used, halved for every decay interval since it was last decayed.
+func (en *evictionEntry) frequency(now time.Time) uint32 {
+	if n := now.Sub(en.decayed) / evictionDecayInterval; n > 0 {
+		if n >= 32 {
+			en.hits = 0
+		} else {
+			en.hits >>= uint(n)
+		}
+		en.decayed = en.decayed.Add(n * evictionDecayInterval)
+	}
+	return en.hits
+}
+
+// candidates returns the keys the policy allows to be evicted, in the order they should be evicted.
+func (e *evictor) candidates(now time.Time) []evictionCandidate {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	candidates := make([]evictionCandidate, 0, len(e.keys))
+	for key, en := range e.keys {
+		c := evictionCandidate{key: key, rev: en.rev, accessed: en.accessed}
+		switch e.policy {
+		case AllKeysLFU:
+			c.score = int64(en.frequency(now))
+		case VolatileTTL:
+			if en.lease == 0 {
+				continue
+			}
+			// keys on named leases expire only if the lease isn't kept alive, so they go last.
+			c.score = math.MaxInt64
+			if exp, ok := e.expirations[key]; ok && exp.lease == en.lease {
+				c.score = exp.at.UnixNano()
+			}
+		}
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score < candidates[j].score
+		}
+		return candidates[i].accessed < candidates[j].accessed
+	})
+	return candidates
+}
+
+// evict removes keys until the cache is back under its target size, then compacts the storage so the space they
+// took up is released. If that isn't possible, eviction waits before trying again.
+func (e *evictor) evict(now time.Time) {
+	ctx := context.Background()
+	target := int64(float64(e.maxBytes) * evictionTargetRatio)
+	err := func() error {
+		// a database that's full may only need the old revisions written since it was last compacted to be removed.
+		if size, ok := e.dbSize(); ok && size > e.maxDBBytes && size > e.compacted {
+			if err := e.compact(ctx); err != nil {
+				return err
+			}
+		}
+		// if it's still full, the keys are evicted down to the share of their size that the database's target is of
+		// its size.
+		if size, ok := e.dbSize(); ok && size > e.maxDBBytes {
+			dbTarget := int64(float64(e.tracked()) * float64(e.maxDBBytes) * evictionTargetRatio / float64(size))
+			if dbTarget < target {
+				target = dbTarget
+			}
+		}
+
+		candidates := e.candidates(now)
+		if len(candidates) == 0 || e.tracked() <= target {
+			return nil
+		}
+
+		// markers are on a lease, so they're cleaned up on their own.
+		lease, err := e.s.storage.LeaseGrant(ctx, &etcdpb.LeaseGrantRequest{TTL: evictionMarkerTTL})
+		if err != nil {
+			return err
+		}
+		for _, c := range candidates {
+			if e.tracked() <= target {
+				break
+			}
+			if err := e.evictKey(ctx, c.key, c.rev, lease.ID); err != nil {
+				return err
+			}
+		}
+		return e.compact(ctx)
+	}()
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if err != nil && !e.failing {
+		log.Println("Unable to evict keys:", err)
+	}
+	e.failing = err != nil
+	if e.size > target || e.dbFull() {
+		e.next = now.Add(evictionRetryInterval)
+	}
+}
+
+// dbSize returns the size of the storage's database, and false if the storage doesn't have one that needs to be
+// defragmented.
+func (e *evictor) dbSize() (int64, bool) {
+	if ds, ok := e.s.storage.(defragStorage); ok {
+		return ds.DBSize(), true
+	}
+	return 0, false
+}
+
+// dbFull returns true if the storage's database is over its limit.
+func (e *evictor) dbFull() bool {
+	size, ok := e.dbSize()
+	return ok && size > e.maxDBBytes
+}
+
+// compact removes the revisions before the current one and defragments the database, releasing the space taken up
+// by the keys that were deleted. Storage that doesn't need to be defragmented compacts itself.
+func (e *evictor) compact(ctx context.Context) error {
+	ds, ok := e.s.storage.(defragStorage)
+	if !ok {
+		return nil
+	}
+
+	// watches look up keys at the revision before they were deleted, so they're given a chance to catch up first.
+	rev := e.s.storage.Rev()
+	for wait := time.Now().Add(evictionCompactWait); !e.s.wc.caughtUp(rev) && time.Now().Before(wait); {
+		e.s.wc.RequestProgress()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	_, err := e.s.storage.Compact(ctx, &etcdpb.CompactionRequest{Revision: rev, Physical: true})
+	if err != nil && err != mvcc.ErrCompacted {
+		return err
+	}
+	if err := ds.Defragment(); err != nil {
+		return err
+	}
+	e.compacted = ds.DBSize()
+	return nil
+}
+
+// evictKey deletes a key, along with its expiration, index entries, and the vectors and search entries of an index
+// it holds, and leaves a marker so watches can tell it was evicted. It's only deleted if it hasn't changed since it
+// was tracked and isn't locked.
+func (e *evictor) evictKey(ctx context.Context, key string, rev, lease int64) error {
+	bkey := util.StringToBytes(key)
+	ops := []*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: bkey,
+				},
+			},
+		},
+		{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: getExpirationName(key),
+				},
+			},
+		},
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   getEvictionName(key),
+					Lease: lease,
+				},
+			},
+		},
+		clearVectorsOp(key),
+		clearSearchIndexOp(key),
+	}
+
+	compares, indexOps, err := e.s.indexOps(ctx, key, nil, 0)
+	if err != nil {
+		return err
+	}
+	res, err := e.s.storage.Txn(ctx, &etcdpb.TxnRequest{
+		Compare: append([]*etcdpb.Compare{
+			txnModCompare(bkey, rev),
+			txnModCompare(getLockName(key), 0),
+		}, compares...),
+		Success: append(ops, indexOps...),
+	})
+	if err != nil {
+		return err
+	} else if !res.Succeeded {
+		return nil
+	}
+
+	// the key stops being tracked now, rather than when its delete is seen, so it isn't evicted twice.
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if en, ok := e.keys[key]; ok && en.rev == rev {
+		e.size -= en.size
+		delete(e.keys, key)
+	}
+	return nil
+}
+
+// tracked returns the size of the tracked keys and values.
+func (e *evictor) tracked() int64 {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.size
+}
+
+// caughtUp returns true once the evictor has tracked every change up to the given revision.
+func (e *evictor) caughtUp(rev int64) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.rev >= rev
+}
+
+func (e *evictor) close() {
+	close(e.closeCh)
+	<-e.doneCh
+	e.stream.Close()
+}
+
+// touch records a read of a key for eviction.
+func (s *Server) touch(key []byte) {
+	if s.evictor != nil {
+		s.evictor.touch(key)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	myc "github.com/deejross/mydis/client"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+// newEvictionServer returns a server on memory storage that evicts with the given policy once its keys take up more
+// than 1000 bytes, which is ten of the keys set by evictionSet. It isn't listening, so its functions are called
+// directly.
+func newEvictionServer(t *testing.T, policy EvictionPolicy) *Server {
+	s := NewServer(CopyConfig(server.config), WithStorage(NewMemoryStorage()), WithEviction(policy, 1000))
+	s.evictor = newEvictor(s)
+	if err := s.evictor.start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func closeEvictionServer(s *Server) {
+	s.evictor.close()
+	s.storage.Close()
+}
+
+// evictionSet sets a key that takes up 100 bytes, waiting until the evictor has tracked it.
+func evictionSet(t *testing.T, s *Server, key string, exp int64) {
+	val := []byte(strings.Repeat("v", 100-len(key)))
+	var err error
+	if exp > 0 {
+		_, err = s.SetWithExpire(ctx, &pb.ExpiringValue{Key: key, Value: val, Exp: exp})
+	} else {
+		_, err = s.Set(ctx, &pb.ByteValue{Key: key, Value: val})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rev := s.storage.Rev()
+	for i := 0; !s.evictor.caughtUp(rev); i++ {
+		if i == 100 {
+			t.Fatal("Evictor never caught up")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// checkEvicted waits for the evicted keys to be removed, then checks the kept keys are still there.
+func checkEvicted(t *testing.T, s *Server, evicted []string, kept []string) {
+	for _, key := range evicted {
+		for i := 0; ; i++ {
+			if i == 100 {
+				t.Fatal("Key never evicted:", key)
+			}
+			if _, err := s.GetWithRevision(ctx, &pb.Key{Key: key}); err == util.ErrKeyNotFound {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	for _, key := range kept {
+		if _, err := s.GetWithRevision(ctx, &pb.Key{Key: key}); err != nil {
+			t.Error("Expected key to be kept:", key, err)
+		}
+	}
+	if size := s.evictor.tracked(); size > 950 {
+		t.Error("Expected to evict down to the target size, got:", size)
+	}
+}
+
+func TestParseEvictionPolicy(t *testing.T) {
+	if p, err := ParseEvictionPolicy("allkeys-lru"); err != nil || p != AllKeysLRU {
+		t.Error("Unexpected policy:", p, err)
+	}
+	if p, err := ParseEvictionPolicy("Volatile-TTL"); err != nil || p != VolatileTTL || p.String() != "volatile-ttl" {
+		t.Error("Unexpected policy:", p, err)
+	}
+	if _, err := ParseEvictionPolicy("allkeys-random"); err != util.ErrInvalidEvictionPolicy {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestEvictionLRU(t *testing.T) {
+	s := newEvictionServer(t, AllKeysLRU)
+	defer closeEvictionServer(s)
+
+	for i := 0; i < 10; i++ {
+		evictionSet(t, s, fmt.Sprint("lru", i), 0)
+	}
+	if _, err := s.Get(ctx, &pb.Key{Key: "lru0"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// locked keys are skipped.
+	if _, err := s.Lock(ctx, &pb.Key{Key: "lru1"}); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Unlock(ctx, &pb.Key{Key: "lru1"})
+
+	evictionSet(t, s, "lru10", 0)
+	checkEvicted(t, s, []string{"lru2", "lru3"}, []string{"lru0", "lru1", "lru4", "lru10"})
+}
+
+func TestEvictionLFU(t *testing.T) {
+	s := newEvictionServer(t, AllKeysLFU)
+	defer closeEvictionServer(s)
+
+	for i := 0; i < 10; i++ {
+		evictionSet(t, s, fmt.Sprint("lfu", i), 0)
+	}
+	for i := 0; i < 10; i++ {
+		if i != 3 && i != 7 {
+			s.GetMany(ctx, &pb.KeysList{Keys: []string{fmt.Sprint("lfu", i)}})
+		}
+	}
+
+	// lfu10 has been used as often as the keys that weren't read, but more recently.
+	evictionSet(t, s, "lfu10", 0)
+	checkEvicted(t, s, []string{"lfu3", "lfu7"}, []string{"lfu0", "lfu9", "lfu10"})
+}
+
+func TestEvictionVolatileTTL(t *testing.T) {
+	s := newEvictionServer(t, VolatileTTL)
+	defer closeEvictionServer(s)
+
+	for i := 0; i < 8; i++ {
+		evictionSet(t, s, fmt.Sprint("ttl", i), 0)
+	}
+	evictionSet(t, s, "ttl8", 200)
+	evictionSet(t, s, "ttl9", 100)
+
+	evictionSet(t, s, "ttl10", 0)
+	checkEvicted(t, s, []string{"ttl9", "ttl8"}, []string{"ttl0", "ttl7", "ttl10"})
+
+	// only keys with an expiration are evicted, so there's nothing left to evict.
+	evictionSet(t, s, "ttl11", 0)
+	evictionSet(t, s, "ttl12", 0)
+	if size := s.evictor.tracked(); size != 1100 {
+		t.Error("Expected no more keys to be evicted, got size:", size)
+	}
+}
+
+func TestEvictionServer(t *testing.T) {
+	s := NewServer(CopyConfig(server.config), WithStorage(NewMemoryStorage()), WithEviction(AllKeysLRU, 1000))
+	if err := s.Start(":8005", ":8388"); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	c, err := myc.NewClient(myc.NewClientConfig("localhost:8388"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ch, id := c.NewEventChannel()
+	defer c.CloseEventChannel(id)
+	c.WatchReasons("server", true, pb.Event_EVICTED)
+	time.Sleep(100 * time.Millisecond)
+
+	for i := 0; i < 11; i++ {
+		evictionSet(t, s, fmt.Sprint("server", i), 0)
+	}
+
+	select {
+	case ev := <-ch:
+		if ev.Current.Key != "server0" || ev.Reason != pb.Event_EVICTED || len(ev.Previous.Value) == 0 {
+			t.Error("Unexpected event:", ev)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Never got event")
+	}
+}
+
+// defragMemoryStorage is memory storage with a database that takes up twice the size of its keys and values.
+type defragMemoryStorage struct {
+	*MemoryStorage
+	defrags int32
+}
+
+func (m *defragMemoryStorage) DBSize() int64 {
+	res, err := m.Range(ctx, &etcdpb.RangeRequest{Key: firstUserKey, RangeEnd: ZeroByte})
+	if err != nil {
+		return 0
+	}
+	size := int64(0)
+	for _, kv := range res.Kvs {
+		size += int64(len(kv.Key) + len(kv.Value))
+	}
+	return size * 2
+}
+
+func (m *defragMemoryStorage) Defragment() error {
+	atomic.AddInt32(&m.defrags, 1)
+	return nil
+}
+
+func TestEvictionDBSize(t *testing.T) {
+	storage := &defragMemoryStorage{MemoryStorage: NewMemoryStorage()}
+	config := CopyConfig(server.config)
+	config.QuotaBackendBytes = 2000
+	s := NewServer(config, WithStorage(storage), WithEviction(AllKeysLRU, 1<<20))
+	s.wc = NewWatchController(s.storage)
+	defer s.wc.Close()
+	s.evictor = newEvictor(s)
+	if err := s.evictor.start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer closeEvictionServer(s)
+
+	// the keys are well under their limit, but the database is over three quarters of the quota once they take up
+	// more than 750 bytes, so they're evicted down to 95% of the share of them that fits.
+	for i := 0; i < 10; i++ {
+		evictionSet(t, s, fmt.Sprint("db", i), 0)
+	}
+	checkEvicted(t, s, []string{"db0", "db1", "db2"}, []string{"db3", "db4", "db5", "db6", "db7", "db8", "db9"})
+
+	// the database is compacted and defragmented after each round.
+	if n := atomic.LoadInt32(&storage.defrags); n == 0 {
+		t.Error("Expected the database to be defragmented")
+	}
+	if size := storage.DBSize(); size > s.evictor.maxDBBytes {
+		t.Error("Expected the database to be under its limit, got:", size)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"strings"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// legacySuffixForLocks is the suffix locks were named with before they were moved to the reserved namespace.
+var legacySuffixForLocks = "*_MYDIS_LOCK"
+
+// migratedMarker is set once the internal keys have been migrated, so the keyspace is only scanned once.
+var migratedMarker = prefixForInternal + "MIGRATED"
+
+// migrateBatchSize is the number of keys read at a time while migrating.
+var migrateBatchSize = int64(1000)
+
+// migrateInternalKeys moves the locks stored by earlier versions alongside user keys into the reserved namespace.
+// Each key is moved in its own transaction, keeping its value and lease, and only if it hasn't changed since it was
+// read and hasn't already been replaced by a key in the new location, so it's safe to run while the cache is in use
+// and on every member of a cluster at the same time. Once every key has been checked, a marker is set so later
+// starts don't scan the keyspace again.
+func (s *Server) migrateInternalKeys(ctx context.Context) error {
+	marker := util.StringToBytes(migratedMarker)
+	if res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: marker, CountOnly: true}); err != nil {
+		return err
+	} else if res.Count > 0 {
+		return nil
+	}
+
+	start := firstUserKey
+	for {
+		res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+			Key:      start,
+			RangeEnd: ZeroByte,
+			Limit:    migrateBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, kv := range res.Kvs {
+			key := util.BytesToString(kv.Key)
+			newKey, ok := migratedKeyName(key)
+			if !ok {
+				continue
+			}
+
+			bNewKey := util.StringToBytes(newKey)
+			if _, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: []*etcdpb.Compare{
+					txnModCompare(kv.Key, kv.ModRevision),
+					txnModCompare(bNewKey, 0),
+				},
+				Success: []*etcdpb.RequestOp{
+					{
+						Request: &etcdpb.RequestOp_RequestPut{
+							RequestPut: &etcdpb.PutRequest{
+								Key:   bNewKey,
+								Value: kv.Value,
+								Lease: kv.Lease,
+							},
+						},
+					},
+					{
+						Request: &etcdpb.RequestOp_RequestDeleteRange{
+							RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+								Key: kv.Key,
+							},
+						},
+					},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+
+		if !res.More || len(res.Kvs) == 0 {
+			_, err := s.storage.Put(ctx, &etcdpb.PutRequest{Key: marker})
+			return err
+		}
+		start = append(append([]byte{}, res.Kvs[len(res.Kvs)-1].Key...), 0)
+	}
+}
+
+// migratedKeyName returns the name of a lock in the reserved namespace, given its name from earlier versions,
+// or false if the key isn't a lock.
+func migratedKeyName(key string) (string, bool) {
+	if !strings.HasSuffix(key, legacySuffixForLocks) {
+		return "", false
+	}
+	return prefixForLocks + strings.TrimSuffix(key, legacySuffixForLocks), true
+}
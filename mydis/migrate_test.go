@@ -0,0 +1,66 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+func TestMigrateInternalKeys(t *testing.T) {
+	testReset()
+
+	server.storage.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{Key: util.StringToBytes(migratedMarker)})
+	if _, err := server.storage.Put(ctx, &etcdpb.PutRequest{Key: util.StringToBytes("key1" + legacySuffixForLocks), Value: ZeroByte}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.migrateInternalKeys(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if lst, err := server.Keys(ctx, null); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "key1" {
+		t.Error("Unexpected keys:", lst.Keys)
+	}
+	if infos, err := server.ListLocks(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if len(infos.Locks) != 1 {
+		t.Error("Expected migrated lock:", infos.Locks)
+	}
+
+	if _, err := server.Unlock(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	}
+
+	// once migrated, the keyspace isn't scanned again.
+	legacyKey := util.StringToBytes("key2" + legacySuffixForLocks)
+	if _, err := server.storage.Put(ctx, &etcdpb.PutRequest{Key: legacyKey, Value: ZeroByte}); err != nil {
+		t.Fatal(err)
+	}
+	if err := server.migrateInternalKeys(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if res, err := server.storage.Range(ctx, &etcdpb.RangeRequest{Key: legacyKey, CountOnly: true}); err != nil {
+		t.Error(err)
+	} else if res.Count != 1 {
+		t.Error("Expected key not to be migrated again")
+	}
+	server.storage.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{Key: legacyKey})
+}
@@ -0,0 +1,205 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// Option configures a Server, and is given to NewServer.
+type Option func(s *Server)
+
+// SetHook is called before a key is set. Returning an error stops the key from being set, and the error is returned
+// to the caller.
+type SetHook func(ctx context.Context, key string, value []byte) error
+
+// AfterSetHook is called after a key is set.
+type AfterSetHook func(ctx context.Context, key string, value []byte)
+
+// DeleteHook is called before a key is deleted. Returning an error stops the key from being deleted, and the error
+// is returned to the caller.
+type DeleteHook func(ctx context.Context, key string) error
+
+// AfterDeleteHook is called after a key is deleted.
+type AfterDeleteHook func(ctx context.Context, key string)
+
+// hooks holds the functions called around writes to keys. Hooks are only called for keys written through the
+// server's functions, including transactions and scripts, and not for keys that expire or are evicted.
+type hooks struct {
+	beforeSet    []SetHook
+	afterSet     []AfterSetHook
+	beforeDelete []DeleteHook
+	afterDelete  []AfterDeleteHook
+}
+
+// WithUnaryInterceptor adds an interceptor to every unary gRPC call, including the calls made through the HTTP
+// gateway. Interceptors are called in the order they're added.
+func WithUnaryInterceptor(interceptor grpc.UnaryServerInterceptor) Option {
+	return func(s *Server) {
+		s.unaryInterceptors = append(s.unaryInterceptors, interceptor)
+	}
+}
+
+// WithStreamInterceptor adds an interceptor to every streaming gRPC call, such as Watch and Subscribe.
+// Interceptors are called in the order they're added.
+func WithStreamInterceptor(interceptor grpc.StreamServerInterceptor) Option {
+	return func(s *Server) {
+		s.streamInterceptors = append(s.streamInterceptors, interceptor)
+	}
+}
+
+// WithHTTPMiddleware wraps the HTTP gateway's handler. The first middleware added is the outermost.
+func WithHTTPMiddleware(middleware func(http.Handler) http.Handler) Option {
+	return func(s *Server) {
+		s.middleware = append(s.middleware, middleware)
+	}
+}
+
+// WithStorage keeps the server's keys in the given storage, such as a MemoryStorage, instead of starting the
+// embedded etcd server. The storage is closed when the server is.
+func WithStorage(storage Storage) Option {
+	return func(s *Server) {
+		s.storage = storage
+	}
+}
+
+// WithBeforeSet adds a hook that's called before a key is set. If a hook is called by a transaction or script that
+// has to be retried because its keys were changed, it's called again.
+func WithBeforeSet(hook SetHook) Option {
+	return func(s *Server) {
+		s.hooks.beforeSet = append(s.hooks.beforeSet, hook)
+	}
+}
+
+// WithAfterSet adds a hook that's called after a key is set.
+func WithAfterSet(hook AfterSetHook) Option {
+	return func(s *Server) {
+		s.hooks.afterSet = append(s.hooks.afterSet, hook)
+	}
+}
+
+// WithBeforeDelete adds a hook that's called before a key is deleted. If a hook is called by a transaction or
+// script that has to be retried because its keys were changed, it's called again.
+func WithBeforeDelete(hook DeleteHook) Option {
+	return func(s *Server) {
+		s.hooks.beforeDelete = append(s.hooks.beforeDelete, hook)
+	}
+}
+
+// WithAfterDelete adds a hook that's called after a key is deleted.
+func WithAfterDelete(hook AfterDeleteHook) Option {
+	return func(s *Server) {
+		s.hooks.afterDelete = append(s.hooks.afterDelete, hook)
+	}
+}
+
+// beforeSet calls the before set hooks, stopping at the first error. Internal keys don't call hooks.
+func (s *Server) beforeSet(ctx context.Context, key string, value []byte) error {
+	if isInternalKey(key) {
+		return nil
+	}
+	for _, hook := range s.hooks.beforeSet {
+		if err := hook(ctx, key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) afterSet(ctx context.Context, key string, value []byte) {
+	if isInternalKey(key) {
+		return
+	}
+	for _, hook := range s.hooks.afterSet {
+		hook(ctx, key, value)
+	}
+}
+
+// beforeDelete calls the before delete hooks, stopping at the first error. Internal keys don't call hooks.
+func (s *Server) beforeDelete(ctx context.Context, key string) error {
+	if isInternalKey(key) {
+		return nil
+	}
+	for _, hook := range s.hooks.beforeDelete {
+		if err := hook(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) afterDelete(ctx context.Context, key string) {
+	if isInternalKey(key) {
+		return
+	}
+	for _, hook := range s.hooks.afterDelete {
+		hook(ctx, key)
+	}
+}
+
+// serverOptions returns the gRPC server options for the interceptors, chaining them into one of each kind, which
+// is all gRPC allows.
+func (s *Server) serverOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{}
+	if len(s.unaryInterceptors) > 0 {
+		opts = append(opts, grpc.UnaryInterceptor(chainUnaryInterceptors(s.unaryInterceptors)))
+	}
+	if len(s.streamInterceptors) > 0 {
+		opts = append(opts, grpc.StreamInterceptor(chainStreamInterceptors(s.streamInterceptors)))
+	}
+	return opts
+}
+
+// chainUnaryInterceptors returns an interceptor that calls each of the interceptors in order, the last one calling
+// the handler.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors returns an interceptor that calls each of the interceptors in order, the last one calling
+// the handler.
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, inner)
+			}
+		}
+		return next(srv, ss)
+	}
+}
+
+// httpHandler wraps the gateway's handler in the HTTP middleware.
+func (s *Server) httpHandler() http.Handler {
+	var handler http.Handler = WebsocketProxy(s.gwmux)
+	for i := len(s.middleware) - 1; i >= 0; i-- {
+		handler = s.middleware[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,167 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestHooks(t *testing.T) {
+	testReset()
+
+	errReadOnly := errors.New("read only")
+	calls := []string{}
+	for _, opt := range []Option{
+		WithBeforeSet(func(ctx context.Context, key string, value []byte) error {
+			if strings.HasPrefix(key, "readonly") {
+				return errReadOnly
+			}
+			calls = append(calls, "before set "+key)
+			return nil
+		}),
+		WithAfterSet(func(ctx context.Context, key string, value []byte) {
+			calls = append(calls, "after set "+key+"="+string(value))
+		}),
+		WithBeforeDelete(func(ctx context.Context, key string) error {
+			if strings.HasPrefix(key, "readonly") {
+				return errReadOnly
+			}
+			calls = append(calls, "before delete "+key)
+			return nil
+		}),
+		WithAfterDelete(func(ctx context.Context, key string) {
+			calls = append(calls, "after delete "+key)
+		}),
+	} {
+		opt(server)
+	}
+	defer func() {
+		server.hooks = hooks{}
+	}()
+
+	server.Set(ctx, &pb.ByteValue{Key: "hook1", Value: []byte("a")})
+	server.Delete(ctx, &pb.Key{Key: "hook1"})
+	server.Txn(ctx, &pb.TxnRequest{Success: []*pb.TxnOp{{Type: pb.TxnOp_SET, Key: "hook2", Value: []byte("b")}}})
+	server.Eval(ctx, &pb.EvalRequest{Script: `mydis.delete("hook2")`})
+
+	expected := []string{
+		"before set hook1", "after set hook1=a",
+		"before delete hook1", "after delete hook1",
+		"before set hook2", "after set hook2=b",
+		"before delete hook2", "after delete hook2",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Error("Unexpected hook calls:", calls)
+	}
+
+	if _, err := server.Set(ctx, &pb.ByteValue{Key: "readonly1", Value: []byte("a")}); err != errReadOnly {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Get(ctx, &pb.Key{Key: "readonly1"}); err != util.ErrKeyNotFound {
+		t.Error("Expected hook to stop the key from being set, got:", err)
+	}
+	if _, err := server.Delete(ctx, &pb.Key{Key: "readonly1"}); err != errReadOnly {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Txn(ctx, &pb.TxnRequest{Success: []*pb.TxnOp{
+		{Type: pb.TxnOp_SET, Key: "hook3", Value: []byte("c")},
+		{Type: pb.TxnOp_SET, Key: "readonly2", Value: []byte("d")},
+	}}); err != errReadOnly {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Get(ctx, &pb.Key{Key: "hook3"}); err != util.ErrKeyNotFound {
+		t.Error("Expected hook to stop the whole transaction, got:", err)
+	}
+
+	// a rejected write through a lock releases the lock.
+	if _, err := server.Lock(ctx, &pb.Key{Key: "readonly3"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.UnlockThenSet(ctx, &pb.ByteValue{Key: "readonly3", Value: []byte("e")}); err != errReadOnly {
+		t.Error("Unexpected or no error:", err)
+	}
+	if locks, err := server.ListLocks(ctx, &pb.Key{Key: "readonly3"}); err != nil {
+		t.Error(err)
+	} else if len(locks.Locks) != 0 {
+		t.Error("Expected lock to be released, got:", locks.Locks)
+	}
+}
+
+func TestInterceptorChains(t *testing.T) {
+	calls := []string{}
+	unary := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	chain := chainUnaryInterceptors([]grpc.UnaryServerInterceptor{unary("first"), unary("second")})
+	res, err := chain(ctx, "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return req, nil
+	})
+	if err != nil || res != "req" {
+		t.Error("Unexpected result:", res, err)
+	}
+	if !reflect.DeepEqual(calls, []string{"first", "second", "handler"}) {
+		t.Error("Unexpected unary calls:", calls)
+	}
+
+	calls = []string{}
+	stream := func(name string) grpc.StreamServerInterceptor {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			calls = append(calls, name)
+			return handler(srv, ss)
+		}
+	}
+	streamChain := chainStreamInterceptors([]grpc.StreamServerInterceptor{stream("first"), stream("second")})
+	if err := streamChain(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		calls = append(calls, "handler")
+		return nil
+	}); err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(calls, []string{"first", "second", "handler"}) {
+		t.Error("Unexpected stream calls:", calls)
+	}
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	s := NewServer(server.config, WithHTTPMiddleware(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}))
+
+	w := httptest.NewRecorder()
+	s.httpHandler().ServeHTTP(w, httptest.NewRequest("POST", "/v1/get", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Error("Expected middleware to reject the request, got:", w.Code)
+	}
+}
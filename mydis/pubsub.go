@@ -0,0 +1,338 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// PubSub object, which sends messages published to channels to their subscribers. Messages are published by writing
+// them to a reserved key named after their channel, which is deleted right away, so they aren't kept. Every server in
+// the cluster watches those keys, and sends the messages to the subscribers connected to it.
+type PubSub struct {
+	closed   bool
+	channels map[string]map[*Subscriber]struct{}
+	patterns map[string]map[*Subscriber]struct{}
+	subs     map[*Subscriber]struct{}
+	closeCh  chan struct{}
+	stream   mvcc.WatchStream
+	lock     sync.RWMutex
+}
+
+// NewPubSub returns a new PubSub object, which gets the messages published to the given storage.
+func NewPubSub(storage Storage) *PubSub {
+	p := &PubSub{
+		channels: map[string]map[*Subscriber]struct{}{},
+		patterns: map[string]map[*Subscriber]struct{}{},
+		subs:     map[*Subscriber]struct{}{},
+		closeCh:  make(chan struct{}),
+		stream:   storage.NewWatchStream(),
+	}
+	p.watch()
+	go p.backgroundProcess()
+	return p
+}
+
+// watch the keys messages are published to from the current revision. Deletes are left out, since they only remove
+// messages that were already sent.
+func (p *PubSub) watch() {
+	p.stream.Watch(util.StringToBytes(prefixForPubSub), getPrefix(prefixForPubSub), 0, func(e mvccpb.Event) bool {
+		return e.Type == mvccpb.DELETE
+	})
+}
+
+func (p *PubSub) backgroundProcess() {
+	streamCh := p.stream.Chan()
+	for {
+		select {
+		case <-p.closeCh:
+			return
+		case r, ok := <-streamCh:
+			if !ok {
+				return
+			}
+			if r.CompactRevision != 0 {
+				// the watch fell behind and the messages it missed are gone, so it carries on with the new ones.
+				p.watch()
+				continue
+			}
+			for _, e := range r.Events {
+				channel := strings.TrimPrefix(util.BytesToString(e.Kv.Key), prefixForPubSub)
+				p.Publish(&pb.PubSubMessage{Channel: channel, Message: e.Kv.Value})
+			}
+		}
+	}
+}
+
+// Subscriber object, which holds the subscriptions of a single stream.
+type Subscriber struct {
+	ps       *PubSub
+	messages chan *pb.PubSubMessage
+	done     chan struct{}
+	closed   bool
+	err      error
+	channels map[string]struct{}
+	patterns map[string]struct{}
+	lock     sync.Mutex
+}
+
+// NewSubscriber returns a new Subscriber object.
+func (p *PubSub) NewSubscriber() *Subscriber {
+	sub := &Subscriber{
+		ps:       p,
+		messages: make(chan *pb.PubSubMessage, watchBufferSize),
+		done:     make(chan struct{}),
+		channels: map[string]struct{}{},
+		patterns: map[string]struct{}{},
+	}
+
+	p.lock.Lock()
+	if p.closed {
+		sub.stop(nil)
+	} else {
+		p.subs[sub] = struct{}{}
+	}
+	p.lock.Unlock()
+	return sub
+}
+
+// Publish a message to a channel on this server only, returning the number of subscribers it was sent to.
+func (p *PubSub) Publish(msg *pb.PubSubMessage) int64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	count := int64(0)
+	for sub := range p.channels[msg.Channel] {
+		sub.send(&pb.PubSubMessage{Channel: msg.Channel, Message: msg.Message})
+		count++
+	}
+	for pattern, subs := range p.patterns {
+		if !globMatch(pattern, msg.Channel) {
+			continue
+		}
+		for sub := range subs {
+			sub.send(&pb.PubSubMessage{Channel: msg.Channel, Message: msg.Message, Pattern: pattern})
+			count++
+		}
+	}
+	return count
+}
+
+// Channels returns the channels with subscribers that match a pattern, or every channel if the pattern is empty,
+// and the number of patterns subscribed to.
+func (p *PubSub) Channels(pattern string) *pb.PubSubChannelList {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	res := &pb.PubSubChannelList{Channels: []*pb.PubSubChannel{}, Patterns: int64(len(p.patterns))}
+	for channel, subs := range p.channels {
+		if len(pattern) == 0 || globMatch(pattern, channel) {
+			res.Channels = append(res.Channels, &pb.PubSubChannel{Channel: channel, Subscribers: int64(len(subs))})
+		}
+	}
+	sort.Slice(res.Channels, func(i, j int) bool {
+		return res.Channels[i].Channel < res.Channels[j].Channel
+	})
+	return res
+}
+
+// subscribers returns the number of subscribers to a channel, including the ones subscribed to a matching pattern.
+func (p *PubSub) subscribers(channel string) int64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	count := int64(len(p.channels[channel]))
+	for pattern, subs := range p.patterns {
+		if globMatch(pattern, channel) {
+			count += int64(len(subs))
+		}
+	}
+	return count
+}
+
+// Close the PubSub, ending every Subscriber.
+func (p *PubSub) Close() {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+	p.closed = true
+	for sub := range p.subs {
+		sub.stop(nil)
+	}
+	p.lock.Unlock()
+
+	close(p.closeCh)
+	p.stream.Close()
+}
+
+// Subscribe to, or unsubscribe from, channels and channel patterns.
+func (s *Subscriber) Subscribe(r *pb.SubscribeRequest) {
+	p := s.ps
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.subs[s]; !ok {
+		return
+	}
+	for _, channel := range r.Channels {
+		if r.Unsubscribe {
+			unsubscribeFrom(p.channels, s.channels, channel, s)
+		} else {
+			subscribeTo(p.channels, s.channels, channel, s)
+		}
+	}
+	for _, pattern := range r.Patterns {
+		if r.Unsubscribe {
+			unsubscribeFrom(p.patterns, s.patterns, pattern, s)
+		} else {
+			subscribeTo(p.patterns, s.patterns, pattern, s)
+		}
+	}
+}
+
+// Err returns the error that ended the Subscriber, if any.
+func (s *Subscriber) Err() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+// Close the Subscriber, unsubscribing from everything.
+func (s *Subscriber) Close() {
+	p := s.ps
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for channel := range s.channels {
+		unsubscribeFrom(p.channels, s.channels, channel, s)
+	}
+	for pattern := range s.patterns {
+		unsubscribeFrom(p.patterns, s.patterns, pattern, s)
+	}
+	delete(p.subs, s)
+	s.stop(nil)
+}
+
+// send a message to the stream without waiting, disconnecting it as a slow consumer if its buffer is full.
+func (s *Subscriber) send(msg *pb.PubSubMessage) {
+	select {
+	case <-s.done:
+	case s.messages <- msg:
+	default:
+		s.stop(util.ErrSlowConsumer)
+	}
+}
+
+// stop the Subscriber, which ends its stream with the given error.
+func (s *Subscriber) stop(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.closed {
+		s.closed = true
+		s.err = err
+		close(s.done)
+	}
+}
+
+func subscribeTo(all map[string]map[*Subscriber]struct{}, own map[string]struct{}, name string, s *Subscriber) {
+	if _, ok := all[name]; !ok {
+		all[name] = map[*Subscriber]struct{}{}
+	}
+	all[name][s] = struct{}{}
+	own[name] = struct{}{}
+}
+
+func unsubscribeFrom(all map[string]map[*Subscriber]struct{}, own map[string]struct{}, name string, s *Subscriber) {
+	if subs, ok := all[name]; ok {
+		delete(subs, s)
+		if len(subs) == 0 {
+			delete(all, name)
+		}
+	}
+	delete(own, name)
+}
+
+// Publish sends a message to the subscribers of a channel on every server in the cluster, returning the number of
+// subscribers connected to this server.
+func (s *Server) Publish(ctx context.Context, msg *pb.PubSubMessage) (*pb.IntValue, error) {
+	if len(msg.Channel) == 0 {
+		return nil, util.ErrInvalidChannel
+	}
+
+	// every server sends the message to its subscribers once it sees the write, so the key can be deleted right away.
+	key := util.StringToBytes(prefixForPubSub + msg.Channel)
+	count := s.ps.subscribers(msg.Channel)
+	if _, err := s.storage.Put(ctx, &etcdpb.PutRequest{Key: key, Value: msg.Message}); err != nil {
+		return nil, err
+	}
+	if _, err := s.storage.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{Key: key}); err != nil {
+		return nil, err
+	}
+	return &pb.IntValue{Value: count}, nil
+}
+
+// Subscribe to channels and channel patterns.
+func (s *Server) Subscribe(stream pb.Mydis_SubscribeServer) error {
+	sub := s.ps.NewSubscriber()
+	defer sub.Close()
+
+	// receiver
+	recvCh := make(chan error, 1)
+	go func() {
+		for {
+			r, err := stream.Recv()
+			if err != nil {
+				recvCh <- err
+				return
+			}
+			sub.Subscribe(r)
+		}
+	}()
+
+	// sender
+	for {
+		select {
+		case msg := <-sub.messages:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-sub.done:
+			return sub.Err()
+		case err := <-recvCh:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// PubSubChannels lists the channels with subscribers connected to this server, matching an optional pattern.
+func (s *Server) PubSubChannels(ctx context.Context, key *pb.Key) (*pb.PubSubChannelList, error) {
+	return s.ps.Channels(key.Key), nil
+}
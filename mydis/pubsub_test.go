@@ -0,0 +1,173 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	myc "github.com/deejross/mydis/client"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+func TestPubSub(t *testing.T) {
+	testReset()
+
+	keys, err := client.Keys()
+	if err != nil {
+		t.Error(err)
+	}
+
+	sub, err := client.SubscribeChannels("news")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	if err := sub.SubscribePatterns("sport*"); err != nil {
+		t.Error(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	for i, channel := range []string{"news", "sports", "weather"} {
+		expected := int64(1)
+		if i == 2 {
+			expected = 0
+		}
+		if count, err := client.Publish(channel, "hello "+channel); err != nil {
+			t.Error(err)
+		} else if count != expected {
+			t.Error("Expected", channel, "to reach", expected, "subscribers, got:", count)
+		}
+	}
+
+	for _, expected := range []*pb.PubSubMessage{
+		{Channel: "news", Message: []byte("hello news")},
+		{Channel: "sports", Message: []byte("hello sports"), Pattern: "sport*"},
+	} {
+		select {
+		case msg := <-sub.Messages():
+			if msg.Channel != expected.Channel || !bytes.Equal(msg.Message, expected.Message) || msg.Pattern != expected.Pattern {
+				t.Error("Expected message:", expected, "got:", msg)
+			}
+		case <-time.After(1 * time.Second):
+			t.Error("Never got message:", expected)
+		}
+	}
+
+	list, err := client.PubSubChannels("n*")
+	if err != nil {
+		t.Error(err)
+	} else if len(list.Channels) != 1 || list.Channels[0].Channel != "news" || list.Channels[0].Subscribers != 1 {
+		t.Error("Unexpected channels:", list.Channels)
+	} else if list.Patterns != 1 {
+		t.Error("Expected 1 pattern, got:", list.Patterns)
+	}
+
+	if err := sub.Unsubscribe("news"); err != nil {
+		t.Error(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if count, err := client.Publish("news", "again"); err != nil {
+		t.Error(err)
+	} else if count != 0 {
+		t.Error("Expected no subscribers after unsubscribing, got:", count)
+	}
+
+	if _, err := client.Publish("", "nowhere"); err != util.ErrInvalidChannel {
+		t.Error("Expected ErrInvalidChannel, got:", err)
+	}
+
+	// messages are never written to the cache.
+	if after, err := client.Keys(); err != nil {
+		t.Error(err)
+	} else if len(after) != len(keys) {
+		t.Error("Expected publishing to leave the keys alone, got:", after)
+	}
+
+	sub.Close()
+	select {
+	case _, ok := <-sub.Messages():
+		if ok {
+			t.Error("Expected no more messages")
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Expected messages channel to be closed")
+	}
+	if sub.Err() != nil {
+		t.Error("Expected no error after closing, got:", sub.Err())
+	}
+}
+
+func TestPubSubCluster(t *testing.T) {
+	c1, err := myc.NewClient(myc.NewClientConfig("localhost:8384"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c1.Close()
+	c2, err := myc.NewClient(myc.NewClientConfig("localhost:8385"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c2.Close()
+	balanced, err := myc.NewClient(myc.NewClientConfigAddresses([]string{"localhost:8384", "localhost:8385", "localhost:8386"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer balanced.Close()
+
+	sub, err := c1.SubscribeChannels("cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	time.Sleep(100 * time.Millisecond)
+
+	// messages reach the subscriber from any server, but are only counted by the server it's connected to.
+	if count, err := c1.Publish("cluster", "local"); err != nil {
+		t.Error(err)
+	} else if count != 1 {
+		t.Error("Expected 1 subscriber, got:", count)
+	}
+	if count, err := c2.Publish("cluster", "remote"); err != nil {
+		t.Error(err)
+	} else if count != 0 {
+		t.Error("Expected no subscribers, got:", count)
+	}
+	for i := 0; i < 6; i++ {
+		if _, err := balanced.Publish("cluster", "balanced"); err != nil {
+			t.Error(err)
+		}
+	}
+
+	for _, expected := range []string{"local", "remote", "balanced", "balanced", "balanced", "balanced", "balanced", "balanced"} {
+		select {
+		case msg := <-sub.Messages():
+			if v, _ := util.NewValue(expected).Bytes(); !bytes.Equal(msg.Message, v) {
+				t.Error("Expected message:", expected, "got:", string(msg.Message))
+			}
+		case <-time.After(1 * time.Second):
+			t.Fatal("Never got message:", expected)
+		}
+	}
+
+	// the channels are listed by the server the subscriber is connected to.
+	if list, err := c2.PubSubChannels("cluster"); err != nil {
+		t.Error(err)
+	} else if len(list.Channels) != 0 {
+		t.Error("Unexpected channels:", list.Channels)
+	}
+}
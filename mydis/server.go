@@ -38,27 +38,43 @@ const (
 
 // Server object.
 type Server struct {
-	config  *embed.Config
-	cache   *embed.Etcd
-	socket  net.Listener
-	server  *grpc.Server
-	gwsock  net.Listener
-	gateway *http.Server
-	gwmux   *runtime.ServeMux
-	tc      *tls.Config
-	wc      *WatchController
+	config   *embed.Config
+	storage  Storage
+	socket   net.Listener
+	server   *grpc.Server
+	gwsock   net.Listener
+	gateway  *http.Server
+	gwmux    *runtime.ServeMux
+	tc       *tls.Config
+	wc       *WatchController
+	ps       *PubSub
+	scripts  *scriptCache
+	commands *commandRegistry
+	evictor  *evictor
+
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	middleware         []func(http.Handler) http.Handler
+	hooks              hooks
+	evictionPolicy     EvictionPolicy
+	evictionMaxBytes   int64
 }
 
-// NewServer returns a new Server object.
-func NewServer(config *embed.Config) *Server {
+// NewServer returns a new Server object, configured by the given options.
+func NewServer(config *embed.Config, opts ...Option) *Server {
 	if !config.Debug {
 		capnslog.SetGlobalLogLevel(capnslog.ERROR)
 	}
 
 	s := &Server{
-		config:  config,
-		gateway: &http.Server{},
-		gwmux:   runtime.NewServeMux(),
+		config:   config,
+		gateway:  &http.Server{},
+		gwmux:    runtime.NewServeMux(),
+		scripts:  newScriptCache(),
+		commands: newCommandRegistry(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 
 	return s
@@ -66,13 +82,32 @@ func NewServer(config *embed.Config) *Server {
 
 // Start the server.
 func (s *Server) Start(http1, http2 string) error {
-	e, err := embed.StartEtcd(s.config)
-	if err != nil {
-		return err
+	// the embedded etcd server is started unless the server was given other storage.
+	if s.storage == nil {
+		storage, err := newEtcdStorage(s.config)
+		if err != nil {
+			return err
+		}
+		s.storage = storage
+	}
+	s.wc = NewWatchController(s.storage)
+	s.ps = NewPubSub(s.storage)
+
+	// internal keys can't be read without the root role once authentication is enabled, in which case they are
+	// left where they are.
+	if err := s.migrateInternalKeys(context.Background()); err != nil {
+		log.Println("Unable to migrate internal keys:", err)
+	}
+
+	// like migrating, eviction can't read or delete keys without the root role once authentication is enabled.
+	if s.evictionPolicy != NoEviction {
+		evictor := newEvictor(s)
+		if err := evictor.start(context.Background()); err != nil {
+			log.Println("Unable to start eviction:", err)
+		} else {
+			s.evictor = evictor
+		}
 	}
-	<-e.Server.ReadyNotify()
-	s.cache = e
-	s.wc = NewWatchController(s.cache.Server)
 
 	socket, err := net.Listen("tcp", http2)
 	if err != nil {
@@ -93,7 +128,7 @@ func (s *Server) Start(http1, http2 string) error {
 	pb.RegisterMydisServer(s.server, s)
 	pb.RegisterMydisHandlerFromEndpoint(context.Background(), s.gwmux, http2, []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(s.tc))})
 	s.gateway.Addr = http1
-	s.gateway.Handler = WebsocketProxy(s.gwmux)
+	s.gateway.Handler = s.httpHandler()
 
 	fmt.Println("Mydis listening on HTTP/1.1:", http1, "HTTP/2 (gRPC):", http2)
 
@@ -117,8 +152,14 @@ func (s *Server) Start(http1, http2 string) error {
 
 // Close the server.
 func (s *Server) Close() {
+	// watch and subscribe streams never end on their own, so they're ended first to let the server stop.
+	s.wc.Close()
+	s.ps.Close()
 	s.server.GracefulStop()
-	s.cache.Close()
+	if s.evictor != nil {
+		s.evictor.close()
+	}
+	s.storage.Close()
 }
 
 func (s *Server) applyTLS() (err error) {
@@ -127,12 +168,13 @@ func (s *Server) applyTLS() (err error) {
 		return err
 	}
 
+	opts := s.serverOptions()
 	if s.tc == nil {
-		s.server = grpc.NewServer()
+		s.server = grpc.NewServer(opts...)
 	} else {
 		s.tc.InsecureSkipVerify = true
 		creds := grpc.Creds(credentials.NewTLS(s.tc))
-		s.server = grpc.NewServer(creds)
+		s.server = grpc.NewServer(append(opts, creds)...)
 	}
 
 	return nil
@@ -0,0 +1,133 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"github.com/coreos/etcd/auth"
+	"github.com/coreos/etcd/embed"
+	"github.com/coreos/etcd/etcdserver"
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// Storage is where a Server keeps its keys. It takes etcd's requests and gives etcd's responses, with the same
+// revisions, leases and errors, so the embedded etcd server is used as it is and other storage behaves the same way.
+type Storage interface {
+	Range(ctx context.Context, r *etcdpb.RangeRequest) (*etcdpb.RangeResponse, error)
+	Put(ctx context.Context, r *etcdpb.PutRequest) (*etcdpb.PutResponse, error)
+	DeleteRange(ctx context.Context, r *etcdpb.DeleteRangeRequest) (*etcdpb.DeleteRangeResponse, error)
+	Txn(ctx context.Context, r *etcdpb.TxnRequest) (*etcdpb.TxnResponse, error)
+	Compact(ctx context.Context, r *etcdpb.CompactionRequest) (*etcdpb.CompactionResponse, error)
+
+	LeaseGrant(ctx context.Context, r *etcdpb.LeaseGrantRequest) (*etcdpb.LeaseGrantResponse, error)
+	LeaseRevoke(ctx context.Context, r *etcdpb.LeaseRevokeRequest) (*etcdpb.LeaseRevokeResponse, error)
+	LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error)
+	LeaseTimeToLive(ctx context.Context, r *etcdpb.LeaseTimeToLiveRequest) (*etcdpb.LeaseTimeToLiveResponse, error)
+
+	// NewWatchStream returns a stream of the changes to the keys it watches.
+	NewWatchStream() mvcc.WatchStream
+	// Rev returns the current revision.
+	Rev() int64
+	// KeyAt returns a key as it was at the given revision, or nil if it didn't exist or the revision was compacted.
+	// It's used by watches, so it isn't subject to authentication.
+	KeyAt(key []byte, rev int64) *mvccpb.KeyValue
+	// KeysAt returns the keys in a range as they were at the given revision, or nil if the revision was compacted.
+	// It's used by watches, so it isn't subject to authentication.
+	KeysAt(key, end []byte, rev int64) []*mvccpb.KeyValue
+	// Close the storage.
+	Close()
+}
+
+// authStorage is storage that supports authentication.
+type authStorage interface {
+	etcdserver.Authenticator
+	AuthStore() auth.AuthStore
+}
+
+// defragStorage is storage with a database that keeps the space taken up by old revisions until it's defragmented.
+type defragStorage interface {
+	// DBSize returns the size of the database in bytes.
+	DBSize() int64
+	// Defragment releases the space taken up by the revisions that were compacted.
+	Defragment() error
+}
+
+// etcdStorage keeps keys in the embedded etcd server.
+type etcdStorage struct {
+	*etcdserver.EtcdServer
+	etcd *embed.Etcd
+}
+
+// newEtcdStorage starts the embedded etcd server, waiting until it's ready.
+func newEtcdStorage(config *embed.Config) (*etcdStorage, error) {
+	e, err := embed.StartEtcd(config)
+	if err != nil {
+		return nil, err
+	}
+	<-e.Server.ReadyNotify()
+	return &etcdStorage{EtcdServer: e.Server, etcd: e}, nil
+}
+
+func (e *etcdStorage) NewWatchStream() mvcc.WatchStream {
+	return e.Watchable().NewWatchStream()
+}
+
+func (e *etcdStorage) Rev() int64 {
+	return e.KV().Rev()
+}
+
+func (e *etcdStorage) KeyAt(key []byte, rev int64) *mvccpb.KeyValue {
+	res, err := e.KV().Range(key, nil, mvcc.RangeOptions{Rev: rev})
+	if err != nil || len(res.KVs) == 0 {
+		return nil
+	}
+	return &res.KVs[0]
+}
+
+func (e *etcdStorage) KeysAt(key, end []byte, rev int64) []*mvccpb.KeyValue {
+	res, err := e.KV().Range(key, end, mvcc.RangeOptions{Rev: rev})
+	if err != nil {
+		return nil
+	}
+	kvs := make([]*mvccpb.KeyValue, len(res.KVs))
+	for i := range res.KVs {
+		kvs[i] = &res.KVs[i]
+	}
+	return kvs
+}
+
+func (e *etcdStorage) DBSize() int64 {
+	return e.Backend().Size()
+}
+
+func (e *etcdStorage) Defragment() error {
+	return e.Backend().Defrag()
+}
+
+func (e *etcdStorage) Close() {
+	e.etcd.Close()
+}
+
+// auth returns the storage's authentication, or ErrAuthNotSupported if it doesn't have any.
+func (s *Server) auth() (authStorage, error) {
+	if a, ok := s.storage.(authStorage); ok {
+		return a, nil
+	}
+	return nil, util.ErrAuthNotSupported
+}
@@ -0,0 +1,844 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"golang.org/x/net/context"
+)
+
+// memoryHistorySize is the number of changes a MemoryStorage keeps for reading old revisions and resuming watches.
+// Older changes are compacted.
+var memoryHistorySize = 10000
+
+// memoryLeaseInterval is how often a MemoryStorage looks for expired leases.
+var memoryLeaseInterval = 100 * time.Millisecond
+
+// MemoryStorage keeps keys in memory, for embedded servers that only need a local cache, and for tests. Nothing is
+// written to disk and there's no cluster, so the keys are gone once the server stops, and authentication isn't
+// supported.
+type MemoryStorage struct {
+	kvs        map[string]*mvccpb.KeyValue
+	keys       []string
+	rev        int64
+	compactRev int64
+	history    []mvccpb.Event
+	pending    []mvccpb.Event
+	leases     map[int64]*memoryLease
+	nextLease  int64
+	streams    map[*memoryWatchStream]struct{}
+	closeCh    chan struct{}
+	closed     bool
+	lock       sync.RWMutex
+}
+
+// memoryLease is a lease on keys in a MemoryStorage.
+type memoryLease struct {
+	ttl    int64
+	expiry time.Time
+	keys   map[string]struct{}
+}
+
+// NewMemoryStorage returns a new MemoryStorage object.
+func NewMemoryStorage() *MemoryStorage {
+	m := &MemoryStorage{
+		kvs:     map[string]*mvccpb.KeyValue{},
+		keys:    []string{},
+		rev:     1,
+		leases:  map[int64]*memoryLease{},
+		streams: map[*memoryWatchStream]struct{}{},
+		closeCh: make(chan struct{}),
+	}
+	go m.expireLeases()
+	return m
+}
+
+// Range gets the keys in a range.
+func (m *MemoryStorage) Range(ctx context.Context, r *etcdpb.RangeRequest) (*etcdpb.RangeResponse, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.rangeKeys(r)
+}
+
+// Put sets a key.
+func (m *MemoryStorage) Put(ctx context.Context, r *etcdpb.PutRequest) (*etcdpb.PutResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.leaseFound(r.Lease) {
+		return nil, lease.ErrLeaseNotFound
+	}
+	res := m.put(r)
+	m.commit()
+	return res, nil
+}
+
+// DeleteRange deletes the keys in a range.
+func (m *MemoryStorage) DeleteRange(ctx context.Context, r *etcdpb.DeleteRangeRequest) (*etcdpb.DeleteRangeResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	res := m.deleteRange(r)
+	m.commit()
+	return res, nil
+}
+
+// Txn runs the success operations if every comparison is true, and the failure operations otherwise, as one
+// revision.
+func (m *MemoryStorage) Txn(ctx context.Context, r *etcdpb.TxnRequest) (*etcdpb.TxnResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	succeeded := true
+	for _, c := range r.Compare {
+		if !m.compare(c) {
+			succeeded = false
+			break
+		}
+	}
+	ops := r.Success
+	if !succeeded {
+		ops = r.Failure
+	}
+
+	// every operation is checked first, so an operation that would fail doesn't leave the others half done.
+	for _, op := range ops {
+		switch req := op.Request.(type) {
+		case *etcdpb.RequestOp_RequestPut:
+			if !m.leaseFound(req.RequestPut.Lease) {
+				return nil, lease.ErrLeaseNotFound
+			}
+		case *etcdpb.RequestOp_RequestRange:
+			if err := m.checkRev(req.RequestRange.Revision); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	responses := make([]*etcdpb.ResponseOp, len(ops))
+	for i, op := range ops {
+		switch req := op.Request.(type) {
+		case *etcdpb.RequestOp_RequestRange:
+			res, _ := m.rangeKeys(req.RequestRange)
+			responses[i] = &etcdpb.ResponseOp{Response: &etcdpb.ResponseOp_ResponseRange{ResponseRange: res}}
+		case *etcdpb.RequestOp_RequestPut:
+			res := m.put(req.RequestPut)
+			responses[i] = &etcdpb.ResponseOp{Response: &etcdpb.ResponseOp_ResponsePut{ResponsePut: res}}
+		case *etcdpb.RequestOp_RequestDeleteRange:
+			res := m.deleteRange(req.RequestDeleteRange)
+			responses[i] = &etcdpb.ResponseOp{Response: &etcdpb.ResponseOp_ResponseDeleteRange{ResponseDeleteRange: res}}
+		}
+	}
+	m.commit()
+
+	return &etcdpb.TxnResponse{Header: m.header(), Succeeded: succeeded, Responses: responses}, nil
+}
+
+// Compact discards the changes up to and including the given revision, which can no longer be read or watched.
+func (m *MemoryStorage) Compact(ctx context.Context, r *etcdpb.CompactionRequest) (*etcdpb.CompactionResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if r.Revision <= m.compactRev {
+		return nil, mvcc.ErrCompacted
+	} else if r.Revision > m.rev {
+		return nil, mvcc.ErrFutureRev
+	}
+	m.compact(r.Revision)
+	return &etcdpb.CompactionResponse{Header: m.header()}, nil
+}
+
+// LeaseGrant creates a lease that expires after its TTL in seconds unless it's renewed.
+func (m *MemoryStorage) LeaseGrant(ctx context.Context, r *etcdpb.LeaseGrantRequest) (*etcdpb.LeaseGrantResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	id := r.ID
+	if id == 0 {
+		for m.nextLease++; m.leases[m.nextLease] != nil; m.nextLease++ {
+		}
+		id = m.nextLease
+	} else if _, ok := m.leases[id]; ok {
+		return nil, lease.ErrLeaseExists
+	}
+
+	ttl := r.TTL
+	if ttl < 1 {
+		ttl = 1
+	}
+	m.leases[id] = &memoryLease{
+		ttl:    ttl,
+		expiry: time.Now().Add(time.Duration(ttl) * time.Second),
+		keys:   map[string]struct{}{},
+	}
+	return &etcdpb.LeaseGrantResponse{Header: m.header(), ID: id, TTL: ttl}, nil
+}
+
+// LeaseRevoke revokes a lease, deleting the keys attached to it.
+func (m *MemoryStorage) LeaseRevoke(ctx context.Context, r *etcdpb.LeaseRevokeRequest) (*etcdpb.LeaseRevokeResponse, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if !m.revoke(r.ID) {
+		return nil, lease.ErrLeaseNotFound
+	}
+	return &etcdpb.LeaseRevokeResponse{Header: m.header()}, nil
+}
+
+// LeaseRenew restarts a lease's TTL, returning the TTL.
+func (m *MemoryStorage) LeaseRenew(ctx context.Context, id lease.LeaseID) (int64, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	l, ok := m.leases[int64(id)]
+	if !ok {
+		return -1, lease.ErrLeaseNotFound
+	}
+	l.expiry = time.Now().Add(time.Duration(l.ttl) * time.Second)
+	return l.ttl, nil
+}
+
+// LeaseTimeToLive gets the time left on a lease in seconds, and the keys attached to it if they're asked for.
+func (m *MemoryStorage) LeaseTimeToLive(ctx context.Context, r *etcdpb.LeaseTimeToLiveRequest) (*etcdpb.LeaseTimeToLiveResponse, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	l, ok := m.leases[r.ID]
+	if !ok {
+		return nil, lease.ErrLeaseNotFound
+	}
+	res := &etcdpb.LeaseTimeToLiveResponse{
+		Header:     m.header(),
+		ID:         r.ID,
+		TTL:        int64(l.expiry.Sub(time.Now()).Seconds()),
+		GrantedTTL: l.ttl,
+	}
+	if r.Keys {
+		keys := make([]string, 0, len(l.keys))
+		for key := range l.keys {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			res.Keys = append(res.Keys, []byte(key))
+		}
+	}
+	return res, nil
+}
+
+// Rev returns the current revision.
+func (m *MemoryStorage) Rev() int64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.rev
+}
+
+// KeyAt returns a key as it was at the given revision, or nil if it didn't exist or the revision was compacted.
+func (m *MemoryStorage) KeyAt(key []byte, rev int64) *mvccpb.KeyValue {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	res, err := m.rangeKeys(&etcdpb.RangeRequest{Key: key, Revision: rev})
+	if err != nil || len(res.Kvs) == 0 {
+		return nil
+	}
+	return res.Kvs[0]
+}
+
+// KeysAt returns the keys in a range as they were at the given revision, or nil if the revision was compacted.
+func (m *MemoryStorage) KeysAt(key, end []byte, rev int64) []*mvccpb.KeyValue {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	res, err := m.rangeKeys(&etcdpb.RangeRequest{Key: key, RangeEnd: end, Revision: rev})
+	if err != nil {
+		return nil
+	}
+	return res.Kvs
+}
+
+// Close the MemoryStorage, which stops leases from expiring.
+func (m *MemoryStorage) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if !m.closed {
+		m.closed = true
+		close(m.closeCh)
+	}
+}
+
+func (m *MemoryStorage) header() *etcdpb.ResponseHeader {
+	return &etcdpb.ResponseHeader{Revision: m.rev}
+}
+
+// rangeKeys gets the keys in a range, rolling back the changes made since the request's revision if it has one.
+func (m *MemoryStorage) rangeKeys(r *etcdpb.RangeRequest) (*etcdpb.RangeResponse, error) {
+	if err := m.checkRev(r.Revision); err != nil {
+		return nil, err
+	}
+
+	kvs := []*mvccpb.KeyValue{}
+	if r.Revision == 0 || (r.Revision == m.rev && len(m.pending) == 0) {
+		for _, key := range m.keysInRange(r.Key, r.RangeEnd) {
+			kvs = append(kvs, m.kvs[key])
+		}
+	} else {
+		at := map[string]*mvccpb.KeyValue{}
+		for _, key := range m.keysInRange(r.Key, r.RangeEnd) {
+			at[key] = m.kvs[key]
+		}
+		changes := append(append([]mvccpb.Event{}, m.history...), m.pending...)
+		for i := len(changes) - 1; i >= 0 && changes[i].Kv.ModRevision > r.Revision; i-- {
+			e := changes[i]
+			if !inRange(e.Kv.Key, r.Key, r.RangeEnd) {
+				continue
+			}
+			if e.PrevKv == nil {
+				delete(at, string(e.Kv.Key))
+			} else {
+				at[string(e.Kv.Key)] = e.PrevKv
+			}
+		}
+		for _, kv := range at {
+			kvs = append(kvs, kv)
+		}
+		sort.Slice(kvs, func(i, j int) bool {
+			return bytes.Compare(kvs[i].Key, kvs[j].Key) < 0
+		})
+	}
+
+	kvs = filterKVs(kvs, func(kv *mvccpb.KeyValue) bool {
+		return (r.MaxModRevision == 0 || kv.ModRevision <= r.MaxModRevision) &&
+			(r.MinModRevision == 0 || kv.ModRevision >= r.MinModRevision) &&
+			(r.MaxCreateRevision == 0 || kv.CreateRevision <= r.MaxCreateRevision) &&
+			(r.MinCreateRevision == 0 || kv.CreateRevision >= r.MinCreateRevision)
+	})
+	sortKVs(kvs, r.SortOrder, r.SortTarget)
+
+	res := &etcdpb.RangeResponse{Header: m.header(), Count: int64(len(kvs))}
+	if r.CountOnly {
+		return res, nil
+	}
+	if r.Limit > 0 && int64(len(kvs)) > r.Limit {
+		kvs = kvs[:r.Limit]
+		res.More = true
+	}
+	for _, kv := range kvs {
+		if r.KeysOnly {
+			kv = &mvccpb.KeyValue{
+				Key:            kv.Key,
+				CreateRevision: kv.CreateRevision,
+				ModRevision:    kv.ModRevision,
+				Version:        kv.Version,
+				Lease:          kv.Lease,
+			}
+		}
+		res.Kvs = append(res.Kvs, kv)
+	}
+	return res, nil
+}
+
+// checkRev returns an error if the revision can't be read.
+func (m *MemoryStorage) checkRev(rev int64) error {
+	if rev > m.rev {
+		return mvcc.ErrFutureRev
+	} else if rev != 0 && rev < m.compactRev {
+		return mvcc.ErrCompacted
+	}
+	return nil
+}
+
+// put sets a key as part of the next revision. Keys are never changed once they're set, so they can be given out
+// without copying them.
+func (m *MemoryStorage) put(r *etcdpb.PutRequest) *etcdpb.PutResponse {
+	key := string(r.Key)
+	rev := m.rev + 1
+	prev := m.kvs[key]
+	kv := &mvccpb.KeyValue{
+		Key:            []byte(key),
+		Value:          append([]byte{}, r.Value...),
+		CreateRevision: rev,
+		ModRevision:    rev,
+		Version:        1,
+		Lease:          r.Lease,
+	}
+
+	if prev == nil {
+		i := sort.SearchStrings(m.keys, key)
+		m.keys = append(m.keys, "")
+		copy(m.keys[i+1:], m.keys[i:])
+		m.keys[i] = key
+	} else {
+		kv.CreateRevision = prev.CreateRevision
+		kv.Version = prev.Version + 1
+		m.detach(prev)
+	}
+	if l, ok := m.leases[r.Lease]; ok {
+		l.keys[key] = struct{}{}
+	}
+	m.kvs[key] = kv
+	m.pending = append(m.pending, mvccpb.Event{Type: mvccpb.PUT, Kv: kv, PrevKv: prev})
+
+	res := &etcdpb.PutResponse{Header: &etcdpb.ResponseHeader{Revision: rev}}
+	if r.PrevKv {
+		res.PrevKv = prev
+	}
+	return res
+}
+
+// deleteRange deletes the keys in a range as part of the next revision.
+func (m *MemoryStorage) deleteRange(r *etcdpb.DeleteRangeRequest) *etcdpb.DeleteRangeResponse {
+	rev := m.rev + 1
+	keys := m.keysInRange(r.Key, r.RangeEnd)
+	res := &etcdpb.DeleteRangeResponse{Header: m.header(), Deleted: int64(len(keys))}
+	if len(keys) == 0 {
+		return res
+	}
+
+	for _, key := range keys {
+		prev := m.kvs[key]
+		delete(m.kvs, key)
+		m.detach(prev)
+		m.pending = append(m.pending, mvccpb.Event{
+			Type:   mvccpb.DELETE,
+			Kv:     &mvccpb.KeyValue{Key: prev.Key, ModRevision: rev},
+			PrevKv: prev,
+		})
+		if r.PrevKv {
+			res.PrevKvs = append(res.PrevKvs, prev)
+		}
+	}
+
+	i := sort.SearchStrings(m.keys, keys[0])
+	m.keys = append(m.keys[:i], m.keys[i+len(keys):]...)
+	res.Header.Revision = rev
+	return res
+}
+
+// detach removes a key from its lease.
+func (m *MemoryStorage) detach(kv *mvccpb.KeyValue) {
+	if l, ok := m.leases[kv.Lease]; ok {
+		delete(l.keys, string(kv.Key))
+	}
+}
+
+// commit finishes the next revision, sending its changes to the watches. Nothing happens if nothing changed.
+func (m *MemoryStorage) commit() {
+	if len(m.pending) == 0 {
+		return
+	}
+	m.rev++
+	events := m.pending
+	m.pending = nil
+
+	m.history = append(m.history, events...)
+	if len(m.history) > memoryHistorySize {
+		// only whole revisions are compacted.
+		rev := m.history[len(m.history)-memoryHistorySize-1].Kv.ModRevision
+		m.compact(rev)
+	}
+
+	for ws := range m.streams {
+		ws.notify(events, m.rev)
+	}
+}
+
+// compact discards the changes up to and including the given revision.
+func (m *MemoryStorage) compact(rev int64) {
+	i := sort.Search(len(m.history), func(i int) bool {
+		return m.history[i].Kv.ModRevision > rev
+	})
+	m.history = append([]mvccpb.Event{}, m.history[i:]...)
+	m.compactRev = rev
+}
+
+// compare returns true if the comparison is true, which it never is for the value of a key that doesn't exist.
+func (m *MemoryStorage) compare(c *etcdpb.Compare) bool {
+	kv, ok := m.kvs[string(c.Key)]
+	if !ok {
+		if c.Target == etcdpb.Compare_VALUE {
+			return false
+		}
+		kv = &mvccpb.KeyValue{}
+	}
+
+	result := 0
+	switch c.Target {
+	case etcdpb.Compare_VALUE:
+		if tv, ok := c.TargetUnion.(*etcdpb.Compare_Value); ok {
+			result = bytes.Compare(kv.Value, tv.Value)
+		}
+	case etcdpb.Compare_CREATE:
+		if tv, ok := c.TargetUnion.(*etcdpb.Compare_CreateRevision); ok {
+			result = compareInt64(kv.CreateRevision, tv.CreateRevision)
+		}
+	case etcdpb.Compare_MOD:
+		if tv, ok := c.TargetUnion.(*etcdpb.Compare_ModRevision); ok {
+			result = compareInt64(kv.ModRevision, tv.ModRevision)
+		}
+	case etcdpb.Compare_VERSION:
+		if tv, ok := c.TargetUnion.(*etcdpb.Compare_Version); ok {
+			result = compareInt64(kv.Version, tv.Version)
+		}
+	}
+
+	switch c.Result {
+	case etcdpb.Compare_EQUAL:
+		return result == 0
+	case etcdpb.Compare_NOT_EQUAL:
+		return result != 0
+	case etcdpb.Compare_GREATER:
+		return result > 0
+	case etcdpb.Compare_LESS:
+		return result < 0
+	}
+	return false
+}
+
+// leaseFound returns true if there's no lease, or the lease exists.
+func (m *MemoryStorage) leaseFound(id int64) bool {
+	if id == 0 {
+		return true
+	}
+	_, ok := m.leases[id]
+	return ok
+}
+
+// revoke removes a lease, then deletes its keys as one revision. It returns false if the lease doesn't exist.
+func (m *MemoryStorage) revoke(id int64) bool {
+	l, ok := m.leases[id]
+	if !ok {
+		return false
+	}
+	delete(m.leases, id)
+
+	keys := make([]string, 0, len(l.keys))
+	for key := range l.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		m.deleteRange(&etcdpb.DeleteRangeRequest{Key: []byte(key)})
+	}
+	m.commit()
+	return true
+}
+
+func (m *MemoryStorage) expireLeases() {
+	ticker := time.NewTicker(memoryLeaseInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.closeCh:
+			return
+		case now := <-ticker.C:
+			m.lock.Lock()
+			for id, l := range m.leases {
+				if now.After(l.expiry) {
+					m.revoke(id)
+				}
+			}
+			m.lock.Unlock()
+		}
+	}
+}
+
+// keysInRange returns the keys in a range, in order. An empty range end is just the key, and a range end of
+// ZeroByte is every key from the key on.
+func (m *MemoryStorage) keysInRange(key, end []byte) []string {
+	start := sort.SearchStrings(m.keys, string(key))
+	if len(end) == 0 {
+		if start < len(m.keys) && m.keys[start] == string(key) {
+			return []string{m.keys[start]}
+		}
+		return nil
+	}
+
+	stop := len(m.keys)
+	if !bytes.Equal(end, ZeroByte) {
+		stop = sort.SearchStrings(m.keys, string(end))
+	}
+	if stop <= start {
+		return nil
+	}
+	return append([]string{}, m.keys[start:stop]...)
+}
+
+// inRange returns true if a key is in a range, using the same ranges as keysInRange.
+func inRange(k, key, end []byte) bool {
+	if len(end) == 0 {
+		return bytes.Equal(k, key)
+	}
+	return bytes.Compare(k, key) >= 0 && (bytes.Equal(end, ZeroByte) || bytes.Compare(k, end) < 0)
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func filterKVs(kvs []*mvccpb.KeyValue, keep func(kv *mvccpb.KeyValue) bool) []*mvccpb.KeyValue {
+	filtered := kvs[:0]
+	for _, kv := range kvs {
+		if keep(kv) {
+			filtered = append(filtered, kv)
+		}
+	}
+	return filtered
+}
+
+// sortKVs sorts keys the same way etcd does. Keys are already sorted by key, and sorting by anything else is
+// ascending unless it's descending.
+func sortKVs(kvs []*mvccpb.KeyValue, order etcdpb.RangeRequest_SortOrder, target etcdpb.RangeRequest_SortTarget) {
+	if target != etcdpb.RangeRequest_KEY && order == etcdpb.RangeRequest_NONE {
+		order = etcdpb.RangeRequest_ASCEND
+	}
+	if order == etcdpb.RangeRequest_NONE {
+		return
+	}
+
+	less := func(i, j int) bool {
+		a, b := kvs[i], kvs[j]
+		switch target {
+		case etcdpb.RangeRequest_VERSION:
+			return a.Version < b.Version
+		case etcdpb.RangeRequest_CREATE:
+			return a.CreateRevision < b.CreateRevision
+		case etcdpb.RangeRequest_MOD:
+			return a.ModRevision < b.ModRevision
+		case etcdpb.RangeRequest_VALUE:
+			return bytes.Compare(a.Value, b.Value) < 0
+		}
+		return bytes.Compare(a.Key, b.Key) < 0
+	}
+	if order == etcdpb.RangeRequest_DESCEND {
+		sort.SliceStable(kvs, func(i, j int) bool { return less(j, i) })
+	} else {
+		sort.SliceStable(kvs, less)
+	}
+}
+
+// NewWatchStream returns a stream of the changes to the keys it watches.
+func (m *MemoryStorage) NewWatchStream() mvcc.WatchStream {
+	ws := &memoryWatchStream{
+		storage:  m,
+		ch:       make(chan mvcc.WatchResponse),
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		watchers: map[mvcc.WatchID]*memoryWatcher{},
+	}
+
+	m.lock.Lock()
+	m.streams[ws] = struct{}{}
+	m.lock.Unlock()
+
+	go ws.run()
+	return ws
+}
+
+// memoryWatchStream is a watch stream on a MemoryStorage. Changes are queued without limit, so a slow reader never
+// holds up writes.
+type memoryWatchStream struct {
+	storage  *MemoryStorage
+	ch       chan mvcc.WatchResponse
+	queue    []mvcc.WatchResponse
+	wake     chan struct{}
+	done     chan struct{}
+	watchers map[mvcc.WatchID]*memoryWatcher
+	nextID   mvcc.WatchID
+	closed   bool
+	lock     sync.Mutex
+}
+
+// memoryWatcher watches a key, or a range of keys, leaving out the changes its filters match.
+type memoryWatcher struct {
+	key     []byte
+	end     []byte
+	filters []mvcc.FilterFunc
+}
+
+// Watch starts watching a key, or the range of keys up to the end, sending the changes since the given revision
+// first if it has one.
+func (ws *memoryWatchStream) Watch(key, end []byte, startRev int64, fcs ...mvcc.FilterFunc) mvcc.WatchID {
+	if len(end) != 0 && bytes.Compare(key, end) != -1 {
+		return -1
+	}
+
+	m := ws.storage
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	id := ws.nextID
+	ws.nextID++
+	w := &memoryWatcher{key: append([]byte{}, key...), filters: fcs}
+	if end != nil {
+		w.end = append([]byte{}, end...)
+	}
+
+	if startRev > 0 && startRev <= m.rev {
+		if startRev <= m.compactRev {
+			ws.push(mvcc.WatchResponse{WatchID: id, CompactRevision: m.compactRev})
+			return id
+		}
+		i := sort.Search(len(m.history), func(i int) bool {
+			return m.history[i].Kv.ModRevision >= startRev
+		})
+		if events := w.filter(m.history[i:]); len(events) > 0 {
+			ws.push(mvcc.WatchResponse{WatchID: id, Events: events, Revision: m.rev})
+		}
+	}
+
+	ws.watchers[id] = w
+	return id
+}
+
+// Chan returns the channel the changes are sent on.
+func (ws *memoryWatchStream) Chan() <-chan mvcc.WatchResponse {
+	return ws.ch
+}
+
+// RequestProgress sends the current revision to a watcher, after the changes it has been sent.
+func (ws *memoryWatchStream) RequestProgress(id mvcc.WatchID) {
+	rev := ws.storage.Rev()
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	if _, ok := ws.watchers[id]; ok {
+		ws.push(mvcc.WatchResponse{WatchID: id, Revision: rev})
+	}
+}
+
+// Cancel stops a watcher.
+func (ws *memoryWatchStream) Cancel(id mvcc.WatchID) error {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	if _, ok := ws.watchers[id]; !ok {
+		return mvcc.ErrWatcherNotExist
+	}
+	delete(ws.watchers, id)
+	return nil
+}
+
+// Close the stream, which closes its channel.
+func (ws *memoryWatchStream) Close() {
+	m := ws.storage
+	m.lock.Lock()
+	delete(m.streams, ws)
+	m.lock.Unlock()
+
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	if !ws.closed {
+		ws.closed = true
+		close(ws.done)
+	}
+}
+
+// Rev returns the current revision.
+func (ws *memoryWatchStream) Rev() int64 {
+	return ws.storage.Rev()
+}
+
+// notify queues the changes of a revision for the watchers they match.
+func (ws *memoryWatchStream) notify(events []mvccpb.Event, rev int64) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+	for id, w := range ws.watchers {
+		if matched := w.filter(events); len(matched) > 0 {
+			ws.push(mvcc.WatchResponse{WatchID: id, Events: matched, Revision: rev})
+		}
+	}
+}
+
+// push queues a response, waking the stream up to send it. The stream's lock must be held.
+func (ws *memoryWatchStream) push(r mvcc.WatchResponse) {
+	if ws.closed {
+		return
+	}
+	ws.queue = append(ws.queue, r)
+	select {
+	case ws.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (ws *memoryWatchStream) run() {
+	defer close(ws.ch)
+	for {
+		ws.lock.Lock()
+		queue := ws.queue
+		ws.queue = nil
+		ws.lock.Unlock()
+
+		for _, r := range queue {
+			select {
+			case ws.ch <- r:
+			case <-ws.done:
+				return
+			}
+		}
+
+		select {
+		case <-ws.wake:
+		case <-ws.done:
+			return
+		}
+	}
+}
+
+// filter returns the changes to the watcher's keys, as etcd sends them, without the keys as they were before.
+// watches returns true if the watcher is watching a key. Like etcd's watches, a nil end watches a single key, while
+// an empty one watches every key after it.
+func (w *memoryWatcher) watches(k []byte) bool {
+	if w.end == nil {
+		return bytes.Equal(k, w.key)
+	}
+	return bytes.Compare(k, w.key) >= 0 && (len(w.end) == 0 || bytes.Compare(k, w.end) < 0)
+}
+
+func (w *memoryWatcher) filter(events []mvccpb.Event) []mvccpb.Event {
+	var matched []mvccpb.Event
+	for _, e := range events {
+		if !w.watches(e.Kv.Key) {
+			continue
+		}
+		ev := mvccpb.Event{Type: e.Type, Kv: e.Kv}
+		filtered := false
+		for _, fc := range w.filters {
+			if fc(ev) {
+				filtered = true
+				break
+			}
+		}
+		if !filtered {
+			matched = append(matched, ev)
+		}
+	}
+	return matched
+}
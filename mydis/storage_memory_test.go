@@ -0,0 +1,174 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	myc "github.com/deejross/mydis/client"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	m := NewMemoryStorage()
+	defer m.Close()
+
+	put := func(key, value string) int64 {
+		res, err := m.Put(ctx, &etcdpb.PutRequest{Key: []byte(key), Value: []byte(value)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Header.Revision
+	}
+	rev1 := put("b", "1")
+	put("a", "1")
+	rev3 := put("b", "2")
+	put("c", "1")
+
+	if res, err := m.Range(ctx, &etcdpb.RangeRequest{Key: []byte("a"), RangeEnd: ZeroByte, Limit: 2}); err != nil {
+		t.Error(err)
+	} else if len(res.Kvs) != 2 || string(res.Kvs[1].Key) != "b" || res.Count != 3 || !res.More {
+		t.Error("Unexpected range:", res)
+	} else if kv := res.Kvs[1]; kv.Version != 2 || kv.CreateRevision != rev1 || kv.ModRevision != rev3 {
+		t.Error("Unexpected key:", kv)
+	}
+
+	// old revisions are read by rolling back the changes since then.
+	if res, err := m.Range(ctx, &etcdpb.RangeRequest{Key: []byte("a"), RangeEnd: ZeroByte, Revision: rev1}); err != nil {
+		t.Error(err)
+	} else if len(res.Kvs) != 1 || string(res.Kvs[0].Value) != "1" {
+		t.Error("Unexpected range:", res)
+	}
+
+	txn, err := m.Txn(ctx, &etcdpb.TxnRequest{
+		Compare: []*etcdpb.Compare{{
+			Key:         []byte("b"),
+			Target:      etcdpb.Compare_MOD,
+			Result:      etcdpb.Compare_EQUAL,
+			TargetUnion: &etcdpb.Compare_ModRevision{ModRevision: rev3},
+		}},
+		Success: []*etcdpb.RequestOp{
+			{Request: &etcdpb.RequestOp_RequestDeleteRange{RequestDeleteRange: &etcdpb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte("c")}}},
+			{Request: &etcdpb.RequestOp_RequestPut{RequestPut: &etcdpb.PutRequest{Key: []byte("d"), Value: []byte("1")}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if !txn.Succeeded || txn.Header.Revision != m.Rev() {
+		t.Error("Unexpected transaction:", txn)
+	}
+	if kv := m.KeyAt([]byte("b"), txn.Header.Revision); kv != nil {
+		t.Error("Expected key to be deleted, got:", kv)
+	}
+	if kv := m.KeyAt([]byte("b"), txn.Header.Revision-1); kv == nil || string(kv.Value) != "2" {
+		t.Error("Unexpected key:", kv)
+	}
+
+	if _, err := m.Compact(ctx, &etcdpb.CompactionRequest{Revision: rev3}); err != nil {
+		t.Error(err)
+	}
+	if _, err := m.Range(ctx, &etcdpb.RangeRequest{Key: []byte("b"), Revision: rev1}); err != mvcc.ErrCompacted {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := m.Range(ctx, &etcdpb.RangeRequest{Key: []byte("b"), Revision: m.Rev() + 1}); err != mvcc.ErrFutureRev {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestMemoryStorageLeases(t *testing.T) {
+	m := NewMemoryStorage()
+	defer m.Close()
+
+	ws := m.NewWatchStream()
+	defer ws.Close()
+	ws.Watch([]byte("leased"), nil, 0)
+
+	res, err := m.LeaseGrant(ctx, &etcdpb.LeaseGrantRequest{TTL: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Put(ctx, &etcdpb.PutRequest{Key: []byte("leased"), Value: []byte("1"), Lease: res.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Put(ctx, &etcdpb.PutRequest{Key: []byte("leased"), Lease: res.ID + 1}); err != lease.ErrLeaseNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+	if ttl, err := m.LeaseTimeToLive(ctx, &etcdpb.LeaseTimeToLiveRequest{ID: res.ID, Keys: true}); err != nil {
+		t.Error(err)
+	} else if ttl.GrantedTTL != 1 || len(ttl.Keys) != 1 || string(ttl.Keys[0]) != "leased" {
+		t.Error("Unexpected lease:", ttl)
+	}
+
+	for _, typ := range []mvccpb.Event_EventType{mvccpb.PUT, mvccpb.DELETE} {
+		select {
+		case r := <-ws.Chan():
+			if len(r.Events) != 1 || r.Events[0].Type != typ {
+				t.Error("Unexpected response:", r)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("Never got event")
+		}
+	}
+	if res, err := m.Range(ctx, &etcdpb.RangeRequest{Key: []byte("leased")}); err != nil || len(res.Kvs) != 0 {
+		t.Error("Expected key to expire:", res, err)
+	}
+}
+
+func TestMemoryStorageServer(t *testing.T) {
+	s := NewServer(CopyConfig(server.config), WithStorage(NewMemoryStorage()))
+	if err := s.Start(":8004", ":8387"); err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	c, err := myc.NewClient(myc.NewClientConfig("localhost:8387"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ch, id := c.NewEventChannel()
+	defer c.CloseEventChannel(id)
+	c.Watch("memory1", false)
+	time.Sleep(100 * time.Millisecond)
+
+	if err := c.SetWithExpire("memory1", "value1", 1); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := c.Get("memory1").String(); err != nil || s != "value1" {
+		t.Error("Unexpected value:", s, err)
+	}
+	for _, reason := range []pb.Event_Reason{pb.Event_WRITTEN, pb.Event_EXPIRED} {
+		select {
+		case ev := <-ch:
+			if ev.Reason != reason {
+				t.Error("Unexpected event:", ev)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatal("Never got event")
+		}
+	}
+
+	if err := c.AuthEnable(); err == nil || grpc.ErrorDesc(err) != util.ErrAuthNotSupported.Error() {
+		t.Error("Unexpected or no error:", err)
+	}
+}
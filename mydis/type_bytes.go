@@ -26,7 +26,7 @@ import (
 
 // Get a byte array from the cache.
 func (s *Server) Get(ctx context.Context, key *pb.Key) (*pb.ByteValue, error) {
-	res, err := s.cache.Server.Range(ctx, getRangeRequestFromKey(key))
+	res, err := s.storage.Range(ctx, getRangeRequestFromKey(key))
 
 	if err != nil && err.Error() == util.ErrKeyNotFound.Error() {
 		return &pb.ByteValue{}, util.ErrKeyNotFound
@@ -49,6 +49,7 @@ func (s *Server) Get(ctx context.Context, key *pb.Key) (*pb.ByteValue, error) {
 			}
 		}
 	} else if res.Count > 0 {
+		s.touch(res.Kvs[0].Key)
 		return &pb.ByteValue{Value: res.Kvs[0].Value}, nil
 	}
 
@@ -86,7 +87,7 @@ func (s *Server) GetMany(ctx context.Context, keys *pb.KeysList) (*pb.Hash, erro
 		req.Failure = append(req.Failure, op)
 	}
 
-	res, err := s.cache.Server.Txn(ctx, req)
+	res, err := s.storage.Txn(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +97,7 @@ func (s *Server) GetMany(ctx context.Context, keys *pb.KeysList) (*pb.Hash, erro
 		key := keys.Keys[i]
 		kvs := op.GetResponseRange().Kvs
 		if kvs != nil && len(kvs) > 0 {
+			s.touch(kvs[0].Key)
 			h.Value[key] = op.GetResponseRange().Kvs[0].Value
 		}
 	}
@@ -104,57 +106,122 @@ func (s *Server) GetMany(ctx context.Context, keys *pb.KeysList) (*pb.Hash, erro
 
 // GetWithPrefix gets all byte arrays with the given prefix.
 func (s *Server) GetWithPrefix(ctx context.Context, key *pb.Key) (*pb.Hash, error) {
+	start, end, ok := getUserRange(key.Key)
+	if !ok {
+		return &pb.Hash{Value: map[string][]byte{}}, nil
+	}
 	req := getRangeRequestFromKey(key)
-	req.RangeEnd = getPrefix(key.Key)
-	res, err := s.cache.Server.Range(ctx, req)
+	req.Key = start
+	req.RangeEnd = end
+	res, err := s.storage.Range(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	h := &pb.Hash{Value: map[string][]byte{}}
 	for _, kv := range res.Kvs {
+		s.touch(kv.Key)
 		h.Value[util.BytesToString(kv.Key)] = kv.Value
 	}
 	return h, nil
 }
 
-// Set a byte array in the cache.
+// GetWithRevision gets a byte array along with the revision it was last modified at.
+func (s *Server) GetWithRevision(ctx context.Context, key *pb.Key) (*pb.RevisionValue, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(key.Key)})
+	if err != nil {
+		return nil, err
+	} else if len(res.Kvs) == 0 {
+		return nil, util.ErrKeyNotFound
+	}
+	s.touch(res.Kvs[0].Key)
+	return &pb.RevisionValue{Key: key.Key, Value: res.Kvs[0].Value, ModRevision: res.Kvs[0].ModRevision}, nil
+}
+
+// GetManyWithRevision gets a list of byte arrays along with the revisions they were last modified at.
+// Keys that don't exist are left out.
+func (s *Server) GetManyWithRevision(ctx context.Context, keys *pb.KeysList) (*pb.RevisionValues, error) {
+	vals := &pb.RevisionValues{Values: []*pb.RevisionValue{}}
+	if len(keys.Keys) == 0 {
+		return vals, nil
+	}
+
+	req := &etcdpb.TxnRequest{}
+	for _, key := range keys.Keys {
+		req.Success = append(req.Success, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestRange{
+				RequestRange: &etcdpb.RangeRequest{
+					Key: util.StringToBytes(key),
+				},
+			},
+		})
+	}
+
+	res, err := s.storage.Txn(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range res.Responses {
+		kvs := op.GetResponseRange().Kvs
+		if len(kvs) > 0 {
+			s.touch(kvs[0].Key)
+			vals.Values = append(vals.Values, &pb.RevisionValue{Key: keys.Keys[i], Value: kvs[0].Value, ModRevision: kvs[0].ModRevision})
+		}
+	}
+	return vals, nil
+}
+
+// Set a byte array in the cache. If the key is locked by anyone but the caller's lock owner, it waits for the key to
+// be unlocked.
 func (s *Server) Set(ctx context.Context, val *pb.ByteValue) (*pb.Null, error) {
 	bkey := util.StringToBytes(val.Key)
 	if len(bkey) == 0 || bytes.Equal(bkey, ZeroByte) {
 		return null, util.ErrInvalidKey
 	}
+	if err := s.beforeSet(ctx, val.Key, val.Value); err != nil {
+		return null, err
+	}
+
+	lease, err := s.getLease(ctx)
+	if err != nil {
+		return null, err
+	}
 
 	maxW := s.getMaxWait(ctx)
 	maxWait := time.Now().Add(time.Duration(maxW) * time.Second)
-	keyLock := getLockName(val.Key)
 
 	for {
-		if res, err := s.cache.Server.Txn(ctx, &etcdpb.TxnRequest{
-			Compare: []*etcdpb.Compare{
-				{
-					Key:    keyLock,
-					Target: etcdpb.Compare_VALUE,
-					Result: etcdpb.Compare_EQUAL,
-					TargetUnion: &etcdpb.Compare_Value{
-						Value: ZeroByte,
-					},
-				},
-			},
-			Failure: []*etcdpb.RequestOp{
-				{
-					Request: &etcdpb.RequestOp_RequestPut{
-						RequestPut: &etcdpb.PutRequest{
-							Key:   util.StringToBytes(val.Key),
-							Value: val.Value,
+		lockCmp, ok, err := s.getWriteLockCompare(ctx, val.Key)
+		if err != nil {
+			return null, err
+		} else if ok {
+			compares, ops, err := s.indexOps(ctx, val.Key, val.Value, lease)
+			if err != nil {
+				return null, err
+			}
+			// the key must not be locked by anyone else, and if it's indexed, must not have changed since its index
+			// entries were read.
+			res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: append([]*etcdpb.Compare{lockCmp}, compares...),
+				Success: append([]*etcdpb.RequestOp{
+					{
+						Request: &etcdpb.RequestOp_RequestPut{
+							RequestPut: &etcdpb.PutRequest{
+								Key:   bkey,
+								Value: val.Value,
+								Lease: lease,
+							},
 						},
 					},
-				},
-			},
-		}); err != nil {
-			return null, err
-		} else if res.Succeeded == false {
-			break
+				}, ops...),
+			})
+			if err != nil {
+				return null, err
+			} else if res.Succeeded {
+				break
+			}
+			continue
 		}
 
 		time.Sleep(delay)
@@ -162,6 +229,7 @@ func (s *Server) Set(ctx context.Context, val *pb.ByteValue) (*pb.Null, error) {
 			return null, util.ErrKeyLocked
 		}
 	}
+	s.afterSet(ctx, val.Key, val.Value)
 	return null, nil
 }
 
@@ -172,8 +240,10 @@ func (s *Server) SetNX(ctx context.Context, val *pb.ByteValue) (*pb.Bool, error)
 		return nil, err
 	}
 	if b, err := s.Has(ctx, key); err != nil {
+		s.Unlock(ctx, key)
 		return nil, err
 	} else if b.Value {
+		s.Unlock(ctx, key)
 		return &pb.Bool{Value: false}, nil
 	}
 
@@ -197,6 +267,134 @@ func (s *Server) SetMany(ctx context.Context, h *pb.Hash) (*pb.ErrorHash, error)
 	return errors, nil
 }
 
+// CompareAndSwap sets a byte array only if the current value equals the expected value, without locking the key.
+// If the value isn't swapped, the revision the key was last modified at is returned so the caller can retry.
+func (s *Server) CompareAndSwap(ctx context.Context, req *pb.CompareAndSwapRequest) (*pb.SwapResult, error) {
+	return s.setIf(ctx, req.Key, req.Value, &etcdpb.Compare{
+		Key:    util.StringToBytes(req.Key),
+		Target: etcdpb.Compare_VALUE,
+		Result: etcdpb.Compare_EQUAL,
+		TargetUnion: &etcdpb.Compare_Value{
+			Value: req.Expected,
+		},
+	})
+}
+
+// SetIfModRevision sets a byte array only if the key was last modified at the given revision, without locking the key.
+// A revision of zero means the key must not exist.
+func (s *Server) SetIfModRevision(ctx context.Context, val *pb.RevisionValue) (*pb.SwapResult, error) {
+	return s.setIf(ctx, val.Key, val.Value, &etcdpb.Compare{
+		Key:    util.StringToBytes(val.Key),
+		Target: etcdpb.Compare_MOD,
+		Result: etcdpb.Compare_EQUAL,
+		TargetUnion: &etcdpb.Compare_ModRevision{
+			ModRevision: val.ModRevision,
+		},
+	})
+}
+
+// setIf sets a byte array if the comparison passes, waiting for the key to be unlocked if it's locked by anyone but
+// the caller's lock owner.
+func (s *Server) setIf(ctx context.Context, key string, value []byte, cmp *etcdpb.Compare) (*pb.SwapResult, error) {
+	bkey := util.StringToBytes(key)
+	if len(bkey) == 0 || bytes.Equal(bkey, ZeroByte) {
+		return nil, util.ErrInvalidKey
+	}
+	if err := s.beforeSet(ctx, key, value); err != nil {
+		return nil, err
+	}
+
+	lease, err := s.getLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxW := s.getMaxWait(ctx)
+	maxWait := time.Now().Add(time.Duration(maxW) * time.Second)
+
+	for {
+		res, err := s.trySetIf(ctx, key, value, cmp, lease)
+		if err != nil {
+			return nil, err
+		} else if res != nil {
+			return res, nil
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			return nil, util.ErrKeyLocked
+		}
+	}
+}
+
+// trySetIf makes a single attempt at setIf, returning a nil result if it has to be retried because the key is locked
+// by someone other than the caller's lock owner, or the key, its lock or the indexes changed since they were read.
+func (s *Server) trySetIf(ctx context.Context, key string, value []byte, cmp *etcdpb.Compare, lease int64) (*pb.SwapResult, error) {
+	lockCmp, ok, err := s.getWriteLockCompare(ctx, key)
+	if err != nil || !ok {
+		return nil, err
+	}
+	compares, ops, err := s.indexOps(ctx, key, value, lease)
+	if err != nil {
+		return nil, err
+	}
+	compares = append([]*etcdpb.Compare{lockCmp}, compares...)
+
+	// when the swap fails, the key and the keys of the other compares are read to tell whether it failed because of
+	// the comparison, or because it has to be retried.
+	bkey := util.StringToBytes(key)
+	failure := []*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestRange{
+				RequestRange: &etcdpb.RangeRequest{
+					Key:      bkey,
+					KeysOnly: true,
+				},
+			},
+		},
+	}
+	for _, c := range compares {
+		failure = append(failure, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestRange{
+				RequestRange: &etcdpb.RangeRequest{
+					Key:      c.Key,
+					KeysOnly: true,
+				},
+			},
+		})
+	}
+
+	res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+		Compare: append([]*etcdpb.Compare{cmp}, compares...),
+		Success: append([]*etcdpb.RequestOp{
+			{
+				Request: &etcdpb.RequestOp_RequestPut{
+					RequestPut: &etcdpb.PutRequest{
+						Key:   bkey,
+						Value: value,
+						Lease: lease,
+					},
+				},
+			},
+		}, ops...),
+		Failure: failure,
+	})
+	if err != nil {
+		return nil, err
+	} else if res.Succeeded {
+		s.afterSet(ctx, key, value)
+		return &pb.SwapResult{Swapped: true, ModRevision: res.Header.Revision}, nil
+	} else if !modComparesHold(compares, res.Responses[1:]) {
+		return nil, nil
+	}
+
+	current := int64(0)
+	if kvs := res.Responses[0].GetResponseRange().Kvs; len(kvs) > 0 {
+		current = kvs[0].ModRevision
+	}
+	return &pb.SwapResult{Swapped: false, ModRevision: current}, nil
+}
+
 // Length returns the length of the value for the given key.
 func (s *Server) Length(ctx context.Context, key *pb.Key) (*pb.IntValue, error) {
 	bv, err := s.Get(ctx, key)
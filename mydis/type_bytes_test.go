@@ -21,6 +21,8 @@ import (
 
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestSet(t *testing.T) {
@@ -118,3 +120,114 @@ func TestLength(t *testing.T) {
 		t.Error("Unexpected value:", iv.Value)
 	}
 }
+
+func TestGetWithRevision(t *testing.T) {
+	testReset()
+
+	rv, err := server.GetWithRevision(ctx, &pb.Key{Key: "key1"})
+	if err != nil {
+		t.Fatal(err)
+	} else if string(rv.Value) != "val1" || rv.ModRevision == 0 {
+		t.Error("Unexpected value:", rv)
+	}
+
+	if _, err := server.GetWithRevision(ctx, &pb.Key{Key: "missing"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if rvs, err := server.GetManyWithRevision(ctx, &pb.KeysList{Keys: []string{"key1", "missing"}}); err != nil {
+		t.Error(err)
+	} else if len(rvs.Values) != 1 || rvs.Values[0].Key != "key1" || rvs.Values[0].ModRevision != rv.ModRevision {
+		t.Error("Unexpected value:", rvs.Values)
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	testReset()
+
+	if res, err := server.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Key: "key1", Expected: []byte("other"), Value: []byte("val2")}); err != nil {
+		t.Error(err)
+	} else if res.Swapped || res.ModRevision == 0 {
+		t.Error("Unexpected value:", res)
+	}
+
+	res, err := server.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Key: "key1", Expected: []byte("val1"), Value: []byte("val2")})
+	if err != nil {
+		t.Fatal(err)
+	} else if !res.Swapped {
+		t.Error("Unexpected value:", res)
+	}
+
+	if rv, err := server.GetWithRevision(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if string(rv.Value) != "val2" || rv.ModRevision != res.ModRevision {
+		t.Error("Unexpected value:", rv, res)
+	}
+
+	if res, err := server.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Key: "missing", Value: []byte("val")}); err != nil {
+		t.Error(err)
+	} else if res.Swapped || res.ModRevision != 0 {
+		t.Error("Unexpected value:", res)
+	}
+
+	// a locked key can't be swapped until it's unlocked.
+	server.Lock(ctx, &pb.Key{Key: "key1"})
+	if _, err := server.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Key: "key1", Expected: []byte("val2"), Value: []byte("val3")}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	server.Unlock(ctx, &pb.Key{Key: "key1"})
+
+	// unless it's locked by the caller's lock owner.
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	server.Lock(ownerCtx, &pb.Key{Key: "key1"})
+	if res, err := server.CompareAndSwap(ownerCtx, &pb.CompareAndSwapRequest{Key: "key1", Expected: []byte("val2"), Value: []byte("val3")}); err != nil {
+		t.Error(err)
+	} else if !res.Swapped {
+		t.Error("Unexpected value:", res)
+	}
+	server.Unlock(ownerCtx, &pb.Key{Key: "key1"})
+}
+
+func TestSetIfModRevision(t *testing.T) {
+	testReset()
+
+	res, err := server.SetIfModRevision(ctx, &pb.RevisionValue{Key: "key2", Value: []byte("val2")})
+	if err != nil {
+		t.Fatal(err)
+	} else if !res.Swapped {
+		t.Error("Unexpected value:", res)
+	}
+
+	if res, err := server.SetIfModRevision(ctx, &pb.RevisionValue{Key: "key2", Value: []byte("val3")}); err != nil {
+		t.Error(err)
+	} else if res.Swapped {
+		t.Error("Unexpected value:", res)
+	}
+
+	if res, err := server.SetIfModRevision(ctx, &pb.RevisionValue{Key: "key2", Value: []byte("val3"), ModRevision: res.ModRevision}); err != nil {
+		t.Error(err)
+	} else if !res.Swapped {
+		t.Error("Unexpected value:", res)
+	}
+
+	if bv, err := server.Get(ctx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	} else if string(bv.Value) != "val3" {
+		t.Error("Unexpected value:", string(bv.Value))
+	}
+
+	// swapped hashes are indexed.
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "key", Field: "color"}); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := proto.Marshal(&pb.Hash{Value: map[string][]byte{"color": []byte("red")}})
+	if res, err := server.SetIfModRevision(ctx, &pb.RevisionValue{Key: "key3", Value: b}); err != nil || !res.Swapped {
+		t.Error("Unexpected value:", res, err)
+	}
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "key", Field: "color", Value: []byte("red")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "key3" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+	server.FieldIndexDrop(ctx, &pb.FieldIndex{Prefix: "key", Field: "color"})
+}
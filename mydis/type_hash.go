@@ -127,71 +127,55 @@ func (s *Server) HashValues(ctx context.Context, key *pb.Key) (*pb.List, error)
 
 // SetHash sets a hash in the cache.
 func (s *Server) SetHash(ctx context.Context, h *pb.Hash) (*pb.Null, error) {
-	key := h.Key
-	h.Key = ""
-	b, err := proto.Marshal(h)
-	if err != nil {
+	if _, err := s.Lock(ctx, &pb.Key{Key: h.Key}); err != nil {
 		return null, err
 	}
-	_, err = s.Set(ctx, &pb.ByteValue{Key: key, Value: b})
-	return null, err
+	return s.UnlockThenSetHash(ctx, h)
 }
 
 // SetHashField sets a single field in a hash, creates new hash if does not exist.
 func (s *Server) SetHashField(ctx context.Context, hf *pb.HashField) (*pb.Null, error) {
-	key := &pb.Key{Key: hf.Key}
-	if _, err := s.Lock(ctx, key); err != nil {
-		return null, err
-	}
-
-	h, err := s.GetHash(ctx, key)
-	if err == util.ErrKeyNotFound {
-		h = &pb.Hash{Value: map[string][]byte{}}
-	} else if err != nil {
-		s.Unlock(ctx, key)
-		return null, err
-	}
-	h.Value[hf.Field] = hf.Value
-	h.Key = hf.Key
-	return s.UnlockThenSetHash(ctx, h)
+	return s.updateHash(ctx, hf.Key, true, func(h *pb.Hash) {
+		h.Value[hf.Field] = hf.Value
+	})
 }
 
 // SetHashFields sets multiple fields in a hash, creates new hash if does not exist.
 func (s *Server) SetHashFields(ctx context.Context, ah *pb.Hash) (*pb.Null, error) {
-	key := &pb.Key{Key: ah.Key}
-	if _, err := s.Lock(ctx, key); err != nil {
-		return null, err
-	}
-
-	h, err := s.GetHash(ctx, key)
-	if err == util.ErrKeyNotFound {
-		h = &pb.Hash{Value: map[string][]byte{}}
-	} else if err != nil {
-		s.Unlock(ctx, key)
-		return null, err
-	}
-
-	for key, b := range ah.Value {
-		h.Value[key] = b
-	}
-
-	h.Key = ah.Key
-	return s.UnlockThenSetHash(ctx, h)
+	return s.updateHash(ctx, ah.Key, true, func(h *pb.Hash) {
+		for key, b := range ah.Value {
+			h.Value[key] = b
+		}
+	})
 }
 
 // DelHashField removes a field from a hash.
 func (s *Server) DelHashField(ctx context.Context, hf *pb.HashField) (*pb.Null, error) {
-	key := &pb.Key{Key: hf.Key}
-	if _, err := s.Lock(ctx, key); err != nil {
+	return s.updateHash(ctx, hf.Key, false, func(h *pb.Hash) {
+		delete(h.Value, hf.Field)
+	})
+}
+
+// updateHash locks a hash, applies fn to it, then stores it along with the changes to its index entries in a single
+// transaction. If create is true, a new hash is created if it doesn't exist.
+func (s *Server) updateHash(ctx context.Context, key string, create bool, fn func(h *pb.Hash)) (*pb.Null, error) {
+	k := &pb.Key{Key: key}
+	if _, err := s.Lock(ctx, k); err != nil {
 		return null, err
 	}
 
-	h, err := s.GetHash(ctx, key)
-	if err != nil {
-		s.Unlock(ctx, key)
+	h, err := s.GetHash(ctx, k)
+	if err == util.ErrKeyNotFound && create {
+		h = &pb.Hash{}
+	} else if err != nil {
+		s.Unlock(ctx, k)
 		return null, err
 	}
-	delete(h.Value, hf.Field)
-	h.Key = hf.Key
+	if h.Value == nil {
+		h.Value = map[string][]byte{}
+	}
+
+	fn(h)
+	h.Key = key
 	return s.UnlockThenSetHash(ctx, h)
 }
@@ -0,0 +1,497 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// Field index entries are stored as keys in the form: prefixForFieldIndexes + encoded prefix + encoded field +
+// encoded value + hashKey, so that the hashes with a given value, or range of numeric values, can be found with a
+// single range request. The parts are encoded with encodeKeyPart, so values containing zero bytes can't match others.
+
+// FieldIndexCreate creates an index on a field of the hashes whose keys start with Prefix, indexing any existing
+// hashes. If Numeric is set, values are indexed as numbers so they can be queried by range, and values that
+// aren't numbers are not indexed. The index is updated in the same transaction as any change to the hashes it
+// covers.
+func (s *Server) FieldIndexCreate(ctx context.Context, fi *pb.FieldIndex) (*pb.Null, error) {
+	if len(fi.Prefix) == 0 || len(fi.Field) == 0 {
+		return null, util.ErrInvalidFieldIndex
+	}
+	if err := s.registerFieldIndex(ctx, fi, false); err != nil {
+		return null, err
+	}
+
+	// remove entries left by a previous index on the same field, which may have had a different type.
+	if err := s.deleteFieldIndexEntries(ctx, fi); err != nil {
+		return null, err
+	}
+
+	keys, err := s.KeysWithPrefix(ctx, &pb.Key{Key: fi.Prefix})
+	if err != nil {
+		return null, err
+	}
+	indexes := &hashIndexes{fields: []*pb.FieldIndex{fi}}
+	for _, k := range keys.Keys {
+		if err := s.indexExistingHash(ctx, indexes, k); err != nil {
+			return null, err
+		}
+	}
+	return null, nil
+}
+
+// FieldIndexDrop deletes an index on a field of the hashes whose keys start with Prefix.
+func (s *Server) FieldIndexDrop(ctx context.Context, fi *pb.FieldIndex) (*pb.Null, error) {
+	if err := s.registerFieldIndex(ctx, fi, true); err != nil {
+		return null, err
+	}
+	return null, s.deleteFieldIndexEntries(ctx, fi)
+}
+
+// FindByField gets the keys of the hashes whose keys start with Prefix where Field has the given Value.
+// If Limit is greater than zero, no more than Limit keys are returned.
+func (s *Server) FindByField(ctx context.Context, q *pb.FieldQuery) (*pb.KeysList, error) {
+	fi, err := s.getFieldIndex(ctx, q.Prefix, q.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := fieldIndexValue(fi, q.Value)
+	if !ok {
+		return nil, util.ErrTypeMismatch
+	}
+	start := fieldIndexBase(fi) + encodeKeyPart(value)
+	return s.findFieldIndexEntries(ctx, start, string(getPrefix(start)), q.Limit)
+}
+
+// FindByFieldRange gets the keys of the hashes whose keys start with Prefix where the numeric Field is between
+// Min and Max inclusive, ordered by value. If NoMin or NoMax is set, the range has no lower or upper bound.
+// If Limit is greater than zero, no more than Limit keys are returned.
+func (s *Server) FindByFieldRange(ctx context.Context, q *pb.FieldQuery) (*pb.KeysList, error) {
+	fi, err := s.getFieldIndex(ctx, q.Prefix, q.Field)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Numeric {
+		return nil, util.ErrTypeMismatch
+	}
+
+	base := fieldIndexBase(fi)
+	start := base
+	if !q.NoMin {
+		start += encodeKeyPart(encodeIndexNumber(q.Min))
+	}
+	end := string(getPrefix(base))
+	if !q.NoMax {
+		end = string(getPrefix(base + encodeKeyPart(encodeIndexNumber(q.Max))))
+	}
+	return s.findFieldIndexEntries(ctx, start, end, q.Limit)
+}
+
+func (s *Server) findFieldIndexEntries(ctx context.Context, start, end string, limit int64) (*pb.KeysList, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      util.StringToBytes(start),
+		RangeEnd: util.StringToBytes(end),
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lst := &pb.KeysList{Keys: []string{}}
+	for _, kv := range res.Kvs {
+		lst.Keys = append(lst.Keys, string(kv.Value))
+	}
+	return lst, nil
+}
+
+// getFieldIndexes gets all of the field indexes.
+func (s *Server) getFieldIndexes(ctx context.Context) (*pb.FieldIndexes, error) {
+	res, err := s.Get(ctx, &pb.Key{Key: fieldIndexRegistry})
+	if err == util.ErrKeyNotFound {
+		return &pb.FieldIndexes{Indexes: []*pb.FieldIndex{}}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	fis := &pb.FieldIndexes{}
+	if err := proto.Unmarshal(res.Value, fis); err != nil {
+		return nil, err
+	}
+	return fis, nil
+}
+
+func (s *Server) getFieldIndex(ctx context.Context, prefix, field string) (*pb.FieldIndex, error) {
+	fis, err := s.getFieldIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range fis.Indexes {
+		if fi.Prefix == prefix && fi.Field == field {
+			return fi, nil
+		}
+	}
+	return nil, util.ErrFieldIndexNotFound
+}
+
+// hashIndexes are the field and search indexes that are updated when hashes change, along with the compares that
+// fail if any of them are created or dropped after they were read.
+type hashIndexes struct {
+	fields   []*pb.FieldIndex
+	searches []*pb.SearchIndex
+	compares []*etcdpb.Compare
+}
+
+// getHashIndexes gets all of the field and search indexes.
+func (s *Server) getHashIndexes(ctx context.Context) (*hashIndexes, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(fieldIndexRegistry)})
+	if err != nil {
+		return nil, err
+	}
+	fis := &pb.FieldIndexes{}
+	fieldRev := int64(0)
+	if len(res.Kvs) > 0 {
+		fieldRev = res.Kvs[0].ModRevision
+		if err := proto.Unmarshal(res.Kvs[0].Value, fis); err != nil {
+			return nil, err
+		}
+	}
+
+	if res, err = s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(searchIndexRegistry)}); err != nil {
+		return nil, err
+	}
+	reg := &pb.Hash{}
+	searchRev := int64(0)
+	if len(res.Kvs) > 0 {
+		searchRev = res.Kvs[0].ModRevision
+		if err := proto.Unmarshal(res.Kvs[0].Value, reg); err != nil {
+			return nil, err
+		}
+	}
+
+	hi := &hashIndexes{
+		fields:   fis.Indexes,
+		searches: []*pb.SearchIndex{},
+		compares: []*etcdpb.Compare{
+			txnModCompare(util.StringToBytes(fieldIndexRegistry), fieldRev),
+			txnModCompare(util.StringToBytes(searchIndexRegistry), searchRev),
+		},
+	}
+	for indexKey, b := range reg.Value {
+		si := &pb.SearchIndex{}
+		if err := proto.Unmarshal(b, si); err != nil {
+			return nil, err
+		}
+		si.Key = indexKey
+		hi.searches = append(hi.searches, si)
+	}
+	return hi, nil
+}
+
+// forKey returns the indexes that include the given key.
+func (hi *hashIndexes) forKey(key string) *hashIndexes {
+	indexes := &hashIndexes{fields: []*pb.FieldIndex{}, searches: []*pb.SearchIndex{}, compares: hi.compares}
+	for _, fi := range hi.fields {
+		if strings.HasPrefix(key, fi.Prefix) {
+			indexes.fields = append(indexes.fields, fi)
+		}
+	}
+	for _, si := range hi.searches {
+		if strings.HasPrefix(key, si.Prefix) && key != si.Key {
+			indexes.searches = append(indexes.searches, si)
+		}
+	}
+	return indexes
+}
+
+func (hi *hashIndexes) empty() bool {
+	return len(hi.fields) == 0 && len(hi.searches) == 0
+}
+
+// ops returns the operations that replace the index entries for the old hash with the ones for the new hash,
+// either of which is nil if the key doesn't exist or isn't a hash. The entries are put with the lease the key is
+// written with, so they go away with the key when it expires or its lease is revoked.
+func (hi *hashIndexes) ops(key string, old, new *pb.Hash, oldLease, lease int64) []*etcdpb.RequestOp {
+	return indexEntryOps(hi.entries(key, old), hi.entries(key, new), lease, oldLease != lease)
+}
+
+func (hi *hashIndexes) entries(key string, h *pb.Hash) map[string]string {
+	entries := fieldIndexEntries(hi.fields, key, h)
+	for name, value := range searchIndexEntries(hi.searches, key, h) {
+		entries[name] = value
+	}
+	return entries
+}
+
+// indexOps reads the indexes that include a key and, if there are any, the current value of the key, returning the
+// operations that update the index entries from the current value to the given one, which is nil if the key is being
+// deleted, and the lease it's written with. Writes made without holding the key's lock must also check the compares,
+// which fail if the value or the indexes changed since they were read.
+func (s *Server) indexOps(ctx context.Context, key string, value []byte, lease int64) ([]*etcdpb.Compare, []*etcdpb.RequestOp, error) {
+	// internal keys are never indexed.
+	if isInternalKey(key) {
+		return nil, nil, nil
+	}
+	all, err := s.getHashIndexes(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	hi := all.forKey(key)
+	if hi.empty() {
+		return hi.compares, nil, nil
+	}
+
+	bkey := util.StringToBytes(key)
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: bkey})
+	if err != nil {
+		return nil, nil, err
+	}
+	var old []byte
+	rev := int64(0)
+	oldLease := int64(0)
+	if len(res.Kvs) > 0 {
+		old = res.Kvs[0].Value
+		rev = res.Kvs[0].ModRevision
+		oldLease = res.Kvs[0].Lease
+	}
+	compares := append([]*etcdpb.Compare{txnModCompare(bkey, rev)}, hi.compares...)
+	return compares, hi.ops(key, indexedHash(old, rev > 0), indexedHash(value, value != nil), oldLease, lease), nil
+}
+
+// indexedHash returns the hash stored in a value, or nil if the key doesn't exist or its value isn't a hash.
+func indexedHash(b []byte, exists bool) *pb.Hash {
+	if !exists {
+		return nil
+	}
+	h, err := txnHash(b)
+	if err != nil {
+		return nil
+	}
+	return h
+}
+
+// registerFieldIndex adds or replaces an index in the registry, or removes it if remove is true.
+func (s *Server) registerFieldIndex(ctx context.Context, fi *pb.FieldIndex, remove bool) error {
+	key := &pb.Key{Key: fieldIndexRegistry}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return err
+	}
+
+	fis, err := s.getFieldIndexes(ctx)
+	if err != nil {
+		s.Unlock(ctx, key)
+		return err
+	}
+
+	found := false
+	for i, existing := range fis.Indexes {
+		if existing.Prefix == fi.Prefix && existing.Field == fi.Field {
+			found = true
+			if remove {
+				fis.Indexes = append(fis.Indexes[:i], fis.Indexes[i+1:]...)
+			} else {
+				fis.Indexes[i] = fi
+			}
+			break
+		}
+	}
+	if !found && remove {
+		s.Unlock(ctx, key)
+		return util.ErrFieldIndexNotFound
+	} else if !found {
+		fis.Indexes = append(fis.Indexes, fi)
+	}
+
+	b, err := proto.Marshal(fis)
+	if err != nil {
+		s.Unlock(ctx, key)
+		return err
+	}
+	_, err = s.UnlockThenSet(ctx, &pb.ByteValue{Key: fieldIndexRegistry, Value: b})
+	return err
+}
+
+func (s *Server) deleteFieldIndexEntries(ctx context.Context, fi *pb.FieldIndex) error {
+	base := fieldIndexBase(fi)
+	_, err := s.storage.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{
+		Key:      util.StringToBytes(base),
+		RangeEnd: getPrefix(base),
+	})
+	return err
+}
+
+// indexExistingHash adds the index entries for a hash while it's locked, so it can't change while being indexed.
+// The entries are put with the lease of the hash, like the ones added when it's written.
+func (s *Server) indexExistingHash(ctx context.Context, indexes *hashIndexes, key string) error {
+	k := &pb.Key{Key: key}
+	if _, err := s.Lock(ctx, k); err != nil {
+		return err
+	}
+
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(key)})
+	if err != nil {
+		s.Unlock(ctx, k)
+		return err
+	}
+
+	// keys under the prefix that no longer exist or aren't hashes are not indexed.
+	var h *pb.Hash
+	if len(res.Kvs) > 0 {
+		h = indexedHash(res.Kvs[0].Value, true)
+	}
+	if h == nil {
+		s.Unlock(ctx, k)
+		return nil
+	}
+
+	ops := indexEntryOps(nil, indexes.entries(key, h), res.Kvs[0].Lease, false)
+	if len(ops) > 0 {
+		// if the lease ran out since the hash was read, the hash is gone along with it, so it isn't indexed.
+		if _, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{Success: ops}); err != nil && err != lease.ErrLeaseNotFound {
+			s.Unlock(ctx, k)
+			return err
+		}
+	}
+	_, err = s.Unlock(ctx, k)
+	return err
+}
+
+// fieldIndexEntries returns the index entry keys for the given hash, mapped to the key of the hash.
+func fieldIndexEntries(indexes []*pb.FieldIndex, key string, h *pb.Hash) map[string]string {
+	entries := map[string]string{}
+	if h == nil {
+		return entries
+	}
+	for _, fi := range indexes {
+		b, ok := h.Value[fi.Field]
+		if !ok {
+			continue
+		}
+		if value, ok := fieldIndexValue(fi, b); ok {
+			entries[fieldIndexBase(fi)+encodeKeyPart(value)+key] = key
+		}
+	}
+	return entries
+}
+
+// indexEntryOps returns the operations that delete the old entries and put the new ones, mapped to their values, with
+// the given lease. Entries that didn't change are only put again if the lease changed.
+func indexEntryOps(oldEntries, newEntries map[string]string, lease int64, leaseChanged bool) []*etcdpb.RequestOp {
+	ops := []*etcdpb.RequestOp{}
+	for entry := range oldEntries {
+		if _, ok := newEntries[entry]; ok {
+			continue
+		}
+		ops = append(ops, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: []byte(entry),
+				},
+			},
+		})
+	}
+	for entry, value := range newEntries {
+		if old, ok := oldEntries[entry]; ok && old == value && !leaseChanged {
+			continue
+		}
+		ops = append(ops, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   []byte(entry),
+					Value: []byte(value),
+					Lease: lease,
+				},
+			},
+		})
+	}
+	return ops
+}
+
+func fieldIndexBase(fi *pb.FieldIndex) string {
+	return prefixForFieldIndexes + encodeKeyPart(fi.Prefix) + encodeKeyPart(fi.Field)
+}
+
+// fieldIndexValue returns the value as it's stored in the index, or false if it can't be indexed.
+func fieldIndexValue(fi *pb.FieldIndex, b []byte) (string, bool) {
+	if !fi.Numeric {
+		return string(b), true
+	}
+	f, ok := parseIndexNumber(b)
+	if !ok || math.IsNaN(f) {
+		return "", false
+	}
+	return encodeIndexNumber(f), true
+}
+
+// parseIndexNumber parses a number stored either as text, or as an IntValue or FloatValue like IncrementInt
+// and IncrementFloat use. Since zero values encode to nothing, an empty value is zero.
+func parseIndexNumber(b []byte) (float64, bool) {
+	if len(b) == 0 {
+		return 0, true
+	}
+	if f, err := strconv.ParseFloat(strings.TrimSpace(util.BytesToString(b)), 64); err == nil {
+		return f, true
+	}
+
+	// values may start with an empty key field, then the tag for field 2, which is a varint for IntValue and
+	// 64-bit for FloatValue.
+	tag := b
+	if len(tag) >= 2 && tag[0] == 0x0a && tag[1] == 0 {
+		tag = tag[2:]
+	}
+	if len(tag) == 0 {
+		return 0, true
+	}
+	switch tag[0] {
+	case 0x10:
+		iv := &pb.IntValue{}
+		if err := proto.Unmarshal(b, iv); err == nil {
+			return float64(iv.Value), true
+		}
+	case 0x11:
+		fv := &pb.FloatValue{}
+		if err := proto.Unmarshal(b, fv); err == nil {
+			return fv.Value, true
+		}
+	}
+	return 0, false
+}
+
+// encodeIndexNumber encodes a float so that the encoded values sort in the same order as the numbers.
+func encodeIndexNumber(f float64) string {
+	if f == 0 {
+		// treat negative zero the same as zero.
+		f = 0
+	}
+	bits := math.Float64bits(f)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, bits)
+	return hex.EncodeToString(b)
+}
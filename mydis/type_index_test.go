@@ -0,0 +1,196 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestEncodeIndexNumber(t *testing.T) {
+	nums := []float64{-100, -1.5, 0, 0.25, 1, 100}
+	for i := 1; i < len(nums); i++ {
+		if encodeIndexNumber(nums[i-1]) >= encodeIndexNumber(nums[i]) {
+			t.Error("Unexpected order:", nums[i-1], nums[i])
+		}
+	}
+
+	if f, ok := parseIndexNumber(util.NewValue(42).RawBytes()); !ok || f != 42 {
+		t.Error("Unexpected value:", f)
+	}
+	if f, ok := parseIndexNumber(util.NewValue(-2.5).RawBytes()); !ok || f != -2.5 {
+		t.Error("Unexpected value:", f)
+	}
+}
+
+func TestFieldIndexCreate(t *testing.T) {
+	testReset()
+
+	server.SetHash(ctx, &pb.Hash{Key: "user/1", Value: map[string][]byte{"email": []byte("a@example.com"), "age": []byte("30")}})
+
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "user/", Field: "email"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "user/", Field: "age", Numeric: true}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "user/"}); err != util.ErrInvalidFieldIndex {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("a@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "user/1" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+}
+
+func TestFieldIndexUpdates(t *testing.T) {
+	server.SetHashField(ctx, &pb.HashField{Key: "user/2", Field: "email", Value: []byte("b@example.com")})
+	server.SetHashFields(ctx, &pb.Hash{Key: "user/3", Value: map[string][]byte{"email": []byte("b@example.com"), "age": util.NewValue(25).RawBytes()}})
+	server.SetHashField(ctx, &pb.HashField{Key: "user/1", Field: "email", Value: []byte("c@example.com")})
+
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("b@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 2 || lst.Keys[0] != "user/2" || lst.Keys[1] != "user/3" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	// the old value is no longer indexed.
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("a@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	server.DelHashField(ctx, &pb.HashField{Key: "user/2", Field: "email"})
+	server.Delete(ctx, &pb.Key{Key: "user/3"})
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("b@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	// values containing zero bytes don't match the values they start with.
+	server.SetHashField(ctx, &pb.HashField{Key: "user/2", Field: "email", Value: []byte("b@example.com\x00x")})
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("b@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+	server.DelHashField(ctx, &pb.HashField{Key: "user/2", Field: "email"})
+
+	server.SetHash(ctx, &pb.Hash{Key: "user/1", Value: map[string][]byte{"email": []byte("d@example.com"), "age": []byte("30")}})
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("d@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+}
+
+func TestFindByFieldRange(t *testing.T) {
+	server.SetHashField(ctx, &pb.HashField{Key: "user/4", Field: "age", Value: []byte("18")})
+	server.SetHashField(ctx, &pb.HashField{Key: "user/5", Field: "age", Value: util.NewValue(45.5).RawBytes()})
+	server.SetHashField(ctx, &pb.HashField{Key: "user/6", Field: "age", Value: []byte("unknown")})
+
+	if lst, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", Min: 18, Max: 30}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 2 || lst.Keys[0] != "user/4" || lst.Keys[1] != "user/1" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	if lst, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", Min: 0, Max: 100, Limit: 2}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 2 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", Value: []byte("45.5")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "user/5" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	// the zero values of Min and Max are only bounds when they aren't left out.
+	if lst, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", Min: 30, NoMax: true}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 2 || lst.Keys[0] != "user/1" || lst.Keys[1] != "user/5" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+	if lst, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", NoMin: true, Max: 18}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "user/4" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	if _, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Max: 1}); err != util.ErrTypeMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestFieldIndexDrop(t *testing.T) {
+	if _, err := server.FieldIndexDrop(ctx, &pb.FieldIndex{Prefix: "user/", Field: "age"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.FindByFieldRange(ctx, &pb.FieldQuery{Prefix: "user/", Field: "age", Max: 100}); err != util.ErrFieldIndexNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if keys, err := server.KeysWithPrefix(ctx, &pb.Key{Key: fieldIndexBase(&pb.FieldIndex{Prefix: "user/", Field: "age"})}); err != nil {
+		t.Error(err)
+	} else if len(keys.Keys) != 0 {
+		t.Error("Unexpected value:", keys.Keys)
+	}
+}
+
+func TestFieldIndexLease(t *testing.T) {
+	testReset()
+
+	if _, err := server.GrantLease(ctx, &pb.Expiration{Key: "members", Exp: 30}); err != nil {
+		t.Fatal(err)
+	}
+	leaseCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lease", "members"))
+	server.SetHash(leaseCtx, &pb.Hash{Key: "member/1", Value: map[string][]byte{"team": []byte("red")}})
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "member/", Field: "team"}); err != nil {
+		t.Fatal(err)
+	}
+	defer server.FieldIndexDrop(ctx, &pb.FieldIndex{Prefix: "member/", Field: "team"})
+	server.SetHash(leaseCtx, &pb.Hash{Key: "member/2", Value: map[string][]byte{"team": []byte("red")}})
+	server.SetHash(leaseCtx, &pb.Hash{Key: "member/3", Value: map[string][]byte{"team": []byte("red")}})
+	if _, err := server.Persist(ctx, &pb.Key{Key: "member/3"}); err != nil {
+		t.Error(err)
+	}
+
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "member/", Field: "team", Value: []byte("red")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 3 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	// the index entries go away with the keys when their lease is revoked, except for the key that was persisted.
+	if _, err := server.RevokeLease(ctx, &pb.Key{Key: "members"}); err != nil {
+		t.Error(err)
+	}
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "member/", Field: "team", Value: []byte("red")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "member/3" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"golang.org/x/net/context"
+)
+
+// jsonPathElement is a single step in a JSON path, either an object field or an array index.
+type jsonPathElement struct {
+	field   string
+	index   int
+	isIndex bool
+}
+
+// parseJSONPath parses a JSONPath-style expression such as `$.a.b[0]['c d']` into its elements.
+// The leading `$` is optional, and an empty path refers to the root of the document.
+func parseJSONPath(path string) ([]jsonPathElement, error) {
+	path = strings.TrimSpace(path)
+	hasRoot := strings.HasPrefix(path, "$")
+	path = strings.TrimPrefix(path, "$")
+	elements := []jsonPathElement{}
+
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				if i == len(path) && len(elements) == 0 {
+					return elements, nil
+				}
+				return nil, util.ErrInvalidJSONPath
+			}
+			elements = append(elements, jsonPathElement{field: path[i:end]})
+			i = end
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, util.ErrInvalidJSONPath
+			}
+			inner := path[i+1 : i+end]
+			i += end + 1
+
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				elements = append(elements, jsonPathElement{field: inner[1 : len(inner)-1]})
+				continue
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, util.ErrInvalidJSONPath
+			}
+			elements = append(elements, jsonPathElement{index: index, isIndex: true})
+		default:
+			if i > 0 || hasRoot {
+				return nil, util.ErrInvalidJSONPath
+			}
+			// allow paths without a leading '.', such as "a.b".
+			path = "." + path
+		}
+	}
+	return elements, nil
+}
+
+func decodeJSON(b []byte) (interface{}, error) {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// jsonLookup returns the value at the given path.
+func jsonLookup(doc interface{}, elements []jsonPathElement) (interface{}, error) {
+	current := doc
+	for _, el := range elements {
+		child, err := jsonChild(current, el)
+		if err != nil {
+			return nil, err
+		}
+		current = child
+	}
+	return current, nil
+}
+
+func jsonChild(parent interface{}, el jsonPathElement) (interface{}, error) {
+	if el.isIndex {
+		arr, ok := parent.([]interface{})
+		if !ok {
+			return nil, util.ErrJSONPathNotFound
+		}
+		index, ok := jsonIndex(arr, el.index)
+		if !ok {
+			return nil, util.ErrJSONPathNotFound
+		}
+		return arr[index], nil
+	}
+
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return nil, util.ErrJSONPathNotFound
+	}
+	child, ok := obj[el.field]
+	if !ok {
+		return nil, util.ErrJSONPathNotFound
+	}
+	return child, nil
+}
+
+func jsonIndex(arr []interface{}, index int) (int, bool) {
+	if index < 0 {
+		index += len(arr)
+	}
+	if index < 0 || index >= len(arr) {
+		return 0, false
+	}
+	return index, true
+}
+
+// jsonReplace sets the value at the given path, creating the last object field if it doesn't exist,
+// and returns the new root of the document.
+func jsonReplace(doc interface{}, elements []jsonPathElement, value interface{}) (interface{}, error) {
+	if len(elements) == 0 {
+		return value, nil
+	}
+
+	parent, err := jsonLookup(doc, elements[:len(elements)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	el := elements[len(elements)-1]
+	if el.isIndex {
+		arr, ok := parent.([]interface{})
+		if !ok {
+			return nil, util.ErrJSONPathNotFound
+		}
+		index, ok := jsonIndex(arr, el.index)
+		if !ok {
+			return nil, util.ErrListIndexOutOfRange
+		}
+		arr[index] = value
+		return doc, nil
+	}
+
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return nil, util.ErrJSONPathNotFound
+	}
+	obj[el.field] = value
+	return doc, nil
+}
+
+// jsonRemove removes the value at the given path and returns the new root of the document.
+func jsonRemove(doc interface{}, elements []jsonPathElement) (interface{}, error) {
+	if len(elements) == 0 {
+		return nil, util.ErrInvalidJSONPath
+	}
+
+	parentPath := elements[:len(elements)-1]
+	parent, err := jsonLookup(doc, parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	el := elements[len(elements)-1]
+	if el.isIndex {
+		arr, ok := parent.([]interface{})
+		if !ok {
+			return nil, util.ErrJSONPathNotFound
+		}
+		index, ok := jsonIndex(arr, el.index)
+		if !ok {
+			return nil, util.ErrJSONPathNotFound
+		}
+		// removing from a slice creates a new slice header, so it must be stored back in its parent.
+		arr = append(arr[:index], arr[index+1:]...)
+		return jsonReplace(doc, parentPath, arr)
+	}
+
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return nil, util.ErrJSONPathNotFound
+	}
+	if _, ok := obj[el.field]; !ok {
+		return nil, util.ErrJSONPathNotFound
+	}
+	delete(obj, el.field)
+	return doc, nil
+}
+
+// getJSON gets and decodes the JSON document stored at the given key.
+func (s *Server) getJSON(ctx context.Context, key string) (interface{}, error) {
+	bv, err := s.Get(ctx, &pb.Key{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decodeJSON(bv.Value)
+	if err != nil {
+		return nil, util.ErrTypeMismatch
+	}
+	return doc, nil
+}
+
+// updateJSON locks the document, applies fn to it, then stores the result. If the key doesn't exist,
+// fn receives a nil document.
+func (s *Server) updateJSON(ctx context.Context, key string, fn func(doc interface{}) (interface{}, error)) error {
+	k := &pb.Key{Key: key}
+	if _, err := s.Lock(ctx, k); err != nil {
+		return err
+	}
+
+	doc, err := s.getJSON(ctx, key)
+	if err != nil && err != util.ErrKeyNotFound {
+		s.Unlock(ctx, k)
+		return err
+	}
+
+	doc, err = fn(doc)
+	if err != nil {
+		s.Unlock(ctx, k)
+		return err
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		s.Unlock(ctx, k)
+		return err
+	}
+	_, err = s.UnlockThenSet(ctx, &pb.ByteValue{Key: key, Value: b})
+	return err
+}
+
+// JSONGet gets the JSON value at the given path in a document.
+func (s *Server) JSONGet(ctx context.Context, jp *pb.JSONPath) (*pb.ByteValue, error) {
+	elements, err := parseJSONPath(jp.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := s.getJSON(ctx, jp.Key)
+	if err != nil {
+		return nil, err
+	}
+	v, err := jsonLookup(doc, elements)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ByteValue{Value: b}, nil
+}
+
+// JSONSet sets the JSON value at the given path in a document. Missing object fields are created, but
+// only for the last element of the path. Setting the root path creates a new document if key doesn't exist.
+func (s *Server) JSONSet(ctx context.Context, jv *pb.JSONValue) (*pb.Null, error) {
+	elements, err := parseJSONPath(jv.Path)
+	if err != nil {
+		return null, err
+	}
+	value, err := decodeJSON(jv.Value)
+	if err != nil {
+		return null, util.ErrInvalidJSON
+	}
+
+	return null, s.updateJSON(ctx, jv.Key, func(doc interface{}) (interface{}, error) {
+		if doc == nil && len(elements) > 0 {
+			return nil, util.ErrKeyNotFound
+		}
+		return jsonReplace(doc, elements, value)
+	})
+}
+
+// JSONDel removes the value at the given path from a document. Removing the root path deletes the key.
+func (s *Server) JSONDel(ctx context.Context, jp *pb.JSONPath) (*pb.Null, error) {
+	elements, err := parseJSONPath(jp.Path)
+	if err != nil {
+		return null, err
+	}
+	if len(elements) == 0 {
+		return s.Delete(ctx, &pb.Key{Key: jp.Key})
+	}
+
+	return null, s.updateJSON(ctx, jp.Key, func(doc interface{}) (interface{}, error) {
+		if doc == nil {
+			return nil, util.ErrKeyNotFound
+		}
+		return jsonRemove(doc, elements)
+	})
+}
+
+// JSONArrAppend appends a JSON value to the array at the given path, returns the new length of the array.
+func (s *Server) JSONArrAppend(ctx context.Context, jv *pb.JSONValue) (*pb.IntValue, error) {
+	elements, err := parseJSONPath(jv.Path)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeJSON(jv.Value)
+	if err != nil {
+		return nil, util.ErrInvalidJSON
+	}
+
+	length := 0
+	err = s.updateJSON(ctx, jv.Key, func(doc interface{}) (interface{}, error) {
+		if doc == nil {
+			return nil, util.ErrKeyNotFound
+		}
+		v, err := jsonLookup(doc, elements)
+		if err != nil {
+			return nil, err
+		}
+		arr, ok := v.([]interface{})
+		if !ok {
+			return nil, util.ErrTypeMismatch
+		}
+		arr = append(arr, value)
+		length = len(arr)
+		return jsonReplace(doc, elements, arr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IntValue{Value: int64(length)}, nil
+}
+
+// JSONNumIncrBy increments the number at the given path and returns the new value.
+func (s *Server) JSONNumIncrBy(ctx context.Context, jn *pb.JSONNumber) (*pb.FloatValue, error) {
+	elements, err := parseJSONPath(jn.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := float64(0)
+	err = s.updateJSON(ctx, jn.Key, func(doc interface{}) (interface{}, error) {
+		if doc == nil {
+			return nil, util.ErrKeyNotFound
+		}
+		v, err := jsonLookup(doc, elements)
+		if err != nil {
+			return nil, err
+		}
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, util.ErrTypeMismatch
+		}
+		f, err := num.Float64()
+		if err != nil {
+			return nil, util.ErrTypeMismatch
+		}
+		result = f + jn.Value
+
+		// keep integers as integers when possible.
+		newNum := json.Number(strconv.FormatFloat(result, 'f', -1, 64))
+		return jsonReplace(doc, elements, newNum)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FloatValue{Value: result}, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+func TestJSONPath(t *testing.T) {
+	if p, err := parseJSONPath("$.a.b[0]['c d'][-1]"); err != nil {
+		t.Error(err)
+	} else if len(p) != 5 || p[1].field != "b" || !p[2].isIndex || p[3].field != "c d" || p[4].index != -1 {
+		t.Error("Unexpected value:", p)
+	}
+
+	if p, err := parseJSONPath("a.b"); err != nil {
+		t.Error(err)
+	} else if len(p) != 2 || p[0].field != "a" {
+		t.Error("Unexpected value:", p)
+	}
+
+	for _, root := range []string{"", "$", "."} {
+		if p, err := parseJSONPath(root); err != nil {
+			t.Error(err)
+		} else if len(p) != 0 {
+			t.Error("Unexpected value:", p)
+		}
+	}
+
+	for _, invalid := range []string{"$.a..b", "$[x]", "$.a[0", "$a"} {
+		if _, err := parseJSONPath(invalid); err != util.ErrInvalidJSONPath {
+			t.Error("Unexpected or no error for", invalid, err)
+		}
+	}
+}
+
+func TestJSONSet(t *testing.T) {
+	testReset()
+
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$.a", Value: []byte(`1`)}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	doc := `{"name": "mydis", "tags": ["a", "b"], "stats": {"count": 1}}`
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$", Value: []byte(doc)}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$.stats.size", Value: []byte(`10`)}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$.tags[-1]", Value: []byte(`"c"`)}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$.missing.field", Value: []byte(`1`)}); err != util.ErrJSONPathNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if _, err := server.JSONSet(ctx, &pb.JSONValue{Key: "doc", Path: "$.name", Value: []byte(`{bad`)}); err != util.ErrInvalidJSON {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestJSONGet(t *testing.T) {
+	if v, err := server.JSONGet(ctx, &pb.JSONPath{Key: "doc", Path: "$.stats"}); err != nil {
+		t.Error(err)
+	} else if string(v.Value) != `{"count":1,"size":10}` {
+		t.Error("Unexpected value:", string(v.Value))
+	}
+
+	if v, err := server.JSONGet(ctx, &pb.JSONPath{Key: "doc", Path: "$.tags[1]"}); err != nil {
+		t.Error(err)
+	} else if string(v.Value) != `"c"` {
+		t.Error("Unexpected value:", string(v.Value))
+	}
+
+	if _, err := server.JSONGet(ctx, &pb.JSONPath{Key: "doc", Path: "$.tags[5]"}); err != util.ErrJSONPathNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	server.Set(ctx, &pb.ByteValue{Key: "notjson", Value: []byte("{")})
+	if _, err := server.JSONGet(ctx, &pb.JSONPath{Key: "notjson"}); err != util.ErrTypeMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestJSONArrAppend(t *testing.T) {
+	if v, err := server.JSONArrAppend(ctx, &pb.JSONValue{Key: "doc", Path: "$.tags", Value: []byte(`{"x": 1}`)}); err != nil {
+		t.Error(err)
+	} else if v.Value != 3 {
+		t.Error("Unexpected value:", v.Value)
+	}
+
+	if _, err := server.JSONArrAppend(ctx, &pb.JSONValue{Key: "doc", Path: "$.name", Value: []byte(`1`)}); err != util.ErrTypeMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if v, err := server.JSONGet(ctx, &pb.JSONPath{Key: "doc", Path: "$.tags[2].x"}); err != nil {
+		t.Error(err)
+	} else if string(v.Value) != `1` {
+		t.Error("Unexpected value:", string(v.Value))
+	}
+}
+
+func TestJSONNumIncrBy(t *testing.T) {
+	if v, err := server.JSONNumIncrBy(ctx, &pb.JSONNumber{Key: "doc", Path: "$.stats.count", Value: 2}); err != nil {
+		t.Error(err)
+	} else if v.Value != 3 {
+		t.Error("Unexpected value:", v.Value)
+	}
+
+	if v, err := server.JSONNumIncrBy(ctx, &pb.JSONNumber{Key: "doc", Path: "$.stats.count", Value: 0.5}); err != nil {
+		t.Error(err)
+	} else if v.Value != 3.5 {
+		t.Error("Unexpected value:", v.Value)
+	}
+
+	if _, err := server.JSONNumIncrBy(ctx, &pb.JSONNumber{Key: "doc", Path: "$.name", Value: 1}); err != util.ErrTypeMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestJSONDel(t *testing.T) {
+	if _, err := server.JSONDel(ctx, &pb.JSONPath{Key: "doc", Path: "$.tags[0]"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.JSONDel(ctx, &pb.JSONPath{Key: "doc", Path: "$.stats"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.JSONDel(ctx, &pb.JSONPath{Key: "doc", Path: "$.stats"}); err != util.ErrJSONPathNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if v, err := server.JSONGet(ctx, &pb.JSONPath{Key: "doc"}); err != nil {
+		t.Error(err)
+	} else if string(v.Value) != `{"name":"mydis","tags":["c",{"x":1}]}` {
+		t.Error("Unexpected value:", string(v.Value))
+	}
+
+	if _, err := server.JSONDel(ctx, &pb.JSONPath{Key: "doc", Path: "$"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.Get(ctx, &pb.Key{Key: "doc"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
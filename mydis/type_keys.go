@@ -15,32 +15,31 @@
 package mydis
 
 import (
+	"bytes"
+	"sort"
 	"time"
 
 	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
 	"golang.org/x/net/context"
 )
 
 // Keys returns a list of valid keys.
 func (s *Server) Keys(ctx context.Context, null *pb.Null) (*pb.KeysList, error) {
-	res, err := s.cache.Server.Range(ctx, &etcdpb.RangeRequest{
-		Key:      ZeroByte,
-		RangeEnd: ZeroByte,
-		KeysOnly: true,
-	})
-	if err != nil {
-		return nil, err
-	}
-	return kvsToList(res.Kvs), nil
+	return s.KeysWithPrefix(ctx, &pb.Key{})
 }
 
 // KeysWithPrefix returns a list of keys with the given prefix.
 func (s *Server) KeysWithPrefix(ctx context.Context, key *pb.Key) (*pb.KeysList, error) {
-	res, err := s.cache.Server.Range(ctx, &etcdpb.RangeRequest{
-		Key:      util.StringToBytes(key.Key),
-		RangeEnd: getPrefix(key.Key),
+	start, end, ok := getUserRange(key.Key)
+	if !ok {
+		return &pb.KeysList{Keys: []string{}}, nil
+	}
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      start,
+		RangeEnd: end,
 		KeysOnly: true,
 	})
 	if err != nil {
@@ -51,7 +50,7 @@ func (s *Server) KeysWithPrefix(ctx context.Context, key *pb.Key) (*pb.KeysList,
 
 // Has determines if the given key exists.
 func (s *Server) Has(ctx context.Context, key *pb.Key) (*pb.Bool, error) {
-	res, err := s.cache.Server.Range(ctx, &etcdpb.RangeRequest{
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
 		Key:      util.StringToBytes(key.Key),
 		KeysOnly: true,
 	})
@@ -69,56 +68,384 @@ func (s *Server) Has(ctx context.Context, key *pb.Key) (*pb.Bool, error) {
 	return &pb.Bool{Value: false}, nil
 }
 
-// SetExpire sets the expiration in seconds on a key.
+// SetExpire sets the expiration in seconds on a key, keeping its value.
 func (s *Server) SetExpire(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
-	res, err := s.cache.Server.LeaseGrant(ctx, &etcdpb.LeaseGrantRequest{
-		TTL: ex.Exp,
-	})
-	if err != nil && err.Error() == util.ErrKeyNotFound.Error() {
-		return null, util.ErrKeyNotFound
-	} else if err != nil {
-		return null, err
+	return null, s.expireAt(ctx, ex.Key, time.Now().Add(time.Duration(ex.Exp)*time.Second))
+}
+
+// SetExpireMillis sets the expiration in milliseconds on a key, keeping its value.
+func (s *Server) SetExpireMillis(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
+	return null, s.expireAt(ctx, ex.Key, time.Now().Add(time.Duration(ex.Exp)*time.Millisecond))
+}
+
+// ExpireAt sets the expiration on a key to the given Unix time in seconds, keeping its value.
+func (s *Server) ExpireAt(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
+	return null, s.expireAt(ctx, ex.Key, time.Unix(ex.Exp, 0))
+}
+
+// ExpireAtMillis sets the expiration on a key to the given Unix time in milliseconds, keeping its value.
+func (s *Server) ExpireAtMillis(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
+	return null, s.expireAt(ctx, ex.Key, fromMillis(ex.Exp))
+}
+
+// SetWithExpire sets a value and its expiration in seconds in a single write.
+func (s *Server) SetWithExpire(ctx context.Context, ev *pb.ExpiringValue) (*pb.Null, error) {
+	return null, s.setWithExpireAt(ctx, ev, time.Now().Add(time.Duration(ev.Exp)*time.Second))
+}
+
+// SetWithExpireMillis sets a value and its expiration in milliseconds in a single write.
+func (s *Server) SetWithExpireMillis(ctx context.Context, ev *pb.ExpiringValue) (*pb.Null, error) {
+	return null, s.setWithExpireAt(ctx, ev, time.Now().Add(time.Duration(ev.Exp)*time.Millisecond))
+}
+
+// TTL gets the number of seconds until a key expires, or -1 if it doesn't expire.
+func (s *Server) TTL(ctx context.Context, key *pb.Key) (*pb.IntValue, error) {
+	ttl, err := s.ttl(ctx, key.Key)
+	if err != nil {
+		return &pb.IntValue{}, err
+	} else if ttl < 0 {
+		return &pb.IntValue{Value: -1}, nil
+	}
+	return &pb.IntValue{Value: int64((ttl + time.Second/2) / time.Second)}, nil
+}
+
+// TTLMillis gets the number of milliseconds until a key expires, or -1 if it doesn't expire.
+func (s *Server) TTLMillis(ctx context.Context, key *pb.Key) (*pb.IntValue, error) {
+	ttl, err := s.ttl(ctx, key.Key)
+	if err != nil {
+		return &pb.IntValue{}, err
+	} else if ttl < 0 {
+		return &pb.IntValue{Value: -1}, nil
+	}
+	return &pb.IntValue{Value: int64(ttl / time.Millisecond)}, nil
+}
+
+// Persist removes the expiration from a key, keeping its value. Returns true if the key had an expiration.
+// If the key is locked by anyone but the caller's lock owner, it waits for the key to be unlocked like Set.
+func (s *Server) Persist(ctx context.Context, key *pb.Key) (*pb.Bool, error) {
+	maxW := s.getMaxWait(ctx)
+	maxWait := time.Now().Add(time.Duration(maxW) * time.Second)
+	bkey := util.StringToBytes(key.Key)
+	for {
+		res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: bkey})
+		if err != nil {
+			return nil, err
+		} else if len(res.Kvs) == 0 {
+			return nil, util.ErrKeyNotFound
+		}
+		kv := res.Kvs[0]
+		if kv.Lease == 0 {
+			return &pb.Bool{Value: false}, nil
+		}
+
+		lockCmp, ok, err := s.getWriteLockCompare(ctx, key.Key)
+		if err != nil {
+			return nil, err
+		} else if ok {
+			// the index entries of the key are detached from its lease along with it.
+			compares, ops, err := s.indexOps(ctx, key.Key, kv.Value, 0)
+			if err != nil {
+				return nil, err
+			}
+			txn, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: append([]*etcdpb.Compare{txnModCompare(bkey, kv.ModRevision), lockCmp}, compares...),
+				Success: append([]*etcdpb.RequestOp{
+					{
+						Request: &etcdpb.RequestOp_RequestPut{
+							RequestPut: &etcdpb.PutRequest{
+								Key:   bkey,
+								Value: kv.Value,
+							},
+						},
+					},
+					{
+						Request: &etcdpb.RequestOp_RequestDeleteRange{
+							RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+								Key: getExpirationName(key.Key),
+							},
+						},
+					},
+				}, ops...),
+			})
+			if err != nil {
+				return nil, err
+			} else if txn.Succeeded {
+				return &pb.Bool{Value: true}, nil
+			}
+			continue
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			return nil, util.ErrKeyLocked
+		}
+	}
+}
+
+// expireAt attaches a new lease to a key that expires at the given time, keeping its value. If the time has already
+// passed, the key is deleted. If the key is locked by anyone but the caller's lock owner, it waits for the key to be
+// unlocked like Set.
+func (s *Server) expireAt(ctx context.Context, key string, at time.Time) error {
+	if !at.After(time.Now()) {
+		if b, err := s.Has(ctx, &pb.Key{Key: key}); err != nil {
+			return err
+		} else if !b.Value {
+			return util.ErrKeyNotFound
+		}
+		_, err := s.Delete(ctx, &pb.Key{Key: key})
+		return err
+	}
+
+	maxW := s.getMaxWait(ctx)
+	maxWait := time.Now().Add(time.Duration(maxW) * time.Second)
+	bkey := util.StringToBytes(key)
+	for {
+		res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: bkey})
+		if err != nil {
+			return err
+		} else if len(res.Kvs) == 0 {
+			return util.ErrKeyNotFound
+		}
+		kv := res.Kvs[0]
+
+		lockCmp, ok, err := s.getWriteLockCompare(ctx, key)
+		if err != nil {
+			return err
+		} else if ok {
+			ops, lease, err := s.expiringPutOps(ctx, key, kv.Value, at)
+			if err != nil {
+				return err
+			}
+			// the index entries of the key are moved to the new lease along with it.
+			compares, iops, err := s.indexOps(ctx, key, kv.Value, lease)
+			if err != nil {
+				s.revokeLease(ctx, lease)
+				return err
+			}
+			txn, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: append([]*etcdpb.Compare{txnModCompare(bkey, kv.ModRevision), lockCmp}, compares...),
+				Success: append(ops, iops...),
+			})
+			if err != nil || !txn.Succeeded {
+				s.revokeLease(ctx, lease)
+			}
+			if err != nil {
+				return err
+			} else if txn.Succeeded {
+				return nil
+			}
+			continue
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			return util.ErrKeyLocked
+		}
+	}
+}
+
+// setWithExpireAt sets a value with a lease that expires at the given time, updating the indexes that include it.
+// If the key is locked by anyone but the caller's lock owner, it waits for the key to be unlocked like Set.
+func (s *Server) setWithExpireAt(ctx context.Context, ev *pb.ExpiringValue, at time.Time) error {
+	bkey := util.StringToBytes(ev.Key)
+	if len(bkey) == 0 || bytes.Equal(bkey, ZeroByte) {
+		return util.ErrInvalidKey
+	}
+	if ev.Exp <= 0 {
+		return util.ErrInvalidExpiration
+	}
+	if err := s.beforeSet(ctx, ev.Key, ev.Value); err != nil {
+		return err
+	}
+
+	ops, lease, err := s.expiringPutOps(ctx, ev.Key, ev.Value, at)
+	if err != nil {
+		return err
 	}
 
-	_, err = s.cache.Server.Put(ctx, &etcdpb.PutRequest{
-		Key:   util.StringToBytes(ex.Key),
-		Lease: res.ID,
+	maxW := s.getMaxWait(ctx)
+	maxWait := time.Now().Add(time.Duration(maxW) * time.Second)
+
+	for {
+		lockCmp, ok, err := s.getWriteLockCompare(ctx, ev.Key)
+		if err != nil {
+			s.revokeLease(ctx, lease)
+			return err
+		} else if ok {
+			compares, iops, err := s.indexOps(ctx, ev.Key, ev.Value, lease)
+			if err != nil {
+				s.revokeLease(ctx, lease)
+				return err
+			}
+			// the key must not be locked by anyone else, and if it's indexed, must not have changed since its index
+			// entries were read.
+			res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: append([]*etcdpb.Compare{lockCmp}, compares...),
+				Success: append(ops, iops...),
+			})
+			if err != nil {
+				s.revokeLease(ctx, lease)
+				return err
+			} else if res.Succeeded {
+				s.afterSet(ctx, ev.Key, ev.Value)
+				return nil
+			}
+			continue
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			s.revokeLease(ctx, lease)
+			return util.ErrKeyLocked
+		}
+	}
+}
+
+// expiringPutOps grants a lease that expires at the given time and returns the operations that put the value with
+// the lease. Leases only have a precision of seconds, so the expiration time is kept with the key, on the same
+// lease, to report the time left precisely.
+func (s *Server) expiringPutOps(ctx context.Context, key string, value []byte, at time.Time) ([]*etcdpb.RequestOp, int64, error) {
+	ttl := (at.Sub(time.Now()) + time.Second - 1) / time.Second
+	if ttl < 1 {
+		ttl = 1
+	}
+	res, err := s.storage.LeaseGrant(ctx, &etcdpb.LeaseGrantRequest{
+		TTL: int64(ttl),
 	})
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return null, err
+	b, err := proto.Marshal(&pb.IntValue{Value: toMillis(at)})
+	if err != nil {
+		s.revokeLease(ctx, res.ID)
+		return nil, 0, err
+	}
+
+	return []*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   util.StringToBytes(key),
+					Value: value,
+					Lease: res.ID,
+				},
+			},
+		},
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   getExpirationName(key),
+					Value: b,
+					Lease: res.ID,
+				},
+			},
+		},
+	}, res.ID, nil
 }
 
-// Delete a key from the cache.
+// ttl returns the time left until a key expires, or -1 if it doesn't expire.
+func (s *Server) ttl(ctx context.Context, key string) (time.Duration, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(key)})
+	if err != nil {
+		return 0, err
+	} else if len(res.Kvs) == 0 {
+		return 0, util.ErrKeyNotFound
+	}
+	kv := res.Kvs[0]
+	if kv.Lease == 0 {
+		return -1, nil
+	}
+
+	// the expiration time is only used if it belongs to the key's current lease.
+	exp, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: getExpirationName(key)})
+	if err != nil {
+		return 0, err
+	}
+	if len(exp.Kvs) > 0 && exp.Kvs[0].Lease == kv.Lease {
+		at := &pb.IntValue{}
+		if err := proto.Unmarshal(exp.Kvs[0].Value, at); err == nil {
+			if ttl := fromMillis(at.Value).Sub(time.Now()); ttl > 0 {
+				return ttl, nil
+			}
+			return 0, nil
+		}
+	}
+
+	lease, err := s.storage.LeaseTimeToLive(ctx, &etcdpb.LeaseTimeToLiveRequest{ID: kv.Lease})
+	if err != nil {
+		return 0, err
+	} else if lease.TTL < 0 {
+		return 0, util.ErrKeyNotFound
+	}
+	return time.Duration(lease.TTL) * time.Second, nil
+}
+
+// revokeLease revokes a lease that was granted for a write that didn't happen.
+func (s *Server) revokeLease(ctx context.Context, id int64) {
+	s.storage.LeaseRevoke(ctx, &etcdpb.LeaseRevokeRequest{ID: id})
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// Delete a key from the cache, along with its index entries. If the key is locked by anyone but the caller's lock
+// owner, it waits for the key to be unlocked.
 func (s *Server) Delete(ctx context.Context, key *pb.Key) (*pb.Null, error) {
+	// hooks are only run for user keys.
+	if isInternalKey(key.Key) {
+		return s.deleteKey(ctx, key)
+	}
+	if err := s.beforeDelete(ctx, key.Key); err != nil {
+		return null, err
+	}
+	if _, err := s.deleteKey(ctx, key); err != nil {
+		return null, err
+	}
+	s.afterDelete(ctx, key.Key)
+	return null, nil
+}
+
+func (s *Server) deleteKey(ctx context.Context, key *pb.Key) (*pb.Null, error) {
 	maxW := time.Duration(s.getMaxWait(ctx))
 	maxWait := time.Now().Add(maxW * time.Second)
-	keyLock := getLockName(key.Key)
 
 	for {
-		if res, err := s.cache.Server.Txn(ctx, &etcdpb.TxnRequest{
-			Compare: []*etcdpb.Compare{
-				{
-					Key:    keyLock,
-					Target: etcdpb.Compare_VALUE,
-					Result: etcdpb.Compare_EQUAL,
-					TargetUnion: &etcdpb.Compare_Value{
-						Value: ZeroByte,
-					},
-				},
-			},
-			Failure: []*etcdpb.RequestOp{
-				{
-					Request: &etcdpb.RequestOp_RequestDeleteRange{
-						RequestDeleteRange: &etcdpb.DeleteRangeRequest{
-							Key: util.StringToBytes(key.Key),
+		lockCmp, ok, err := s.getWriteLockCompare(ctx, key.Key)
+		if err != nil {
+			return null, err
+		} else if ok {
+			compares, ops, err := s.indexOps(ctx, key.Key, nil, 0)
+			if err != nil {
+				return null, err
+			}
+			// the vectors of a vector index and the entries of a search index are stored in their own keys, which are
+			// deleted with it.
+			res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: append([]*etcdpb.Compare{lockCmp}, compares...),
+				Success: append([]*etcdpb.RequestOp{
+					{
+						Request: &etcdpb.RequestOp_RequestDeleteRange{
+							RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+								Key: util.StringToBytes(key.Key),
+							},
 						},
 					},
-				},
-			},
-		}); err != nil {
-			return null, err
-		} else if res.Succeeded == false {
-			break
+					clearVectorsOp(key.Key),
+					clearSearchIndexOp(key.Key),
+				}, ops...),
+			})
+			if err != nil {
+				return null, err
+			} else if res.Succeeded {
+				break
+			}
+			continue
 		}
 
 		time.Sleep(delay)
@@ -129,11 +456,35 @@ func (s *Server) Delete(ctx context.Context, key *pb.Key) (*pb.Null, error) {
 	return null, nil
 }
 
-// Clear all keys in the cache.
+// clearKeeps are the prefixes of the internal keys that Clear doesn't delete.
+var clearKeeps = []string{prefixForExpirations, prefixForLeases, prefixForLocks}
+
+// Clear all keys in the cache. Locks and named leases are kept, so they can still be used by their holders.
+// Expirations are kept too, since they go away with the leases of their keys.
 func (s *Server) Clear(ctx context.Context, null *pb.Null) (*pb.Null, error) {
-	_, err := s.cache.Server.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{
-		Key:      ZeroByte,
-		RangeEnd: ZeroByte,
-	})
+	// everything before, between and after the kept prefixes is deleted.
+	kept := append([]string{}, clearKeeps...)
+	sort.Strings(kept)
+
+	ops := []*etcdpb.RequestOp{}
+	start := ZeroByte
+	for _, prefix := range kept {
+		ops = append(ops, deleteRangeOp(start, util.StringToBytes(prefix)))
+		start = getPrefix(prefix)
+	}
+	ops = append(ops, deleteRangeOp(start, ZeroByte))
+	_, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{Success: ops})
 	return null, err
 }
+
+// deleteRangeOp returns the operation that deletes the keys from start up to, but not including, end.
+func deleteRangeOp(start, end []byte) *etcdpb.RequestOp {
+	return &etcdpb.RequestOp{
+		Request: &etcdpb.RequestOp_RequestDeleteRange{
+			RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+				Key:      start,
+				RangeEnd: end,
+			},
+		},
+	}
+}
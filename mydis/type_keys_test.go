@@ -19,6 +19,9 @@ import (
 	"time"
 
 	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestKeys(t *testing.T) {
@@ -61,10 +64,10 @@ func TestSetExpire(t *testing.T) {
 	if _, err := server.SetExpire(ctx, &pb.Expiration{Key: "key1", Exp: 1}); err != nil {
 		t.Error(err)
 	}
-	if b, err := server.Has(ctx, &pb.Key{Key: "key1"}); err != nil {
+	if bv, err := server.Get(ctx, &pb.Key{Key: "key1"}); err != nil {
 		t.Error(err)
-	} else if b.Value == false {
-		t.Error("Expected key not found")
+	} else if string(bv.Value) != "val1" {
+		t.Error("Unexpected value:", string(bv.Value))
 	}
 
 	t.Log("INFO: Waiting two seconds for key expiration")
@@ -78,6 +81,167 @@ func TestSetExpire(t *testing.T) {
 	}
 }
 
+func TestTTL(t *testing.T) {
+	testReset()
+
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != -1 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+	if _, err := server.TTL(ctx, &pb.Key{Key: "missing"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if _, err := server.SetExpireMillis(ctx, &pb.Expiration{Key: "key1", Exp: 10500}); err != nil {
+		t.Error(err)
+	}
+	if iv, err := server.TTLMillis(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value <= 10000 || iv.Value > 10500 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	at := time.Now().Add(30 * time.Second).Unix()
+	if _, err := server.ExpireAt(ctx, &pb.Expiration{Key: "key1", Exp: at}); err != nil {
+		t.Error(err)
+	}
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value < 28 || iv.Value > 30 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	// setting the value without an expiration removes the expiration.
+	if _, err := server.Set(ctx, &pb.ByteValue{Key: "key1", Value: []byte("val1")}); err != nil {
+		t.Error(err)
+	}
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != -1 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	// an expiration in the past deletes the key.
+	if _, err := server.ExpireAtMillis(ctx, &pb.Expiration{Key: "key1", Exp: 1}); err != nil {
+		t.Error(err)
+	}
+	if b, err := server.Has(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if b.Value {
+		t.Error("Unexpected key found")
+	}
+}
+
+func TestPersist(t *testing.T) {
+	testReset()
+
+	if b, err := server.Persist(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if b.Value {
+		t.Error("Expected key without expiration")
+	}
+
+	if _, err := server.SetExpire(ctx, &pb.Expiration{Key: "key1", Exp: 1}); err != nil {
+		t.Error(err)
+	}
+	if b, err := server.Persist(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if !b.Value {
+		t.Error("Expected key with expiration")
+	}
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != -1 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	t.Log("INFO: Waiting two seconds to make sure the key doesn't expire")
+	time.Sleep(2000 * time.Millisecond)
+	if bv, err := server.Get(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if string(bv.Value) != "val1" {
+		t.Error("Unexpected value:", string(bv.Value))
+	}
+}
+
+func TestExpirationLocked(t *testing.T) {
+	testReset()
+
+	otherCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "0", "lockowner", "owner2"))
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	if _, err := server.SetExpire(ctx, &pb.Expiration{Key: "key1", Exp: 20}); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Lock(ownerCtx, &pb.Key{Key: "key1"})
+	if _, err := server.SetExpire(otherCtx, &pb.Expiration{Key: "key1", Exp: 30}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Persist(otherCtx, &pb.Key{Key: "key1"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// the lock's owner can still change the expiration.
+	if b, err := server.Persist(ownerCtx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if !b.Value {
+		t.Error("Expected key with expiration")
+	}
+	server.Unlock(ownerCtx, &pb.Key{Key: "key1"})
+}
+
+func TestSetWithExpire(t *testing.T) {
+	testReset()
+
+	if _, err := server.SetWithExpire(ctx, &pb.ExpiringValue{Key: "key2", Value: []byte("val2"), Exp: 20}); err != nil {
+		t.Error(err)
+	}
+	if bv, err := server.Get(ctx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	} else if string(bv.Value) != "val2" {
+		t.Error("Unexpected value:", string(bv.Value))
+	}
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	} else if iv.Value < 19 || iv.Value > 20 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	if _, err := server.SetWithExpireMillis(ctx, &pb.ExpiringValue{Key: "key2", Value: []byte("val2"), Exp: 0}); err != util.ErrInvalidExpiration {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	server.Lock(ctx, &pb.Key{Key: "key2"})
+	if _, err := server.SetWithExpire(ctx, &pb.ExpiringValue{Key: "key2", Value: []byte("val3"), Exp: 20}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	server.Unlock(ctx, &pb.Key{Key: "key2"})
+
+	// the lock's owner can still set the value.
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	server.Lock(ownerCtx, &pb.Key{Key: "key2"})
+	if _, err := server.SetWithExpire(ownerCtx, &pb.ExpiringValue{Key: "key2", Value: []byte("val3"), Exp: 20}); err != nil {
+		t.Error(err)
+	}
+	server.Unlock(ownerCtx, &pb.Key{Key: "key2"})
+
+	// the indexes that include the key are updated.
+	if _, err := server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "user/", Field: "email"}); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := proto.Marshal(&pb.Hash{Value: map[string][]byte{"email": []byte("a@example.com")}})
+	if _, err := server.SetWithExpire(ctx, &pb.ExpiringValue{Key: "user/1", Value: b, Exp: 20}); err != nil {
+		t.Error(err)
+	}
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "user/", Field: "email", Value: []byte("a@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "user/1" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+	server.FieldIndexDrop(ctx, &pb.FieldIndex{Prefix: "user/", Field: "email"})
+}
+
 func TestDelete(t *testing.T) {
 	testReset()
 
@@ -103,3 +267,54 @@ func TestClear(t *testing.T) {
 		t.Error("Expected empty cache")
 	}
 }
+
+func TestKeysHideInternal(t *testing.T) {
+	testReset()
+
+	server.Lock(ctx, &pb.Key{Key: "key1"})
+	defer server.Unlock(ctx, &pb.Key{Key: "key1"})
+
+	if lst, err := server.Keys(ctx, null); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "key1" {
+		t.Error("Unexpected keys:", lst.Keys)
+	}
+	if lst, err := server.KeysWithPrefix(ctx, &pb.Key{Key: prefixForInternal}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected keys:", lst.Keys)
+	}
+	if h, err := server.GetWithPrefix(ctx, &pb.Key{}); err != nil {
+		t.Error(err)
+	} else if len(h.Value) != 1 {
+		t.Error("Unexpected values:", h.Value)
+	}
+}
+
+func TestClearKeepsLocks(t *testing.T) {
+	testReset()
+
+	server.Lock(ctx, &pb.Key{Key: "key1"})
+	defer server.Unlock(ctx, &pb.Key{Key: "key1"})
+	server.GrantLease(ctx, &pb.Expiration{Key: "session1", Exp: 30})
+	defer server.RevokeLease(ctx, &pb.Key{Key: "session1"})
+	server.Set(ctx, &pb.ByteValue{Key: "key2", Value: []byte("val2")})
+	server.SetExpire(ctx, &pb.Expiration{Key: "key2", Exp: 30})
+
+	if _, err := server.Clear(ctx, null); err != nil {
+		t.Error(err)
+	}
+	if infos, err := server.ListLocks(ctx, &pb.Key{Key: "key1"}); err != nil {
+		t.Error(err)
+	} else if len(infos.Locks) != 1 {
+		t.Error("Expected lock to be kept:", infos.Locks)
+	}
+	if _, err := server.GetLease(ctx, &pb.Key{Key: "session1"}); err != nil {
+		t.Error("Expected lease to be kept:", err)
+	}
+	if lst, err := server.Keys(ctx, null); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected keys:", lst.Keys)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func getLeaseName(name string) []byte {
+	return util.StringToBytes(prefixForLeases + name)
+}
+
+// GrantLease creates a named lease that expires after the given number of seconds unless it's kept alive.
+func (s *Server) GrantLease(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
+	if len(ex.Key) == 0 {
+		return null, util.ErrInvalidKey
+	}
+	if ex.Exp <= 0 {
+		return null, util.ErrInvalidExpiration
+	}
+
+	res, err := s.storage.LeaseGrant(ctx, &etcdpb.LeaseGrantRequest{
+		TTL: ex.Exp,
+	})
+	if err != nil {
+		return null, err
+	}
+
+	b, err := proto.Marshal(&pb.IntValue{Value: res.ID})
+	if err != nil {
+		s.revokeLease(ctx, res.ID)
+		return null, err
+	}
+
+	// the name is attached to the lease, so it goes away when the lease expires or is revoked.
+	name := getLeaseName(ex.Key)
+	txn, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+		Compare: []*etcdpb.Compare{txnModCompare(name, 0)},
+		Success: []*etcdpb.RequestOp{
+			{
+				Request: &etcdpb.RequestOp_RequestPut{
+					RequestPut: &etcdpb.PutRequest{
+						Key:   name,
+						Value: b,
+						Lease: res.ID,
+					},
+				},
+			},
+		},
+	})
+	if err != nil || !txn.Succeeded {
+		s.revokeLease(ctx, res.ID)
+	}
+	if err != nil {
+		return null, err
+	} else if !txn.Succeeded {
+		return null, util.ErrLeaseExists
+	}
+	return null, nil
+}
+
+// RevokeLease revokes a named lease, deleting every key attached to it.
+func (s *Server) RevokeLease(ctx context.Context, key *pb.Key) (*pb.Null, error) {
+	id, err := s.getLeaseID(ctx, key.Key)
+	if err != nil {
+		return null, err
+	}
+
+	// the name is written again first, which tells watches that the keys were deleted rather than expired.
+	b, err := proto.Marshal(&pb.IntValue{Value: id})
+	if err != nil {
+		return null, err
+	}
+	if _, err := s.storage.Put(ctx, &etcdpb.PutRequest{Key: getLeaseName(key.Key), Value: b, Lease: id}); err == lease.ErrLeaseNotFound {
+		return null, util.ErrLeaseNotFound
+	} else if err != nil {
+		return null, err
+	}
+
+	if _, err := s.storage.LeaseRevoke(ctx, &etcdpb.LeaseRevokeRequest{ID: id}); err == lease.ErrLeaseNotFound {
+		return null, util.ErrLeaseNotFound
+	} else if err != nil {
+		return null, err
+	}
+	return null, nil
+}
+
+// GetLease gets the time left on a named lease in seconds and the keys attached to it.
+func (s *Server) GetLease(ctx context.Context, key *pb.Key) (*pb.LeaseInfo, error) {
+	id, err := s.getLeaseID(ctx, key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.storage.LeaseTimeToLive(ctx, &etcdpb.LeaseTimeToLiveRequest{ID: id, Keys: true})
+	if err != nil {
+		return nil, err
+	} else if res.TTL < 0 {
+		return nil, util.ErrLeaseNotFound
+	}
+
+	info := &pb.LeaseInfo{Name: key.Key, Ttl: res.TTL, GrantedTTL: res.GrantedTTL, Keys: []string{}}
+	for _, k := range res.Keys {
+		if key := util.BytesToString(k); !isInternalKey(key) {
+			info.Keys = append(info.Keys, key)
+		}
+	}
+	return info, nil
+}
+
+// KeepAlive refreshes the named leases it receives, sending back the time left on each one in seconds.
+// The stream ends with an error if a lease doesn't exist.
+func (s *Server) KeepAlive(stream pb.Mydis_KeepAliveServer) error {
+	ctx := stream.Context()
+	for {
+		key, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		id, err := s.getLeaseID(ctx, key.Key)
+		if err != nil {
+			return err
+		}
+		ttl, err := s.storage.LeaseRenew(ctx, lease.LeaseID(id))
+		if err == lease.ErrLeaseNotFound {
+			return util.ErrLeaseNotFound
+		} else if err != nil {
+			return err
+		}
+
+		if err := stream.Send(&pb.LeaseInfo{Name: key.Key, Ttl: ttl}); err != nil {
+			return err
+		}
+	}
+}
+
+// getLeaseID returns the ID of the etcd lease with the given name.
+func (s *Server) getLeaseID(ctx context.Context, name string) (int64, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: getLeaseName(name)})
+	if err != nil {
+		return 0, err
+	} else if len(res.Kvs) == 0 {
+		return 0, util.ErrLeaseNotFound
+	}
+
+	id := &pb.IntValue{}
+	if err := proto.Unmarshal(res.Kvs[0].Value, id); err != nil {
+		return 0, err
+	}
+	return id.Value, nil
+}
+
+// getLease returns the ID of the lease named in the request metadata, which keys are attached to when they're
+// written, or zero if no lease was named.
+func (s *Server) getLease(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	if name, ok := md["lease"]; ok && len(name) > 0 && len(name[0]) > 0 {
+		return s.getLeaseID(ctx, name[0])
+	}
+	return 0, nil
+}
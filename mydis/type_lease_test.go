@@ -0,0 +1,80 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestLease(t *testing.T) {
+	testReset()
+
+	if _, err := server.GrantLease(ctx, &pb.Expiration{Key: "session1", Exp: 30}); err != nil {
+		t.Fatal(err)
+	}
+	defer server.RevokeLease(ctx, &pb.Key{Key: "session1"})
+	if _, err := server.GrantLease(ctx, &pb.Expiration{Key: "session1", Exp: 30}); err != util.ErrLeaseExists {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	leaseCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lease", "session1"))
+	if _, err := server.Set(leaseCtx, &pb.ByteValue{Key: "lease1", Value: []byte("val1")}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.SetHash(leaseCtx, &pb.Hash{Key: "lease2", Value: map[string][]byte{"field": []byte("val")}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.ListAppend(leaseCtx, &pb.ListItem{Key: "lease3", Value: []byte("item")}); err != nil {
+		t.Error(err)
+	}
+
+	info, err := server.GetLease(ctx, &pb.Key{Key: "session1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(info.Keys)
+	if info.Ttl <= 0 || info.Ttl > 30 || info.GrantedTTL != 30 {
+		t.Error("Unexpected lease:", info)
+	} else if len(info.Keys) != 3 || info.Keys[0] != "lease1" || info.Keys[2] != "lease3" {
+		t.Error("Unexpected keys:", info.Keys)
+	}
+	if iv, err := server.TTL(ctx, &pb.Key{Key: "lease1"}); err != nil {
+		t.Error(err)
+	} else if iv.Value <= 0 || iv.Value > 30 {
+		t.Error("Unexpected TTL:", iv.Value)
+	}
+
+	// revoking the lease deletes every key attached to it, but not the others.
+	if _, err := server.RevokeLease(ctx, &pb.Key{Key: "session1"}); err != nil {
+		t.Error(err)
+	}
+	if lst, err := server.Keys(ctx, null); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "key1" {
+		t.Error("Unexpected keys:", lst.Keys)
+	}
+
+	if _, err := server.GetLease(ctx, &pb.Key{Key: "session1"}); err != util.ErrLeaseNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Set(leaseCtx, &pb.ByteValue{Key: "lease1", Value: []byte("val1")}); err != util.ErrLeaseNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
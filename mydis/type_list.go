@@ -373,6 +373,7 @@ func (s *Server) ListDeleteItem(ctx context.Context, li *pb.ListItem) (*pb.IntVa
 	}
 
 	if found == -1 {
+		s.Unlock(ctx, key)
 		return &pb.IntValue{Value: -1}, nil
 	}
 
@@ -16,6 +16,8 @@ package mydis
 
 import (
 	"bytes"
+	"sort"
+	"strings"
 	"time"
 
 	"strconv"
@@ -47,9 +49,23 @@ func (s *Server) getMaxWait(ctx context.Context) int64 {
 	return defaultMaxWait
 }
 
+// getLockOwner returns the lock owner given in the request metadata. Locks taken without an owner can't be
+// acquired again until they are released.
+func getLockOwner(ctx context.Context) string {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if owner, ok := md["lockowner"]; ok && len(owner) > 0 {
+		return owner[0]
+	}
+	return ""
+}
+
 // Lock a key from being modified. If a lock has already been placed on the key,
 // code will block until lock is released, or until 5 seconds has passed. If
-// 5 second timeout is reached, ErrKeyLocked is returned.
+// 5 second timeout is reached, ErrKeyLocked is returned. If the lock is already
+// held by the same lock owner, it is acquired again without blocking.
 func (s *Server) Lock(ctx context.Context, key *pb.Key) (*pb.Null, error) {
 	maxWait := s.getMaxWait(ctx)
 	return s.LockWithTimeout(ctx, &pb.Expiration{Key: key.Key, Exp: maxWait})
@@ -62,35 +78,46 @@ func (s *Server) Lock(ctx context.Context, key *pb.Key) (*pb.Null, error) {
 func (s *Server) LockWithTimeout(ctx context.Context, ex *pb.Expiration) (*pb.Null, error) {
 	maxWait := time.Now().Add(time.Duration(ex.Exp) * time.Second)
 	keyLock := getLockName(ex.Key)
+	owner := getLockOwner(ctx)
 
 	for {
-		if res, err := s.cache.Server.Txn(ctx, &etcdpb.TxnRequest{
-			Compare: []*etcdpb.Compare{
-				{
-					Key:    keyLock,
-					Target: etcdpb.Compare_VALUE,
-					Result: etcdpb.Compare_EQUAL,
-					TargetUnion: &etcdpb.Compare_Value{
-						Value: ZeroByte,
-					},
-				},
-			},
+		_, put, _ := acquireLock(ex.Key, nil, 0, owner)
+		res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+			Compare: []*etcdpb.Compare{txnModCompare(keyLock, 0)},
+			Success: []*etcdpb.RequestOp{put},
 			Failure: []*etcdpb.RequestOp{
 				{
-					Request: &etcdpb.RequestOp_RequestPut{
-						RequestPut: &etcdpb.PutRequest{
-							Key:   keyLock,
-							Value: ZeroByte,
+					Request: &etcdpb.RequestOp_RequestRange{
+						RequestRange: &etcdpb.RangeRequest{
+							Key: keyLock,
 						},
 					},
 				},
 			},
-		}); err != nil {
+		})
+		if err != nil {
 			return null, err
-		} else if res.Succeeded == false {
+		} else if res.Succeeded {
 			break
 		}
 
+		// the lock may already be held by the same owner, or may have been released since it was checked.
+		kvs := res.Responses[0].GetResponseRange().Kvs
+		if len(kvs) == 0 {
+			continue
+		}
+		if cmp, put, ok := acquireLock(ex.Key, decodeLockHolder(kvs[0].Value), kvs[0].ModRevision, owner); ok {
+			if res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+				Compare: []*etcdpb.Compare{cmp},
+				Success: []*etcdpb.RequestOp{put},
+			}); err != nil {
+				return null, err
+			} else if res.Succeeded {
+				break
+			}
+			continue
+		}
+
 		if ex.Exp == 0 {
 			return null, util.ErrKeyLocked
 		}
@@ -103,48 +130,438 @@ func (s *Server) LockWithTimeout(ctx context.Context, ex *pb.Expiration) (*pb.Nu
 	return null, nil
 }
 
-// Unlock a key for modifications.
+// Unlock a key for modifications. If the lock has been acquired more than once by the same lock owner,
+// one hold is released and the key stays locked.
 func (s *Server) Unlock(ctx context.Context, key *pb.Key) (*pb.Null, error) {
-	return s.Delete(ctx, &pb.Key{Key: util.BytesToString(getLockName(key.Key))})
+	return null, s.unlockWithOps(ctx, key.Key, nil)
+}
+
+// LockMany locks all of the given keys in a single transaction, so either all of them are locked or none of them
+// are. Keys are locked in sorted order, so callers locking overlapping keys can't deadlock each other. If any of the
+// keys are already locked, code will block until they are released or the timeout is reached, the same as
+// LockWithTimeout.
+func (s *Server) LockMany(ctx context.Context, ex *pb.KeysExpiration) (*pb.Null, error) {
+	keys := sortedUniqueKeys(ex.Keys)
+	if len(keys) == 0 {
+		return null, nil
+	}
+	for _, key := range keys {
+		if len(key) == 0 {
+			return null, util.ErrInvalidKey
+		}
+	}
+
+	maxWait := time.Now().Add(time.Duration(ex.Exp) * time.Second)
+	owner := getLockOwner(ctx)
+
+	for {
+		holders, revs, err := s.getLockHolders(ctx, keys)
+		if err != nil {
+			return null, err
+		}
+
+		req := &etcdpb.TxnRequest{}
+		locked := false
+		for i, key := range keys {
+			cmp, put, ok := acquireLock(key, holders[i], revs[i], owner)
+			if !ok {
+				locked = true
+				break
+			}
+			req.Compare = append(req.Compare, cmp)
+			req.Success = append(req.Success, put)
+		}
+
+		if !locked {
+			if res, err := s.storage.Txn(ctx, req); err != nil {
+				return null, err
+			} else if res.Succeeded {
+				break
+			}
+			continue
+		}
+
+		if ex.Exp == 0 {
+			return null, util.ErrKeyLocked
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) && ex.Exp >= 0 {
+			return null, util.ErrKeyLocked
+		}
+	}
+	return null, nil
+}
+
+// UnlockMany unlocks all of the given keys in a single transaction.
+func (s *Server) UnlockMany(ctx context.Context, keys *pb.KeysList) (*pb.Null, error) {
+	return null, s.unlockManyWithOps(ctx, keys.Keys, nil)
+}
+
+// unlockManyWithOps releases a hold on the locks of the given keys and runs the given operations in the same
+// transaction. Keys that aren't locked must stay unlocked for the operations to run.
+func (s *Server) unlockManyWithOps(ctx context.Context, keys []string, ops []*etcdpb.RequestOp) error {
+	sorted := sortedUniqueKeys(keys)
+	if len(sorted) == 0 {
+		return nil
+	}
+	owner := getLockOwner(ctx)
+
+	for {
+		holders, revs, err := s.getLockHolders(ctx, sorted)
+		if err != nil {
+			return err
+		}
+
+		req := &etcdpb.TxnRequest{}
+		for i, key := range sorted {
+			if holders[i] == nil {
+				if len(ops) > 0 {
+					req.Compare = append(req.Compare, txnModCompare(getLockName(key), 0))
+				}
+				continue
+			}
+			cmp, op, err := releaseLock(key, holders[i], revs[i], owner)
+			if err != nil {
+				return err
+			}
+			req.Compare = append(req.Compare, cmp)
+			req.Success = append(req.Success, op)
+		}
+		req.Success = append(req.Success, ops...)
+		if len(req.Success) == 0 {
+			return nil
+		}
+
+		if res, err := s.storage.Txn(ctx, req); err != nil {
+			return err
+		} else if res.Succeeded {
+			return nil
+		}
+	}
+}
+
+// ListLocks gets the locks held on the keys with the given prefix, with their holders, when they were first acquired
+// and the number of seconds left on their leases, which is -1 for locks that don't expire.
+func (s *Server) ListLocks(ctx context.Context, prefix *pb.Key) (*pb.LockInfos, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      getLockName(prefix.Key),
+		RangeEnd: getPrefix(prefixForLocks + prefix.Key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := &pb.LockInfos{Locks: []*pb.LockInfo{}}
+	for _, kv := range res.Kvs {
+		holder := decodeLockHolder(kv.Value)
+		info := &pb.LockInfo{
+			Key:      strings.TrimPrefix(util.BytesToString(kv.Key), prefixForLocks),
+			Owner:    holder.Owner,
+			Count:    holder.Count,
+			Acquired: holder.Acquired,
+			Ttl:      -1,
+		}
+		if kv.Lease != 0 {
+			lease, err := s.storage.LeaseTimeToLive(ctx, &etcdpb.LeaseTimeToLiveRequest{ID: kv.Lease})
+			if err != nil {
+				return nil, err
+			}
+			info.Ttl = lease.TTL
+		}
+		infos.Locks = append(infos.Locks, info)
+	}
+	return infos, nil
+}
+
+// ForceUnlock releases the lock on a key no matter who holds it or how many times it was acquired. If
+// authentication is enabled, the root role is required.
+func (s *Server) ForceUnlock(ctx context.Context, key *pb.Key) (*pb.Null, error) {
+	// storage without authentication lets anyone force a lock to be released.
+	if a, err := s.auth(); err == nil {
+		as := a.AuthStore()
+		authInfo, err := as.AuthInfoFromCtx(ctx)
+		if err != nil {
+			return null, err
+		}
+		if err := as.IsAdminPermitted(authInfo); err != nil {
+			return null, err
+		}
+	}
+
+	_, err := s.storage.DeleteRange(ctx, &etcdpb.DeleteRangeRequest{Key: getLockName(key.Key)})
+	return null, err
+}
+
+// sortedUniqueKeys returns a sorted copy of the keys with duplicates removed.
+func sortedUniqueKeys(keys []string) []string {
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+	unique := []string{}
+	for i, key := range sorted {
+		if i == 0 || key != sorted[i-1] {
+			unique = append(unique, key)
+		}
+	}
+	return unique
+}
+
+// getLockHolder gets the holder of the lock on a key and the revision the lock was last modified at,
+// or nil and zero if the key isn't locked.
+func (s *Server) getLockHolder(ctx context.Context, key string) (*pb.LockHolder, int64, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: getLockName(key)})
+	if err != nil {
+		return nil, 0, err
+	} else if len(res.Kvs) == 0 {
+		return nil, 0, nil
+	}
+	return decodeLockHolder(res.Kvs[0].Value), res.Kvs[0].ModRevision, nil
+}
+
+// getLockHolders works the same as getLockHolder for multiple keys, reading them all at the same revision.
+func (s *Server) getLockHolders(ctx context.Context, keys []string) ([]*pb.LockHolder, []int64, error) {
+	req := &etcdpb.TxnRequest{}
+	for _, key := range keys {
+		req.Success = append(req.Success, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestRange{
+				RequestRange: &etcdpb.RangeRequest{
+					Key: getLockName(key),
+				},
+			},
+		})
+	}
+
+	res, err := s.storage.Txn(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	holders := make([]*pb.LockHolder, len(keys))
+	revs := make([]int64, len(keys))
+	for i, op := range res.Responses {
+		if kvs := op.GetResponseRange().Kvs; len(kvs) > 0 {
+			holders[i] = decodeLockHolder(kvs[0].Value)
+			revs[i] = kvs[0].ModRevision
+		}
+	}
+	return holders, revs, nil
+}
+
+// decodeLockHolder decodes the value of a lock. Locks without holder information are held once with no owner.
+func decodeLockHolder(b []byte) *pb.LockHolder {
+	holder := &pb.LockHolder{}
+	if err := proto.Unmarshal(b, holder); err != nil || holder.Count < 1 {
+		return &pb.LockHolder{Count: 1}
+	}
+	return holder
 }
 
-// UnlockThenSet unlocks a key, then immediately sets a new value for it.
+// acquireLock returns the comparison and operation that take a hold on the lock for the given owner, given the
+// current holder of the lock and the revision it was last modified at. If the lock is held by someone else,
+// false is returned.
+func acquireLock(key string, holder *pb.LockHolder, rev int64, owner string) (*etcdpb.Compare, *etcdpb.RequestOp, bool) {
+	next := &pb.LockHolder{Owner: owner, Count: 1, Acquired: time.Now().UnixNano() / int64(time.Millisecond)}
+	if holder != nil {
+		if owner == "" || holder.Owner != owner {
+			return nil, nil, false
+		}
+		next.Count = holder.Count + 1
+		next.Acquired = holder.Acquired
+	}
+
+	b, _ := proto.Marshal(next)
+	keyLock := getLockName(key)
+	return txnModCompare(keyLock, rev), &etcdpb.RequestOp{
+		Request: &etcdpb.RequestOp_RequestPut{
+			RequestPut: &etcdpb.PutRequest{
+				Key:   keyLock,
+				Value: b,
+			},
+		},
+	}, true
+}
+
+// writeLockCompare returns the comparison that checks a key can still be written without taking its lock, given the
+// current holder of the lock and the revision it was last modified at. A key can be written if it isn't locked, or if
+// its lock is held by the given owner. If the lock is held by someone else, false is returned.
+func writeLockCompare(key string, holder *pb.LockHolder, rev int64, owner string) (*etcdpb.Compare, bool) {
+	keyLock := getLockName(key)
+	if holder == nil {
+		return txnModCompare(keyLock, 0), true
+	}
+	if owner == "" || holder.Owner != owner {
+		return nil, false
+	}
+	return txnModCompare(keyLock, rev), true
+}
+
+// getWriteLockCompare reads the lock on a key and returns the comparison from writeLockCompare.
+func (s *Server) getWriteLockCompare(ctx context.Context, key string) (*etcdpb.Compare, bool, error) {
+	holder, rev, err := s.getLockHolder(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+	cmp, ok := writeLockCompare(key, holder, rev, getLockOwner(ctx))
+	return cmp, ok, nil
+}
+
+// releaseLock returns the comparison and operation that release a hold on the lock, given the current holder of
+// the lock and the revision it was last modified at. Locks can only be released by their owner, if they have one.
+func releaseLock(key string, holder *pb.LockHolder, rev int64, owner string) (*etcdpb.Compare, *etcdpb.RequestOp, error) {
+	if holder.Owner != "" && holder.Owner != owner {
+		return nil, nil, util.ErrLockNotOwned
+	}
+
+	keyLock := getLockName(key)
+	if holder.Count > 1 {
+		b, _ := proto.Marshal(&pb.LockHolder{Owner: holder.Owner, Count: holder.Count - 1, Acquired: holder.Acquired})
+		return txnModCompare(keyLock, rev), &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   keyLock,
+					Value: b,
+				},
+			},
+		}, nil
+	}
+	return txnModCompare(keyLock, rev), &etcdpb.RequestOp{
+		Request: &etcdpb.RequestOp_RequestDeleteRange{
+			RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+				Key: keyLock,
+			},
+		},
+	}, nil
+}
+
+// unlockWithOps releases a hold on the lock of a key and runs the given operations in the same transaction.
+// If the key isn't locked, the operations are still run.
+func (s *Server) unlockWithOps(ctx context.Context, key string, ops []*etcdpb.RequestOp) error {
+	owner := getLockOwner(ctx)
+	for {
+		holder, rev, err := s.getLockHolder(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		req := &etcdpb.TxnRequest{Success: ops}
+		if holder == nil {
+			if len(ops) == 0 {
+				return nil
+			}
+			req.Compare = []*etcdpb.Compare{txnModCompare(getLockName(key), 0)}
+		} else {
+			cmp, op, err := releaseLock(key, holder, rev, owner)
+			if err != nil {
+				return err
+			}
+			req.Compare = []*etcdpb.Compare{cmp}
+			req.Success = append([]*etcdpb.RequestOp{op}, ops...)
+		}
+
+		if res, err := s.storage.Txn(ctx, req); err != nil {
+			return err
+		} else if res.Succeeded {
+			return nil
+		}
+	}
+}
+
+// UnlockThenSet unlocks a key, then immediately sets a new value for it, updating the indexes that include it in the
+// same transaction.
 func (s *Server) UnlockThenSet(ctx context.Context, val *pb.ByteValue) (*pb.Null, error) {
+	return s.unlockThenSetWithOps(ctx, val, nil)
+}
+
+// unlockThenSetWithOps works the same as UnlockThenSet, but also runs the given operations in the same transaction.
+func (s *Server) unlockThenSetWithOps(ctx context.Context, val *pb.ByteValue, ops []*etcdpb.RequestOp) (*pb.Null, error) {
 	bkey := util.StringToBytes(val.Key)
 	if len(bkey) == 0 || bytes.Equal(bkey, ZeroByte) {
 		return null, util.ErrInvalidKey
 	}
-	keyLock := getLockName(val.Key)
-	_, err := s.cache.Server.Txn(ctx, &etcdpb.TxnRequest{
-		Compare: []*etcdpb.Compare{
-			{
-				Key:    ZeroByte,
-				Target: etcdpb.Compare_VALUE,
-				Result: etcdpb.Compare_EQUAL,
-				TargetUnion: &etcdpb.Compare_Value{
-					Value: ZeroByte,
+	if err := s.beforeSet(ctx, val.Key, val.Value); err != nil {
+		s.Unlock(ctx, &pb.Key{Key: val.Key})
+		return null, err
+	}
+	lease, err := s.getLease(ctx)
+	if err != nil {
+		s.Unlock(ctx, &pb.Key{Key: val.Key})
+		return null, err
+	}
+	// the key is locked, so its value can't change before the index entries are updated.
+	_, iops, err := s.indexOps(ctx, val.Key, val.Value, lease)
+	if err != nil {
+		s.Unlock(ctx, &pb.Key{Key: val.Key})
+		return null, err
+	}
+	ops = append(ops, iops...)
+	if err := s.unlockWithOps(ctx, val.Key, append([]*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   bkey,
+					Value: val.Value,
+					Lease: lease,
 				},
 			},
 		},
-		Failure: []*etcdpb.RequestOp{
-			{
-				Request: &etcdpb.RequestOp_RequestDeleteRange{
-					RequestDeleteRange: &etcdpb.DeleteRangeRequest{
-						Key: keyLock,
-					},
-				},
-			},
-			{
-				Request: &etcdpb.RequestOp_RequestPut{
-					RequestPut: &etcdpb.PutRequest{
-						Key:   util.StringToBytes(val.Key),
-						Value: val.Value,
-					},
+	}, ops...)); err != nil {
+		return null, err
+	}
+	s.afterSet(ctx, val.Key, val.Value)
+	return null, nil
+}
+
+// unlockManyThenSet unlocks the keys of the given values, then immediately sets the values, updating the indexes that
+// include them, all in one transaction.
+func (s *Server) unlockManyThenSet(ctx context.Context, vals []*pb.ByteValue) (*pb.Null, error) {
+	keys := make([]string, 0, len(vals))
+	for _, val := range vals {
+		keys = append(keys, val.Key)
+	}
+	unlock := func(err error) (*pb.Null, error) {
+		s.UnlockMany(ctx, &pb.KeysList{Keys: keys})
+		return null, err
+	}
+
+	for _, val := range vals {
+		bkey := util.StringToBytes(val.Key)
+		if len(bkey) == 0 || bytes.Equal(bkey, ZeroByte) {
+			return unlock(util.ErrInvalidKey)
+		}
+		if err := s.beforeSet(ctx, val.Key, val.Value); err != nil {
+			return unlock(err)
+		}
+	}
+	lease, err := s.getLease(ctx)
+	if err != nil {
+		return unlock(err)
+	}
+
+	// the keys are locked, so their values can't change before the index entries are updated.
+	ops := make([]*etcdpb.RequestOp, 0, len(vals))
+	for _, val := range vals {
+		_, iops, err := s.indexOps(ctx, val.Key, val.Value, lease)
+		if err != nil {
+			return unlock(err)
+		}
+		ops = append(ops, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   util.StringToBytes(val.Key),
+					Value: val.Value,
+					Lease: lease,
 				},
 			},
-		},
-	})
-	return null, err
+		})
+		ops = append(ops, iops...)
+	}
+	if err := s.unlockManyWithOps(ctx, keys, ops); err != nil {
+		return null, err
+	}
+	for _, val := range vals {
+		s.afterSet(ctx, val.Key, val.Value)
+	}
+	return null, nil
 }
 
 // UnlockThenSetList unlocks a key, then immediately sets a list value for it.
@@ -19,6 +19,7 @@ import (
 
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestLock(t *testing.T) {
@@ -45,3 +46,172 @@ func TestLock(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestLockMany(t *testing.T) {
+	testReset()
+
+	if _, err := server.Lock(ctx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	}
+
+	// key1 must not be left locked when key2 is already locked.
+	if _, err := server.LockMany(ctx, &pb.KeysExpiration{Keys: []string{"key1", "key2"}}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.LockWithTimeout(ctx, &pb.Expiration{Key: "key1"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.UnlockMany(ctx, &pb.KeysList{Keys: []string{"key1", "key2"}}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.LockMany(ctx, &pb.KeysExpiration{Keys: []string{"key2", "key1", "key2"}, Exp: 1}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Set(ctx, &pb.ByteValue{Key: "key2", Value: []byte("val2")}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// waits for the other locks to be released.
+	go server.UnlockMany(ctx, &pb.KeysList{Keys: []string{"key1", "key2"}})
+	if _, err := server.LockMany(ctx, &pb.KeysExpiration{Keys: []string{"key1", "key2"}, Exp: 1}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.UnlockMany(ctx, &pb.KeysList{Keys: []string{"key1", "key2"}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Set(ctx, &pb.ByteValue{Key: "key2", Value: []byte("val2")}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLockReentrant(t *testing.T) {
+	testReset()
+
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	otherCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "0", "lockowner", "owner2"))
+
+	if _, err := server.Lock(ownerCtx, &pb.Key{Key: "counter"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Lock(ownerCtx, &pb.Key{Key: "counter"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Lock(otherCtx, &pb.Key{Key: "counter"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// IncrementInt locks the key internally, which doesn't block the owner.
+	if iv, err := server.IncrementInt(ownerCtx, &pb.IntValue{Key: "counter", Value: 2}); err != nil {
+		t.Error(err)
+	} else if iv.Value != 2 {
+		t.Error("Unexpected value:", iv.Value)
+	}
+	if _, err := server.IncrementInt(otherCtx, &pb.IntValue{Key: "counter", Value: 2}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// Set and Delete don't block the owner either.
+	if _, err := server.Set(ownerCtx, &pb.ByteValue{Key: "owned", Value: []byte("val")}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Lock(ownerCtx, &pb.Key{Key: "owned"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Set(ownerCtx, &pb.ByteValue{Key: "owned", Value: []byte("val2")}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Delete(otherCtx, &pb.Key{Key: "owned"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Delete(ownerCtx, &pb.Key{Key: "owned"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Unlock(ownerCtx, &pb.Key{Key: "owned"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.Unlock(otherCtx, &pb.Key{Key: "counter"}); err != util.ErrLockNotOwned {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Unlock(ownerCtx, &pb.Key{Key: "counter"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Set(otherCtx, &pb.ByteValue{Key: "counter", Value: []byte("val")}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Unlock(ownerCtx, &pb.Key{Key: "counter"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Set(otherCtx, &pb.ByteValue{Key: "counter", Value: []byte("val")}); err != nil {
+		t.Error(err)
+	}
+
+	// keys locked by LockMany can be acquired again by the owner, and are released one hold at a time.
+	if _, err := server.LockMany(ownerCtx, &pb.KeysExpiration{Keys: []string{"key1", "key2"}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.LockMany(ownerCtx, &pb.KeysExpiration{Keys: []string{"key2", "key3"}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.LockWithTimeout(ctx, &pb.Expiration{Key: "key3"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.UnlockMany(ownerCtx, &pb.KeysList{Keys: []string{"key1", "key2", "key3"}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.LockWithTimeout(ctx, &pb.Expiration{Key: "key2"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Unlock(ownerCtx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	}
+
+	// locks without an owner aren't reentrant.
+	if _, err := server.Lock(ctx, &pb.Key{Key: "key2"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.LockWithTimeout(ctx, &pb.Expiration{Key: "key2"}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	server.Unlock(ctx, &pb.Key{Key: "key2"})
+}
+
+func TestListLocks(t *testing.T) {
+	testReset()
+
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	server.Lock(ownerCtx, &pb.Key{Key: "jobs/1"})
+	server.Lock(ownerCtx, &pb.Key{Key: "jobs/1"})
+	server.Lock(ctx, &pb.Key{Key: "jobs/2"})
+	server.Lock(ctx, &pb.Key{Key: "other"})
+
+	infos, err := server.ListLocks(ctx, &pb.Key{Key: "jobs/"})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(infos.Locks) != 2 {
+		t.Fatal("Unexpected value:", infos.Locks)
+	}
+	if lock := infos.Locks[0]; lock.Key != "jobs/1" || lock.Owner != "owner1" || lock.Count != 2 || lock.Acquired == 0 || lock.Ttl != -1 {
+		t.Error("Unexpected value:", lock)
+	}
+	if lock := infos.Locks[1]; lock.Key != "jobs/2" || lock.Owner != "" || lock.Count != 1 {
+		t.Error("Unexpected value:", lock)
+	}
+
+	if _, err := server.ForceUnlock(ctx, &pb.Key{Key: "jobs/1"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Lock(ctx, &pb.Key{Key: "jobs/1"}); err != nil {
+		t.Error(err)
+	}
+
+	for _, key := range []string{"jobs/1", "jobs/2", "other"} {
+		server.ForceUnlock(ctx, &pb.Key{Key: key})
+	}
+	if infos, err := server.ListLocks(ctx, &pb.Key{}); err != nil {
+		t.Error(err)
+	} else if len(infos.Locks) != 0 {
+		t.Error("Unexpected value:", infos.Locks)
+	}
+}
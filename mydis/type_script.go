@@ -0,0 +1,249 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+	"golang.org/x/net/context"
+)
+
+// scriptMaxSteps is the number of Starlark computation steps a script can take each time it's run.
+var scriptMaxSteps uint64 = 1000000
+
+// scriptTimeout is how long a script can run each time it's run.
+var scriptTimeout = time.Second
+
+// scriptOptions allows top-level statements, so scripts don't need to be wrapped in a function.
+var scriptOptions = &syntax.FileOptions{
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// scriptCache holds compiled scripts by their SHA1 hash.
+type scriptCache struct {
+	programs map[string]*starlark.Program
+	lock     sync.RWMutex
+}
+
+func newScriptCache() *scriptCache {
+	return &scriptCache{programs: map[string]*starlark.Program{}}
+}
+
+func (c *scriptCache) get(sha string) (*starlark.Program, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	prog, ok := c.programs[sha]
+	return prog, ok
+}
+
+func (c *scriptCache) put(sha string, prog *starlark.Program) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.programs[sha] = prog
+}
+
+// scriptRun is the state of a single run of a script, which every change is made to until it's committed.
+type scriptRun struct {
+	s     *Server
+	ctx   context.Context
+	keys  []string
+	state map[string]*txnKey
+}
+
+// Eval runs a script, committing every change it makes atomically. The script is also stored, so it can be run
+// again with EvalSHA.
+func (s *Server) Eval(ctx context.Context, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	prog, _, err := s.loadScript(ctx, req.Script)
+	if err != nil {
+		return nil, err
+	}
+	return s.runScript(ctx, prog, req)
+}
+
+// EvalSHA runs a stored script by its SHA1 hash, committing every change it makes atomically.
+func (s *Server) EvalSHA(ctx context.Context, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	prog, err := s.getScript(ctx, req.Sha)
+	if err != nil {
+		return nil, err
+	}
+	return s.runScript(ctx, prog, req)
+}
+
+// ScriptLoad stores a script without running it, returning its SHA1 hash.
+func (s *Server) ScriptLoad(ctx context.Context, sc *pb.Script) (*pb.Script, error) {
+	_, sha, err := s.loadScript(ctx, sc.Script)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Script{Sha: sha}, nil
+}
+
+// loadScript compiles a script and stores it by its SHA1 hash, so every server can run it with EvalSHA.
+func (s *Server) loadScript(ctx context.Context, script string) (*starlark.Program, string, error) {
+	sum := sha1.Sum(util.StringToBytes(script))
+	sha := hex.EncodeToString(sum[:])
+	if prog, ok := s.scripts.get(sha); ok {
+		return prog, sha, nil
+	}
+
+	prog, err := compileScript(script)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := s.storage.Put(ctx, &etcdpb.PutRequest{
+		Key:   getScriptName(sha),
+		Value: util.StringToBytes(script),
+	}); err != nil {
+		return nil, "", err
+	}
+	s.scripts.put(sha, prog)
+	return prog, sha, nil
+}
+
+// getScript returns a stored script by its SHA1 hash, compiling it if it was stored by another server.
+func (s *Server) getScript(ctx context.Context, sha string) (*starlark.Program, error) {
+	if prog, ok := s.scripts.get(sha); ok {
+		return prog, nil
+	}
+
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: getScriptName(sha)})
+	if err != nil {
+		return nil, err
+	} else if len(res.Kvs) == 0 {
+		return nil, util.ErrScriptNotFound
+	}
+
+	prog, err := compileScript(util.BytesToString(res.Kvs[0].Value))
+	if err != nil {
+		return nil, err
+	}
+	s.scripts.put(sha, prog)
+	return prog, nil
+}
+
+// runScript runs a script until its changes are committed. Every key the script reads is checked for changes when
+// its changes are written, and if any were changed or locked, the script is run again, until the maximum lock wait.
+func (s *Server) runScript(ctx context.Context, prog *starlark.Program, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	maxW := time.Duration(s.getMaxWait(ctx))
+	maxWait := time.Now().Add(maxW * time.Second)
+
+	for {
+		res, err := s.tryScript(ctx, prog, req)
+		if err != nil {
+			return nil, err
+		} else if res != nil {
+			return res, nil
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			return nil, util.ErrKeyLocked
+		}
+	}
+}
+
+// tryScript makes a single attempt at running a script, returning a nil response if it needs to be run again.
+func (s *Server) tryScript(ctx context.Context, prog *starlark.Program, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	run := &scriptRun{s: s, ctx: ctx, keys: []string{}, state: map[string]*txnKey{}}
+
+	thread := &starlark.Thread{Name: "script", Print: func(*starlark.Thread, string) {}}
+	thread.SetLocal("run", run)
+	thread.SetMaxExecutionSteps(scriptMaxSteps)
+
+	timedOut := int32(0)
+	timer := time.AfterFunc(scriptTimeout, func() {
+		atomic.StoreInt32(&timedOut, 1)
+		thread.Cancel("timed out")
+	})
+	globals, err := prog.Init(thread, scriptPredeclared(req))
+	timer.Stop()
+
+	if atomic.LoadInt32(&timedOut) == 1 {
+		return nil, util.ErrScriptTimeout
+	} else if thread.ExecutionSteps() >= scriptMaxSteps {
+		return nil, util.ErrScriptTooManySteps
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := []byte{}
+	if result, ok := globals["result"]; ok {
+		if value, err = scriptBytes(result); err != nil {
+			return nil, err
+		}
+	}
+
+	rev, err := s.commitTxn(ctx, run.keys, run.state)
+	if err != nil || rev == 0 {
+		return nil, err
+	}
+	return &pb.EvalResponse{Value: value, Revision: rev}, nil
+}
+
+// key returns the state of a key, reading it the first time the script uses it.
+func (r *scriptRun) key(name string) (*txnKey, error) {
+	if len(name) == 0 || isInternalKey(name) {
+		return nil, util.ErrInvalidKey
+	}
+	if k, ok := r.state[name]; ok {
+		return k, nil
+	}
+
+	k, err := r.s.readTxnKey(r.ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r.keys = append(r.keys, name)
+	r.state[name] = k
+	return k, nil
+}
+
+func compileScript(script string) (*starlark.Program, error) {
+	_, prog, err := starlark.SourceProgramOptions(scriptOptions, "script", script, func(name string) bool {
+		return name == "KEYS" || name == "ARGV" || name == "mydis"
+	})
+	return prog, err
+}
+
+func scriptPredeclared(req *pb.EvalRequest) starlark.StringDict {
+	keys := make([]starlark.Value, len(req.Keys))
+	for i, key := range req.Keys {
+		keys[i] = starlark.String(key)
+	}
+	args := make([]starlark.Value, len(req.Args))
+	for i, arg := range req.Args {
+		args[i] = starlark.String(arg)
+	}
+	return starlark.StringDict{
+		"KEYS":  starlark.NewList(keys),
+		"ARGV":  starlark.NewList(args),
+		"mydis": scriptModule,
+	}
+}
+
+func getScriptName(sha string) []byte {
+	return util.StringToBytes(prefixForScripts + sha)
+}
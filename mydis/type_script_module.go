@@ -0,0 +1,380 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// scriptModule is the mydis module scripts use to read and change keys. Changes are made to the script's own copy
+// of each key, and are only written when the script finishes.
+var scriptModule = &starlarkstruct.Module{
+	Name: "mydis",
+	Members: starlark.StringDict{
+		"get":            starlark.NewBuiltin("get", scriptGet),
+		"set":            starlark.NewBuiltin("set", scriptSet),
+		"delete":         starlark.NewBuiltin("delete", scriptDelete),
+		"exists":         starlark.NewBuiltin("exists", scriptExists),
+		"get_int":        starlark.NewBuiltin("get_int", scriptGetInt),
+		"set_int":        starlark.NewBuiltin("set_int", scriptSetInt),
+		"increment_int":  starlark.NewBuiltin("increment_int", scriptIncrementInt),
+		"get_float":      starlark.NewBuiltin("get_float", scriptGetFloat),
+		"set_float":      starlark.NewBuiltin("set_float", scriptSetFloat),
+		"get_list":       starlark.NewBuiltin("get_list", scriptGetList),
+		"set_list":       starlark.NewBuiltin("set_list", scriptSetList),
+		"list_append":    starlark.NewBuiltin("list_append", scriptListAppend),
+		"list_pop_left":  starlark.NewBuiltin("list_pop_left", scriptListPop),
+		"list_pop_right": starlark.NewBuiltin("list_pop_right", scriptListPop),
+		"get_hash":       starlark.NewBuiltin("get_hash", scriptGetHash),
+		"get_hash_field": starlark.NewBuiltin("get_hash_field", scriptGetHashField),
+		"set_hash_field": starlark.NewBuiltin("set_hash_field", scriptSetHashField),
+		"del_hash_field": starlark.NewBuiltin("del_hash_field", scriptDelHashField),
+	},
+}
+
+// scriptKey unpacks the arguments of a call, which always start with a key, and returns the state of the key.
+func scriptKey(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple, min int, vars ...interface{}) (*txnKey, error) {
+	var key string
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, min, append([]interface{}{&key}, vars...)...); err != nil {
+		return nil, err
+	}
+	return thread.Local("run").(*scriptRun).key(key)
+}
+
+func scriptGet(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	} else if !k.exists {
+		return starlark.None, nil
+	}
+	return starlark.String(k.value), nil
+}
+
+func scriptSet(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value starlark.Value
+	k, err := scriptKey(thread, b, args, kwargs, 2, &value)
+	if err != nil {
+		return nil, err
+	}
+	v, err := scriptBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, txnApply(k, &pb.TxnOp{Type: pb.TxnOp_SET, Value: v})
+}
+
+func scriptDelete(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	}
+	existed := k.exists
+	return starlark.Bool(existed), txnApply(k, &pb.TxnOp{Type: pb.TxnOp_DELETE})
+}
+
+func scriptExists(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.Bool(k.exists), nil
+}
+
+func scriptGetInt(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	} else if !k.exists {
+		return starlark.None, nil
+	}
+	iv, err := txnInt(k.value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.MakeInt64(iv.Value), nil
+}
+
+func scriptSetInt(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value int64
+	k, err := scriptKey(thread, b, args, kwargs, 2, &value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, txnApply(k, &pb.TxnOp{Type: pb.TxnOp_SET_INT, Int: value})
+}
+
+func scriptIncrementInt(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	by := int64(1)
+	k, err := scriptKey(thread, b, args, kwargs, 1, &by)
+	if err != nil {
+		return nil, err
+	}
+	if err := txnApply(k, &pb.TxnOp{Type: pb.TxnOp_INCREMENT_INT, Int: by}); err != nil {
+		return nil, err
+	}
+	iv, err := txnInt(k.value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.MakeInt64(iv.Value), nil
+}
+
+func scriptGetFloat(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	} else if !k.exists {
+		return starlark.None, nil
+	}
+	fv := &pb.FloatValue{}
+	if err := txnUnmarshal(k.value, fv); err != nil {
+		return nil, err
+	}
+	return starlark.Float(fv.Value), nil
+}
+
+func scriptSetFloat(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value float64
+	k, err := scriptKey(thread, b, args, kwargs, 2, &value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, scriptPut(k, &pb.FloatValue{Value: value})
+}
+
+func scriptGetList(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	}
+	lst, err := scriptList(k)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]starlark.Value, len(lst.Value))
+	for i, item := range lst.Value {
+		items[i] = starlark.String(item)
+	}
+	return starlark.NewList(items), nil
+}
+
+func scriptSetList(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var items *starlark.List
+	k, err := scriptKey(thread, b, args, kwargs, 2, &items)
+	if err != nil {
+		return nil, err
+	}
+	lst := &pb.List{Value: make([][]byte, items.Len())}
+	for i := range lst.Value {
+		if lst.Value[i], err = scriptBytes(items.Index(i)); err != nil {
+			return nil, err
+		}
+	}
+	return starlark.None, scriptPut(k, lst)
+}
+
+func scriptListAppend(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value starlark.Value
+	k, err := scriptKey(thread, b, args, kwargs, 2, &value)
+	if err != nil {
+		return nil, err
+	}
+	v, err := scriptBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, txnApply(k, &pb.TxnOp{Type: pb.TxnOp_LIST_APPEND, Value: v})
+}
+
+// scriptListPop removes the first or last item of a list, returning None if the list is empty.
+func scriptListPop(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	}
+	lst, err := scriptList(k)
+	if err != nil {
+		return nil, err
+	} else if len(lst.Value) == 0 {
+		return starlark.None, nil
+	}
+
+	var item []byte
+	if b.Name() == "list_pop_left" {
+		item, lst.Value = lst.Value[0], lst.Value[1:]
+	} else {
+		item, lst.Value = lst.Value[len(lst.Value)-1], lst.Value[:len(lst.Value)-1]
+	}
+	return starlark.String(item), scriptPut(k, lst)
+}
+
+func scriptGetHash(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	k, err := scriptKey(thread, b, args, kwargs, 1)
+	if err != nil {
+		return nil, err
+	}
+	h, err := scriptHash(k)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	for field := range h.Value {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	d := starlark.NewDict(len(fields))
+	for _, field := range fields {
+		d.SetKey(starlark.String(field), starlark.String(h.Value[field]))
+	}
+	return d, nil
+}
+
+func scriptGetHashField(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var field string
+	k, err := scriptKey(thread, b, args, kwargs, 2, &field)
+	if err != nil {
+		return nil, err
+	}
+	h, err := scriptHash(k)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := h.Value[field]; ok {
+		return starlark.String(value), nil
+	}
+	return starlark.None, nil
+}
+
+func scriptSetHashField(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var field string
+	var value starlark.Value
+	k, err := scriptKey(thread, b, args, kwargs, 3, &field, &value)
+	if err != nil {
+		return nil, err
+	}
+	v, err := scriptBytes(value)
+	if err != nil {
+		return nil, err
+	}
+	return starlark.None, txnApply(k, &pb.TxnOp{Type: pb.TxnOp_SET_HASH_FIELD, Field: field, Value: v})
+}
+
+// scriptDelHashField deletes a field from a hash, returning whether the field existed.
+func scriptDelHashField(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var field string
+	k, err := scriptKey(thread, b, args, kwargs, 2, &field)
+	if err != nil {
+		return nil, err
+	}
+	h, err := scriptHash(k)
+	if err != nil {
+		return nil, err
+	} else if _, ok := h.Value[field]; !ok {
+		return starlark.False, nil
+	}
+	delete(h.Value, field)
+	return starlark.True, scriptPut(k, h)
+}
+
+// scriptList returns the list held by a key, or an empty list if the key doesn't exist.
+func scriptList(k *txnKey) (*pb.List, error) {
+	if !k.exists {
+		return &pb.List{Value: [][]byte{}}, nil
+	}
+	return txnList(k.value)
+}
+
+// scriptHash returns the hash held by a key, or an empty hash if the key doesn't exist.
+func scriptHash(k *txnKey) (*pb.Hash, error) {
+	h := &pb.Hash{}
+	if k.exists {
+		var err error
+		if h, err = txnHash(k.value); err != nil {
+			return nil, err
+		}
+	}
+	if h.Value == nil {
+		h.Value = map[string][]byte{}
+	}
+	return h, nil
+}
+
+func scriptPut(k *txnKey, m proto.Message) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	k.value = b
+	k.exists = true
+	k.changed = true
+	return nil
+}
+
+// scriptBytes encodes a Starlark value the same way the client encodes values of the matching Go type. Strings
+// are stored as they are, and lists and dicts with string keys are stored as lists and hashes of encoded values.
+func scriptBytes(v starlark.Value) ([]byte, error) {
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return []byte{}, nil
+	case starlark.String:
+		return util.StringToBytes(string(v)), nil
+	case starlark.Bytes:
+		return util.StringToBytes(string(v)), nil
+	case starlark.Bool:
+		return util.NewValue(bool(v)).Bytes()
+	case starlark.Int:
+		i, ok := v.Int64()
+		if !ok {
+			return nil, errors.New("int too large to store: " + v.String())
+		}
+		return util.NewValue(i).Bytes()
+	case starlark.Float:
+		return util.NewValue(float64(v)).Bytes()
+	case starlark.Indexable:
+		lst := &pb.List{Value: make([][]byte, v.Len())}
+		for i := range lst.Value {
+			b, err := scriptBytes(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			lst.Value[i] = b
+		}
+		return proto.Marshal(lst)
+	case *starlark.Dict:
+		h := &pb.Hash{Value: map[string][]byte{}}
+		for _, item := range v.Items() {
+			field, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, errors.New("dict keys must be strings to store, got: " + item[0].Type())
+			}
+			b, err := scriptBytes(item[1])
+			if err != nil {
+				return nil, err
+			}
+			h.Value[string(field)] = b
+		}
+		return proto.Marshal(h)
+	}
+	return nil, errors.New("can't store a value of type: " + v.Type())
+}
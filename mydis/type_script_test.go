@@ -0,0 +1,185 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+var transferScript = `
+amount = int(ARGV[0])
+balance = mydis.get_int(KEYS[0]) or 0
+if balance < amount:
+    fail("insufficient funds")
+mydis.increment_int(KEYS[0], -amount)
+result = mydis.increment_int(KEYS[1], amount)
+mydis.list_append("history", "transfer " + ARGV[0])
+mydis.set_hash_field("accounts", KEYS[1], result)
+`
+
+func TestEval(t *testing.T) {
+	testReset()
+
+	server.SetInt(ctx, &pb.IntValue{Key: "from", Value: 100})
+	if res, err := server.Eval(ctx, &pb.EvalRequest{Script: transferScript, Keys: []string{"from", "to"}, Args: []string{"30"}}); err != nil {
+		t.Error(err)
+	} else if i, err := util.NewValue(res.Value).Int(); err != nil || i != 30 || res.Revision == 0 {
+		t.Error("Unexpected value:", res, err)
+	}
+
+	if iv, err := server.GetInt(ctx, &pb.Key{Key: "from"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != 70 {
+		t.Error("Unexpected value:", iv.Value)
+	}
+	if lst, err := server.GetList(ctx, &pb.Key{Key: "history"}); err != nil {
+		t.Error(err)
+	} else if len(lst.Value) != 1 || string(lst.Value[0]) != "transfer 30" {
+		t.Error("Unexpected value:", lst.Value)
+	}
+	if v := client.GetHashField("accounts", "to"); v.Error() != nil {
+		t.Error(v.Error())
+	} else if i, _ := v.Int(); i != 30 {
+		t.Error("Unexpected value:", i)
+	}
+
+	// a failed script leaves every key as it was.
+	if _, err := server.Eval(ctx, &pb.EvalRequest{Script: transferScript, Keys: []string{"from", "to"}, Args: []string{"100"}}); err == nil {
+		t.Error("Expected script to fail")
+	}
+	if iv, err := server.GetInt(ctx, &pb.Key{Key: "to"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != 30 {
+		t.Error("Unexpected value:", iv.Value)
+	}
+
+	if _, err := server.Eval(ctx, &pb.EvalRequest{Script: "result = ("}); err == nil {
+		t.Error("Expected syntax error")
+	}
+	if _, err := server.Eval(ctx, &pb.EvalRequest{Script: `mydis.set("\x00_MYDIS_LOCK\x00key1", "a")`}); err == nil {
+		t.Error("Expected internal keys to be off limits")
+	}
+}
+
+func TestEvalTypes(t *testing.T) {
+	testReset()
+
+	script := `
+mydis.set_list("list", [1, "two"])
+mydis.set("hash", {"a": "b"})
+result = [mydis.list_pop_right("list"), mydis.get_hash_field("hash", "a"), mydis.delete("hash"), mydis.exists("hash")]
+`
+	v := client.Eval(script, nil)
+	lst, err := v.List()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(lst) != 4 {
+		t.Fatal("Unexpected value:", lst)
+	}
+	if s, _ := lst[0].String(); s != "two" {
+		t.Error("Unexpected value:", s)
+	}
+	if s, _ := lst[1].String(); s != "b" {
+		t.Error("Unexpected value:", s)
+	}
+	if b, _ := lst[2].Bool(); !b {
+		t.Error("Expected delete to return true")
+	}
+	if b, _ := lst[3].Bool(); b {
+		t.Error("Expected deleted key not to exist")
+	}
+
+	if l, err := server.GetList(ctx, &pb.Key{Key: "list"}); err != nil {
+		t.Error(err)
+	} else if len(l.Value) != 1 {
+		t.Error("Unexpected value:", l.Value)
+	} else if i, _ := util.NewValue(l.Value[0]).Int(); i != 1 {
+		t.Error("Unexpected value:", i)
+	}
+
+	// key1 holds a string, not an int.
+	if v := client.Eval(`result = mydis.get_int("key1")`, nil); v.Error() == nil {
+		t.Error("Expected type mismatch")
+	}
+}
+
+func TestEvalSHA(t *testing.T) {
+	testReset()
+
+	sha, err := client.ScriptLoad(`result = mydis.increment_int(KEYS[0])`)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(sha) != 40 {
+		t.Error("Unexpected SHA1 hash:", sha)
+	}
+
+	// scripts are stored, so servers that didn't load them can still run them.
+	server.scripts = newScriptCache()
+	for i := int64(1); i <= 2; i++ {
+		if n, err := client.EvalSHA(sha, []string{"counter"}).Int(); err != nil {
+			t.Error(err)
+		} else if n != i {
+			t.Error("Unexpected value:", n)
+		}
+	}
+
+	if v := client.EvalSHA("missing", nil); v.Error() != util.ErrScriptNotFound {
+		t.Error("Unexpected or no error:", v.Error())
+	}
+}
+
+func TestEvalLimits(t *testing.T) {
+	testReset()
+
+	loop := `
+mydis.set("written", "a")
+while True:
+    pass
+`
+	if v := client.Eval(loop, nil); v.Error() != util.ErrScriptTooManySteps {
+		t.Error("Unexpected or no error:", v.Error())
+	}
+
+	steps, timeout := scriptMaxSteps, scriptTimeout
+	scriptMaxSteps, scriptTimeout = 1<<62, 50*time.Millisecond
+	defer func() {
+		scriptMaxSteps, scriptTimeout = steps, timeout
+	}()
+	if v := client.Eval(loop, nil); v.Error() != util.ErrScriptTimeout {
+		t.Error("Unexpected or no error:", v.Error())
+	}
+
+	if _, err := server.Get(ctx, &pb.Key{Key: "written"}); err != util.ErrKeyNotFound {
+		t.Error("Expected stopped scripts not to write anything, got:", err)
+	}
+}
+
+func TestEvalLocked(t *testing.T) {
+	testReset()
+
+	server.Lock(ctx, &pb.Key{Key: "locked"})
+	if _, err := server.Eval(ctx, &pb.EvalRequest{Script: `mydis.set("unlocked", "a")
+mydis.set("locked", "b")`}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Get(ctx, &pb.Key{Key: "unlocked"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+	server.Unlock(ctx, &pb.Key{Key: "locked"})
+}
@@ -0,0 +1,458 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultSearchLimit is the number of results returned when a query doesn't give a limit.
+	defaultSearchLimit = 10
+	// BM25 ranking parameters.
+	searchK1 = 1.2
+	searchB  = 0.75
+)
+
+// A search index's settings are stored at its key, and its entries are stored as keys in the form:
+// prefixForSearch + encoded index key + "D" + hash key, holding the length of the hash's indexed text, and
+// prefixForSearch + encoded index key + "T" + encoded term + hash key, holding the term's postings in the hash.
+// So each write only changes the entries of the hash being written, and the postings of a term, or of the terms
+// starting with a prefix, can be found with a single range request.
+
+// searchClause is a single part of a query that a document must match.
+type searchClause struct {
+	terms  []string
+	prefix bool
+}
+
+// searchDocuments are the lengths of the indexed documents, used to rank matches.
+type searchDocuments struct {
+	lengths   map[string]int32
+	avgLength float64
+}
+
+// SearchIndexCreate creates a full-text search index over the given fields of the hashes whose keys start with
+// Prefix, indexing any existing hashes. Creating an index that already exists rebuilds it with the new settings.
+// The index is updated in the same transaction as any change to the hashes it covers.
+func (s *Server) SearchIndexCreate(ctx context.Context, si *pb.SearchIndex) (*pb.Null, error) {
+	if len(si.Prefix) == 0 || len(si.Fields) == 0 || strings.HasPrefix(si.Key, si.Prefix) {
+		return null, util.ErrInvalidSearchIndex
+	}
+	idx := &pb.SearchIndex{Key: si.Key, Prefix: si.Prefix, Fields: si.Fields}
+	if err := s.registerSearchIndex(ctx, idx, false); err != nil {
+		return null, err
+	}
+
+	// the settings replace any previous index, and its entries are removed in the same transaction.
+	key := &pb.Key{Key: si.Key}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return null, err
+	}
+	idx.Key = ""
+	b, err := proto.Marshal(idx)
+	idx.Key = si.Key
+	if err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+	if _, err := s.unlockThenSetWithOps(ctx, &pb.ByteValue{Key: si.Key, Value: b}, []*etcdpb.RequestOp{clearSearchIndexOp(si.Key)}); err != nil {
+		return null, err
+	}
+
+	keys, err := s.KeysWithPrefix(ctx, &pb.Key{Key: si.Prefix})
+	if err != nil {
+		return null, err
+	}
+	indexes := &hashIndexes{searches: []*pb.SearchIndex{idx}}
+	for _, k := range keys.Keys {
+		if err := s.indexExistingHash(ctx, indexes, k); err != nil {
+			return null, err
+		}
+	}
+	return null, nil
+}
+
+// SearchIndexDrop deletes a full-text search index. The indexed hashes are not changed.
+func (s *Server) SearchIndexDrop(ctx context.Context, key *pb.Key) (*pb.Null, error) {
+	if err := s.registerSearchIndex(ctx, &pb.SearchIndex{Key: key.Key}, true); err != nil {
+		return null, err
+	}
+	return s.Delete(ctx, key)
+}
+
+// Search gets the keys of the hashes that match all parts of a query, best match first, ranked using BM25.
+// A query is made of words, "quoted phrases" whose words must appear next to each other in the same field, and
+// prefixes ending with '*'. Offset and Limit page through the results, Total being the number of matches.
+func (s *Server) Search(ctx context.Context, q *pb.SearchQuery) (*pb.SearchResults, error) {
+	if q.Offset < 0 || q.Limit < 0 {
+		return nil, util.ErrInvalidSearchQuery
+	}
+	clauses := parseSearchQuery(q.Query)
+	if len(clauses) == 0 {
+		return nil, util.ErrInvalidSearchQuery
+	}
+
+	if _, err := s.getSearchIndex(ctx, q.Key); err != nil {
+		return nil, err
+	}
+	docs, err := s.getSearchDocuments(ctx, q.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := map[string]float64{}
+	for i, clause := range clauses {
+		clauseScores, err := s.scoreSearchClause(ctx, q.Key, docs, clause)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			scores = clauseScores
+			continue
+		}
+		for key, score := range scores {
+			if clauseScore, ok := clauseScores[key]; ok {
+				scores[key] = score + clauseScore
+			} else {
+				delete(scores, key)
+			}
+		}
+	}
+
+	all := make([]*pb.SearchResult, 0, len(scores))
+	for key, score := range scores {
+		all = append(all, &pb.SearchResult{Key: key, Score: score})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Score == all[j].Score {
+			return all[i].Key < all[j].Key
+		}
+		return all[i].Score > all[j].Score
+	})
+
+	limit := int(q.Limit)
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+	start := int(q.Offset)
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+	return &pb.SearchResults{Total: int64(len(all)), Results: all[start:end]}, nil
+}
+
+func getSearchPrefix(key string) string {
+	return prefixForSearch + encodeKeyPart(key)
+}
+
+func getSearchDocumentName(key, hashKey string) string {
+	return getSearchPrefix(key) + "D" + hashKey
+}
+
+func getSearchTermName(key, term, hashKey string) string {
+	return getSearchPrefix(key) + "T" + encodeKeyPart(term) + hashKey
+}
+
+// clearSearchIndexOp returns the operation that deletes every entry of a search index.
+func clearSearchIndexOp(key string) *etcdpb.RequestOp {
+	prefix := getSearchPrefix(key)
+	return &etcdpb.RequestOp{
+		Request: &etcdpb.RequestOp_RequestDeleteRange{
+			RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+				Key:      util.StringToBytes(prefix),
+				RangeEnd: getPrefix(prefix),
+			},
+		},
+	}
+}
+
+func (s *Server) getSearchIndex(ctx context.Context, key string) (*pb.SearchIndex, error) {
+	res, err := s.Get(ctx, &pb.Key{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	idx := &pb.SearchIndex{}
+	if err := txnUnmarshal(res.Value, idx); err != nil {
+		return nil, err
+	}
+	idx.Key = key
+	return idx, nil
+}
+
+func (s *Server) getSearchDocuments(ctx context.Context, key string) (*searchDocuments, error) {
+	prefix := getSearchPrefix(key) + "D"
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      util.StringToBytes(prefix),
+		RangeEnd: getPrefix(prefix),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	docs := &searchDocuments{lengths: map[string]int32{}}
+	total := float64(0)
+	for _, kv := range res.Kvs {
+		doc := &pb.SearchDocument{}
+		if err := proto.Unmarshal(kv.Value, doc); err != nil {
+			return nil, err
+		}
+		docs.lengths[string(kv.Key[len(prefix):])] = doc.Length
+		total += float64(doc.Length)
+	}
+	if len(docs.lengths) > 0 {
+		docs.avgLength = total / float64(len(docs.lengths))
+	}
+	return docs, nil
+}
+
+// getSearchPostings gets the postings of a term, or of every term starting with it if prefix is true, by term.
+func (s *Server) getSearchPostings(ctx context.Context, key, term string, prefix bool) (map[string][]*pb.SearchPosting, error) {
+	base := getSearchPrefix(key) + "T"
+	start := base + encodeKeyPart(term)
+	if prefix {
+		// terms never contain zero bytes, so they're encoded as themselves followed by the terminator.
+		start = base + term
+	}
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      util.StringToBytes(start),
+		RangeEnd: getPrefix(start),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	terms := map[string][]*pb.SearchPosting{}
+	for _, kv := range res.Kvs {
+		t, _, ok := decodeKeyPart(string(kv.Key[len(base):]))
+		if !ok {
+			continue
+		}
+		postings := &pb.SearchPostings{}
+		if err := proto.Unmarshal(kv.Value, postings); err != nil {
+			return nil, err
+		}
+		terms[t] = append(terms[t], postings.Postings...)
+	}
+	return terms, nil
+}
+
+// registerSearchIndex adds or replaces an index in the registry of indexes that are updated when hashes change,
+// or removes it if remove is true.
+func (s *Server) registerSearchIndex(ctx context.Context, si *pb.SearchIndex, remove bool) error {
+	key := &pb.Key{Key: searchIndexRegistry}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return err
+	}
+
+	reg, err := s.GetHash(ctx, key)
+	if err == util.ErrKeyNotFound {
+		reg = &pb.Hash{Value: map[string][]byte{}}
+	} else if err != nil {
+		s.Unlock(ctx, key)
+		return err
+	}
+	if reg.Value == nil {
+		reg.Value = map[string][]byte{}
+	}
+
+	if remove {
+		delete(reg.Value, si.Key)
+	} else {
+		b, err := proto.Marshal(&pb.SearchIndex{Prefix: si.Prefix, Fields: si.Fields})
+		if err != nil {
+			s.Unlock(ctx, key)
+			return err
+		}
+		reg.Value[si.Key] = b
+	}
+	reg.Key = searchIndexRegistry
+	_, err = s.UnlockThenSetHash(ctx, reg)
+	return err
+}
+
+// searchIndexEntries returns the search index entries for the given hash, mapped to their values.
+func searchIndexEntries(indexes []*pb.SearchIndex, key string, h *pb.Hash) map[string]string {
+	entries := map[string]string{}
+	if h == nil {
+		return entries
+	}
+	for _, si := range indexes {
+		doc := &pb.SearchDocument{}
+		terms := map[string]*pb.SearchPostings{}
+		for _, field := range si.Fields {
+			positions := map[string][]int32{}
+			order := []string{}
+			for i, token := range tokenize(util.BytesToString(h.Value[field])) {
+				if _, ok := positions[token]; !ok {
+					order = append(order, token)
+				}
+				positions[token] = append(positions[token], int32(i))
+				doc.Length++
+			}
+
+			for _, term := range order {
+				postings := terms[term]
+				if postings == nil {
+					postings = &pb.SearchPostings{}
+					terms[term] = postings
+				}
+				postings.Postings = append(postings.Postings, &pb.SearchPosting{Key: key, Field: field, Positions: positions[term]})
+			}
+		}
+
+		if b, err := proto.Marshal(doc); err == nil {
+			entries[getSearchDocumentName(si.Key, key)] = string(b)
+		}
+		for term, postings := range terms {
+			if b, err := proto.Marshal(postings); err == nil {
+				entries[getSearchTermName(si.Key, term, key)] = string(b)
+			}
+		}
+	}
+	return entries
+}
+
+// tokenize splits text into lower case words, made of letters and numbers.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func parseSearchQuery(query string) []searchClause {
+	clauses := []searchClause{}
+	for i, part := range strings.Split(query, "\"") {
+		// every odd part was inside quotes.
+		if i%2 == 1 {
+			if terms := tokenize(part); len(terms) > 0 {
+				clauses = append(clauses, searchClause{terms: terms})
+			}
+			continue
+		}
+
+		for _, word := range strings.Fields(part) {
+			terms := tokenize(word)
+			for j, term := range terms {
+				prefix := j == len(terms)-1 && strings.HasSuffix(word, "*")
+				clauses = append(clauses, searchClause{terms: []string{term}, prefix: prefix})
+			}
+		}
+	}
+	return clauses
+}
+
+// scoreSearchClause returns the BM25 score of each document matching the clause.
+func (s *Server) scoreSearchClause(ctx context.Context, key string, docs *searchDocuments, clause searchClause) (map[string]float64, error) {
+	scores := map[string]float64{}
+	if len(clause.terms) == 1 {
+		terms, err := s.getSearchPostings(ctx, key, clause.terms[0], clause.prefix)
+		if err != nil {
+			return nil, err
+		}
+		for _, postings := range terms {
+			addSearchScores(docs, scores, termFrequencies(postings), 1)
+		}
+		return scores, nil
+	}
+
+	phrase := make([][]*pb.SearchPosting, len(clause.terms))
+	for i, term := range clause.terms {
+		terms, err := s.getSearchPostings(ctx, key, term, false)
+		if err != nil {
+			return nil, err
+		} else if len(terms[term]) == 0 {
+			return scores, nil
+		}
+		phrase[i] = terms[term]
+	}
+	addSearchScores(docs, scores, phraseFrequencies(phrase), float64(len(clause.terms)))
+	return scores, nil
+}
+
+func addSearchScores(docs *searchDocuments, scores map[string]float64, freqs map[string]int, weight float64) {
+	if len(freqs) == 0 {
+		return
+	}
+
+	n := float64(len(docs.lengths))
+	df := float64(len(freqs))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	for key, freq := range freqs {
+		tf := float64(freq)
+		length := float64(docs.lengths[key])
+		norm := 1 - searchB
+		if docs.avgLength > 0 {
+			norm += searchB * length / docs.avgLength
+		}
+		scores[key] += weight * idf * tf * (searchK1 + 1) / (tf + searchK1*norm)
+	}
+}
+
+func termFrequencies(postings []*pb.SearchPosting) map[string]int {
+	freqs := map[string]int{}
+	for _, posting := range postings {
+		freqs[posting.Key] += len(posting.Positions)
+	}
+	return freqs
+}
+
+// phraseFrequencies counts how many times the terms, given by their postings, appear next to each other, in order,
+// in the same field.
+func phraseFrequencies(phrase [][]*pb.SearchPosting) map[string]int {
+	positions := make([]map[string]map[int32]bool, len(phrase))
+	for i, postings := range phrase {
+		positions[i] = map[string]map[int32]bool{}
+		for _, posting := range postings {
+			set := map[int32]bool{}
+			for _, pos := range posting.Positions {
+				set[pos] = true
+			}
+			positions[i][posting.Key+"\x00"+posting.Field] = set
+		}
+	}
+
+	freqs := map[string]int{}
+	for _, posting := range phrase[0] {
+		docField := posting.Key + "\x00" + posting.Field
+		for _, start := range posting.Positions {
+			match := true
+			for i := 1; i < len(phrase); i++ {
+				if !positions[i][docField][start+int32(i)] {
+					match = false
+					break
+				}
+			}
+			if match {
+				freqs[posting.Key]++
+			}
+		}
+	}
+	return freqs
+}
@@ -0,0 +1,202 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+)
+
+func searchKeys(res *pb.SearchResults) []string {
+	keys := []string{}
+	for _, r := range res.Results {
+		keys = append(keys, r.Key)
+	}
+	return keys
+}
+
+func TestTokenize(t *testing.T) {
+	if tokens := tokenize("Red Running-Shoe, size 10!"); len(tokens) != 5 || tokens[1] != "running" || tokens[4] != "10" {
+		t.Error("Unexpected value:", tokens)
+	}
+}
+
+func TestSearchIndexCreate(t *testing.T) {
+	testReset()
+
+	server.SetHash(ctx, &pb.Hash{Key: "product:1", Value: map[string][]byte{"title": []byte("Red running shoe"), "price": []byte("10")}})
+	server.SetHash(ctx, &pb.Hash{Key: "product:2", Value: map[string][]byte{"title": []byte("Blue shoe for running")}})
+
+	if _, err := server.SearchIndexCreate(ctx, &pb.SearchIndex{Key: "products", Prefix: "product:", Fields: []string{"title", "description"}}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.SearchIndexCreate(ctx, &pb.SearchIndex{Key: "product:idx", Prefix: "product:", Fields: []string{"title"}}); err != util.ErrInvalidSearchIndex {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestSearchIndexUpdates(t *testing.T) {
+	if _, err := server.SetHashField(ctx, &pb.HashField{Key: "product:3", Field: "title", Value: []byte("Green sneaker")}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.SetHashFields(ctx, &pb.Hash{Key: "product:4", Value: map[string][]byte{"title": []byte("Shoe horn"), "description": []byte("For a running shoe")}}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.SetHash(ctx, &pb.Hash{Key: "product:5", Value: map[string][]byte{"title": []byte("Sock")}}); err != nil {
+		t.Error(err)
+	}
+
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "shoe"}); err != nil {
+		t.Error(err)
+	} else if res.Total != 3 {
+		t.Error("Unexpected value:", searchKeys(res))
+	}
+
+	if _, err := server.DelHashField(ctx, &pb.HashField{Key: "product:4", Field: "title"}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Delete(ctx, &pb.Key{Key: "product:5"}); err != nil {
+		t.Error(err)
+	}
+
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "horn"}); err != nil {
+		t.Error(err)
+	} else if res.Total != 0 {
+		t.Error("Unexpected value:", searchKeys(res))
+	}
+
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "sock"}); err != nil {
+		t.Error(err)
+	} else if res.Total != 0 {
+		t.Error("Unexpected value:", searchKeys(res))
+	}
+}
+
+func TestSearchQueries(t *testing.T) {
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: `"running shoe"`}); err != nil {
+		t.Error(err)
+	} else if keys := searchKeys(res); len(keys) != 2 || keys[0] != "product:1" || keys[1] != "product:4" {
+		t.Error("Unexpected value:", keys)
+	}
+
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "sne*"}); err != nil {
+		t.Error(err)
+	} else if keys := searchKeys(res); len(keys) != 1 || keys[0] != "product:3" {
+		t.Error("Unexpected value:", keys)
+	}
+
+	// every part of the query must match.
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "blue running"}); err != nil {
+		t.Error(err)
+	} else if keys := searchKeys(res); len(keys) != 1 || keys[0] != "product:2" {
+		t.Error("Unexpected value:", keys)
+	}
+
+	if _, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: " !! "}); err != util.ErrInvalidSearchQuery {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	// shorter fields with the same term rank higher.
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "shoe"}); err != nil {
+		t.Error(err)
+	} else if keys := searchKeys(res); len(keys) != 3 || keys[0] != "product:1" || res.Results[0].Score <= res.Results[2].Score {
+		t.Error("Unexpected value:", res.Results)
+	}
+}
+
+func TestSearchPaging(t *testing.T) {
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "shoe", Offset: 1, Limit: 1}); err != nil {
+		t.Error(err)
+	} else if res.Total != 3 || len(res.Results) != 1 {
+		t.Error("Unexpected value:", res)
+	}
+
+	if res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "shoe", Offset: 5}); err != nil {
+		t.Error(err)
+	} else if res.Total != 3 || len(res.Results) != 0 {
+		t.Error("Unexpected value:", res)
+	}
+}
+
+func searchTotal(t *testing.T, query string) int64 {
+	res, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Total
+}
+
+func TestSearchIndexWrites(t *testing.T) {
+	b, _ := proto.Marshal(&pb.Hash{Value: map[string][]byte{"title": []byte("Purple boot")}})
+	if _, err := server.Set(ctx, &pb.ByteValue{Key: "product:6", Value: b}); err != nil {
+		t.Fatal(err)
+	}
+	if total := searchTotal(t, "purple"); total != 1 {
+		t.Error("Expected plain set to be indexed, got:", total)
+	}
+
+	// replacing a hash with a value that isn't a hash removes it from the index.
+	if res, err := server.CompareAndSwap(ctx, &pb.CompareAndSwapRequest{Key: "product:6", Expected: b, Value: []byte("boot")}); err != nil || !res.Swapped {
+		t.Fatal("Unexpected value:", res, err)
+	}
+	if total := searchTotal(t, "purple"); total != 0 {
+		t.Error("Expected swapped value to be removed, got:", total)
+	}
+
+	b, _ = proto.Marshal(&pb.Hash{Value: map[string][]byte{"title": []byte("Yellow boot")}})
+	if res, err := server.SetIfModRevision(ctx, &pb.RevisionValue{Key: "product:7", Value: b}); err != nil || !res.Swapped {
+		t.Fatal("Unexpected value:", res, err)
+	}
+	if total := searchTotal(t, "yellow"); total != 1 {
+		t.Error("Expected swapped value to be indexed, got:", total)
+	}
+
+	if _, err := server.Txn(ctx, &pb.TxnRequest{Success: []*pb.TxnOp{{Type: pb.TxnOp_DELETE, Key: "product:7"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if total := searchTotal(t, "yellow boot"); total != 0 {
+		t.Error("Expected deleted value to be removed, got:", total)
+	}
+	server.Delete(ctx, &pb.Key{Key: "product:6"})
+}
+
+func TestSearchIndexDrop(t *testing.T) {
+	if _, err := server.SearchIndexDrop(ctx, &pb.Key{Key: "products"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.Search(ctx, &pb.SearchQuery{Key: "products", Query: "shoe"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	prefix := getSearchPrefix("products")
+	if res, err := server.storage.Range(ctx, &etcdpb.RangeRequest{Key: []byte(prefix), RangeEnd: getPrefix(prefix), CountOnly: true}); err != nil {
+		t.Error(err)
+	} else if res.Count != 0 {
+		t.Error("Expected entries to be deleted, got:", res.Count)
+	}
+
+	// changes to hashes still work once the index is dropped.
+	if _, err := server.SetHashField(ctx, &pb.HashField{Key: "product:1", Field: "title", Value: []byte("Shoe")}); err != nil {
+		t.Error(err)
+	}
+}
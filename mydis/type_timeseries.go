@@ -0,0 +1,360 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// maxDownsampleDepth limits how many series a single sample can cascade through.
+const maxDownsampleDepth = 8
+
+// GetTimeSeries gets a time series from the cache.
+func (s *Server) GetTimeSeries(ctx context.Context, key *pb.Key) (*pb.TimeSeries, error) {
+	res, err := s.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	ts := &pb.TimeSeries{}
+	if err := proto.Unmarshal(res.Value, ts); err != nil && strings.HasPrefix(err.Error(), "proto: can't skip unknown wire type") {
+		return nil, util.ErrTypeMismatch
+	} else if err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// TimeSeriesCreate creates a time series, or updates the retention and downsampling rules of an existing one.
+// Retention and bucket sizes are in milliseconds. Rules that lead back to the series, or that cascade through more
+// than maxDownsampleDepth series, are rejected.
+func (s *Server) TimeSeriesCreate(ctx context.Context, ts *pb.TimeSeries) (*pb.Null, error) {
+	if ts.Retention < 0 {
+		return null, util.ErrInvalidRetention
+	}
+	for _, rule := range ts.Rules {
+		if rule.Bucket <= 0 || rule.Aggregation == pb.Aggregation_NONE {
+			return null, util.ErrInvalidDownsampleRule
+		}
+		if len(rule.DestKey) == 0 || rule.DestKey == ts.Key {
+			return null, util.ErrInvalidKey
+		}
+	}
+
+	key := &pb.Key{Key: ts.Key}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return null, err
+	}
+
+	existing, err := s.getTimeSeriesOrEmpty(ctx, ts.Key)
+	if err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+
+	existing.Retention = ts.Retention
+	existing.Rules = ts.Rules
+	if _, err := s.downsampleSeries(ctx, existing); err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+	enforceRetention(existing)
+	return s.unlockThenSetTimeSeries(ctx, existing)
+}
+
+// TimeSeriesAdd adds a sample to a time series, creates new time series if doesn't exist. If the timestamp
+// is zero, the current time is used. Samples are kept ordered by timestamp, and a sample with the same
+// timestamp as an existing one replaces it. Downsampling rules are applied in the same transaction the sample is
+// stored in, so either every series the sample cascades into is written or none of them are.
+func (s *Server) TimeSeriesAdd(ctx context.Context, sample *pb.TimeSeriesSample) (*pb.Null, error) {
+	if sample.Timestamp == 0 {
+		sample.Timestamp = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+
+	for {
+		// the series are found before they're locked, so they're read again once locked in case their rules changed.
+		root, err := s.getTimeSeriesOrEmpty(ctx, sample.Key)
+		if err != nil {
+			return null, err
+		}
+		series, err := s.downsampleSeries(ctx, root)
+		if err != nil {
+			return null, err
+		}
+		keys := make([]string, 0, len(series))
+		for key := range series {
+			keys = append(keys, key)
+		}
+		if _, err := s.LockMany(ctx, &pb.KeysExpiration{Keys: keys, Exp: s.getMaxWait(ctx)}); err != nil {
+			return null, err
+		}
+
+		unlock := func() {
+			s.UnlockMany(ctx, &pb.KeysList{Keys: keys})
+		}
+		if root, err = s.getTimeSeriesOrEmpty(ctx, sample.Key); err != nil {
+			unlock()
+			return null, err
+		}
+		locked, err := s.downsampleSeries(ctx, root)
+		if err != nil {
+			unlock()
+			return null, err
+		}
+		if !sameSeries(series, locked) {
+			unlock()
+			continue
+		}
+
+		addSample(locked, sample.Key, &pb.Sample{Timestamp: sample.Timestamp, Value: sample.Value})
+		vals := make([]*pb.ByteValue, 0, len(locked))
+		for key, ts := range locked {
+			b, err := marshalTimeSeries(ts)
+			if err != nil {
+				unlock()
+				return null, err
+			}
+			vals = append(vals, &pb.ByteValue{Key: key, Value: b})
+		}
+		return s.unlockManyThenSet(ctx, vals)
+	}
+}
+
+// TimeSeriesRange gets the samples between From and To inclusive, in milliseconds. A To of zero means no upper bound.
+// If an aggregation is given, samples are aggregated into buckets of the given size in milliseconds, each
+// sample being stamped with the start of its bucket. A bucket size of zero aggregates the whole range into one sample.
+func (s *Server) TimeSeriesRange(ctx context.Context, q *pb.TimeSeriesQuery) (*pb.TimeSeries, error) {
+	if q.Bucket < 0 {
+		return nil, util.ErrInvalidDownsampleRule
+	}
+
+	ts, err := s.GetTimeSeries(ctx, &pb.Key{Key: q.Key})
+	if err != nil {
+		return nil, err
+	}
+
+	samples := []*pb.Sample{}
+	for _, sample := range ts.Samples {
+		if sample.Timestamp < q.From || (q.To > 0 && sample.Timestamp > q.To) {
+			continue
+		}
+		samples = append(samples, sample)
+	}
+
+	if q.Aggregation != pb.Aggregation_NONE {
+		samples = aggregateSamples(samples, q.Aggregation, q.Bucket)
+	}
+
+	return &pb.TimeSeries{
+		Key:       q.Key,
+		Samples:   samples,
+		Retention: ts.Retention,
+		Rules:     ts.Rules,
+	}, nil
+}
+
+// getTimeSeriesOrEmpty gets a time series, or an empty one if the key doesn't exist.
+func (s *Server) getTimeSeriesOrEmpty(ctx context.Context, key string) (*pb.TimeSeries, error) {
+	ts, err := s.GetTimeSeries(ctx, &pb.Key{Key: key})
+	if err == util.ErrKeyNotFound {
+		ts = &pb.TimeSeries{Samples: []*pb.Sample{}}
+	} else if err != nil {
+		return nil, err
+	}
+	ts.Key = key
+	return ts, nil
+}
+
+func (s *Server) unlockThenSetTimeSeries(ctx context.Context, ts *pb.TimeSeries) (*pb.Null, error) {
+	b, err := marshalTimeSeries(ts)
+	if err != nil {
+		s.Unlock(ctx, &pb.Key{Key: ts.Key})
+		return null, err
+	}
+	return s.UnlockThenSet(ctx, &pb.ByteValue{Key: ts.Key, Value: b})
+}
+
+// marshalTimeSeries encodes a time series without its key, which is stored as the name of the key instead.
+func marshalTimeSeries(ts *pb.TimeSeries) ([]byte, error) {
+	key := ts.Key
+	ts.Key = ""
+	b, err := proto.Marshal(ts)
+	ts.Key = key
+	return b, err
+}
+
+// downsampleSeries reads the series that samples added to the given series cascade into through downsampling rules,
+// keyed by name and including the given series. Series that don't exist yet are empty. ErrInvalidDownsampleRule is
+// returned if the rules lead back to a series they came from, or cascade through more than maxDownsampleDepth series.
+func (s *Server) downsampleSeries(ctx context.Context, root *pb.TimeSeries) (map[string]*pb.TimeSeries, error) {
+	series := map[string]*pb.TimeSeries{root.Key: root}
+	path := map[string]bool{root.Key: true}
+
+	var walk func(ts *pb.TimeSeries, depth int) error
+	walk = func(ts *pb.TimeSeries, depth int) error {
+		for _, rule := range ts.Rules {
+			if path[rule.DestKey] || depth >= maxDownsampleDepth {
+				return util.ErrInvalidDownsampleRule
+			}
+			dest, ok := series[rule.DestKey]
+			if !ok {
+				var err error
+				if dest, err = s.getTimeSeriesOrEmpty(ctx, rule.DestKey); err != nil {
+					return err
+				}
+				series[rule.DestKey] = dest
+			}
+
+			path[rule.DestKey] = true
+			if err := walk(dest, depth+1); err != nil {
+				return err
+			}
+			delete(path, rule.DestKey)
+		}
+		return nil
+	}
+	return series, walk(root, 0)
+}
+
+// sameSeries returns true if both sets of series have the same names and downsampling rules.
+func sameSeries(a, b map[string]*pb.TimeSeries) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, ts := range a {
+		other, ok := b[key]
+		if !ok || len(ts.Rules) != len(other.Rules) {
+			return false
+		}
+		for i, rule := range ts.Rules {
+			if !proto.Equal(rule, other.Rules[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// addSample inserts a sample into one of the series, then recalculates the bucket it falls in for each of the
+// series' downsampling rules, adding the result to the rule's series.
+func addSample(series map[string]*pb.TimeSeries, key string, sample *pb.Sample) {
+	ts := series[key]
+	insertSample(ts, sample)
+	enforceRetention(ts)
+
+	for _, rule := range ts.Rules {
+		start := bucketStart(sample.Timestamp, rule.Bucket)
+		samples := []*pb.Sample{}
+		for _, sample := range ts.Samples {
+			if sample.Timestamp >= start && sample.Timestamp < start+rule.Bucket {
+				samples = append(samples, sample)
+			}
+		}
+		if len(samples) == 0 {
+			continue
+		}
+		addSample(series, rule.DestKey, &pb.Sample{Timestamp: start, Value: aggregate(samples, rule.Aggregation)})
+	}
+}
+
+func insertSample(ts *pb.TimeSeries, sample *pb.Sample) {
+	i := sort.Search(len(ts.Samples), func(i int) bool {
+		return ts.Samples[i].Timestamp >= sample.Timestamp
+	})
+	if i < len(ts.Samples) && ts.Samples[i].Timestamp == sample.Timestamp {
+		ts.Samples[i] = sample
+		return
+	}
+
+	ts.Samples = append(ts.Samples, nil)
+	copy(ts.Samples[i+1:], ts.Samples[i:])
+	ts.Samples[i] = sample
+}
+
+func enforceRetention(ts *pb.TimeSeries) {
+	if ts.Retention == 0 || len(ts.Samples) == 0 {
+		return
+	}
+
+	oldest := ts.Samples[len(ts.Samples)-1].Timestamp - ts.Retention
+	i := sort.Search(len(ts.Samples), func(i int) bool {
+		return ts.Samples[i].Timestamp >= oldest
+	})
+	ts.Samples = ts.Samples[i:]
+}
+
+func bucketStart(timestamp, bucket int64) int64 {
+	start := timestamp - timestamp%bucket
+	if timestamp < 0 && timestamp%bucket != 0 {
+		start -= bucket
+	}
+	return start
+}
+
+func aggregateSamples(samples []*pb.Sample, agg pb.Aggregation, bucket int64) []*pb.Sample {
+	if len(samples) == 0 {
+		return samples
+	}
+	if bucket == 0 {
+		return []*pb.Sample{{Timestamp: samples[0].Timestamp, Value: aggregate(samples, agg)}}
+	}
+
+	result := []*pb.Sample{}
+	first := 0
+	start := bucketStart(samples[0].Timestamp, bucket)
+	for i, sample := range samples {
+		if sample.Timestamp >= start+bucket {
+			result = append(result, &pb.Sample{Timestamp: start, Value: aggregate(samples[first:i], agg)})
+			first = i
+			start = bucketStart(sample.Timestamp, bucket)
+		}
+	}
+	return append(result, &pb.Sample{Timestamp: start, Value: aggregate(samples[first:], agg)})
+}
+
+func aggregate(samples []*pb.Sample, agg pb.Aggregation) float64 {
+	switch agg {
+	case pb.Aggregation_COUNT:
+		return float64(len(samples))
+	case pb.Aggregation_MIN:
+		min := math.Inf(1)
+		for _, sample := range samples {
+			min = math.Min(min, sample.Value)
+		}
+		return min
+	case pb.Aggregation_MAX:
+		max := math.Inf(-1)
+		for _, sample := range samples {
+			max = math.Max(max, sample.Value)
+		}
+		return max
+	}
+
+	sum := float64(0)
+	for _, sample := range samples {
+		sum += sample.Value
+	}
+	if agg == pb.Aggregation_AVG && len(samples) > 0 {
+		return sum / float64(len(samples))
+	}
+	return sum
+}
@@ -0,0 +1,141 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+)
+
+func TestTimeSeriesCreate(t *testing.T) {
+	testReset()
+
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "ts1", Retention: 10000, Rules: []*pb.DownsampleRule{
+		{DestKey: "ts1_avg", Aggregation: pb.Aggregation_AVG, Bucket: 1000},
+	}}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "ts2", Rules: []*pb.DownsampleRule{
+		{DestKey: "ts2_avg", Bucket: 1000},
+	}}); err != util.ErrInvalidDownsampleRule {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestTimeSeriesAdd(t *testing.T) {
+	for i := int64(0); i < 30; i++ {
+		if _, err := server.TimeSeriesAdd(ctx, &pb.TimeSeriesSample{Key: "ts1", Timestamp: 1000 + i*100, Value: float64(i)}); err != nil {
+			t.Error(err)
+		}
+	}
+
+	// out of order samples are inserted in order.
+	if _, err := server.TimeSeriesAdd(ctx, &pb.TimeSeriesSample{Key: "ts1", Timestamp: 1050, Value: 100}); err != nil {
+		t.Error(err)
+	}
+
+	if ts, err := server.GetTimeSeries(ctx, &pb.Key{Key: "ts1"}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 31 || ts.Samples[1].Timestamp != 1050 || ts.Samples[30].Value != 29 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+}
+
+func TestTimeSeriesRange(t *testing.T) {
+	if ts, err := server.TimeSeriesRange(ctx, &pb.TimeSeriesQuery{Key: "ts1", From: 2000, To: 2900}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 10 || ts.Samples[0].Value != 10 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+
+	if ts, err := server.TimeSeriesRange(ctx, &pb.TimeSeriesQuery{Key: "ts1", From: 2000, Aggregation: pb.Aggregation_MAX, Bucket: 1000}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 2 || ts.Samples[0].Timestamp != 2000 || ts.Samples[0].Value != 19 || ts.Samples[1].Value != 29 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+
+	if ts, err := server.TimeSeriesRange(ctx, &pb.TimeSeriesQuery{Key: "ts1", Aggregation: pb.Aggregation_COUNT}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 1 || ts.Samples[0].Value != 31 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+}
+
+func TestTimeSeriesDownsample(t *testing.T) {
+	if ts, err := server.GetTimeSeries(ctx, &pb.Key{Key: "ts1_avg"}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 3 || ts.Samples[1].Timestamp != 2000 || ts.Samples[1].Value != 14.5 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+}
+
+func TestTimeSeriesRetention(t *testing.T) {
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "ts1", Retention: 500}); err != nil {
+		t.Error(err)
+	}
+
+	if ts, err := server.GetTimeSeries(ctx, &pb.Key{Key: "ts1"}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 6 || ts.Samples[0].Timestamp != 3400 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+
+	// rolled up data is kept.
+	if ts, err := server.GetTimeSeries(ctx, &pb.Key{Key: "ts1_avg"}); err != nil {
+		t.Error(err)
+	} else if len(ts.Samples) != 3 {
+		t.Error("Unexpected value:", ts.Samples)
+	}
+}
+
+func TestTimeSeriesRuleCycles(t *testing.T) {
+	rule := func(dest string) []*pb.DownsampleRule {
+		return []*pb.DownsampleRule{{DestKey: dest, Aggregation: pb.Aggregation_SUM, Bucket: 1000}}
+	}
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "tsA", Rules: rule("tsB")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "tsB", Rules: rule("tsC")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "tsC", Rules: rule("tsA")}); err != util.ErrInvalidDownsampleRule {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// chains deeper than the limit are rejected when they're created, not when a sample is added.
+	for i := 0; i < maxDownsampleDepth; i++ {
+		if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: fmt.Sprint("tsDeep", i), Rules: rule(fmt.Sprint("tsDeep", i+1))}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := server.TimeSeriesCreate(ctx, &pb.TimeSeries{Key: "tsDeep", Rules: rule("tsDeep0")}); err != util.ErrInvalidDownsampleRule {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if _, err := server.TimeSeriesAdd(ctx, &pb.TimeSeriesSample{Key: "tsA", Timestamp: 1500, Value: 2}); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"tsB", "tsC"} {
+		if ts, err := server.GetTimeSeries(ctx, &pb.Key{Key: key}); err != nil {
+			t.Error(err)
+		} else if len(ts.Samples) != 1 || ts.Samples[0].Timestamp != 1000 || ts.Samples[0].Value != 2 {
+			t.Error("Unexpected value:", key, ts.Samples)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+// txnKey is the state of a key as read by a transaction, along with the changes made to it.
+type txnKey struct {
+	orig    []byte
+	value   []byte
+	exists  bool
+	modRev  int64
+	lease   int64
+	changed bool
+}
+
+// Txn evaluates the comparisons and applies the success operations if they all pass, otherwise the failure
+// operations. All of the keys are read, then the changes are written in a single etcd transaction that only
+// succeeds if none of the keys were changed or locked in the meantime, retrying until the maximum lock wait.
+func (s *Server) Txn(ctx context.Context, req *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if err := validateTxn(req); err != nil {
+		return nil, err
+	}
+
+	maxW := time.Duration(s.getMaxWait(ctx))
+	maxWait := time.Now().Add(maxW * time.Second)
+
+	for {
+		res, err := s.tryTxn(ctx, req)
+		if err != nil {
+			return nil, err
+		} else if res != nil {
+			return res, nil
+		}
+
+		time.Sleep(delay)
+		if time.Now().After(maxWait) {
+			return nil, util.ErrKeyLocked
+		}
+	}
+}
+
+// tryTxn makes a single attempt at running a transaction, returning a nil response if it needs to be retried.
+func (s *Server) tryTxn(ctx context.Context, req *pb.TxnRequest) (*pb.TxnResponse, error) {
+	keys, state, err := s.readTxnKeys(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := true
+	for _, c := range req.Compare {
+		ok, err := txnCompare(state[c.Key], c)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			succeeded = false
+			break
+		}
+	}
+
+	ops := req.Success
+	if !succeeded {
+		ops = req.Failure
+	}
+	results := []*pb.ByteValue{}
+	for _, op := range ops {
+		k := state[op.Key]
+		if err := txnApply(k, op); err != nil {
+			return nil, err
+		}
+		results = append(results, &pb.ByteValue{Key: op.Key, Value: k.value})
+	}
+
+	rev, err := s.commitTxn(ctx, keys, state)
+	if err != nil || rev == 0 {
+		return nil, err
+	}
+	return &pb.TxnResponse{Succeeded: succeeded, Results: results, Revision: rev}, nil
+}
+
+// commitTxn writes the changes made to the keys read by a transaction, along with the changes to their index entries,
+// in a single etcd transaction, returning the revision it was written at, or zero if any of the keys or indexes were
+// changed since they were read, or the keys are locked by someone other than the caller's lock owner.
+func (s *Server) commitTxn(ctx context.Context, keys []string, state map[string]*txnKey) (int64, error) {
+	indexes, err := s.getHashIndexes(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lease, err := s.getLease(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	holders, lockRevs, err := s.getLockHolders(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+	owner := getLockOwner(ctx)
+
+	compares := append([]*etcdpb.Compare{}, indexes.compares...)
+	requests := []*etcdpb.RequestOp{}
+	changed := []string{}
+	for i, key := range keys {
+		k := state[key]
+		bkey := util.StringToBytes(key)
+		compares = append(compares, txnModCompare(bkey, k.modRev))
+		if !k.changed {
+			continue
+		}
+		changed = append(changed, key)
+		if k.exists {
+			err = s.beforeSet(ctx, key, k.value)
+		} else {
+			err = s.beforeDelete(ctx, key)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		// the key must not be locked by anyone else, and its lock must not change before the write.
+		lockCmp, ok := writeLockCompare(key, holders[i], lockRevs[i], owner)
+		if !ok {
+			return 0, nil
+		}
+		compares = append(compares, lockCmp)
+
+		if k.exists {
+			requests = append(requests, &etcdpb.RequestOp{
+				Request: &etcdpb.RequestOp_RequestPut{
+					RequestPut: &etcdpb.PutRequest{
+						Key:   bkey,
+						Value: k.value,
+						Lease: lease,
+					},
+				},
+			})
+		} else {
+			requests = append(requests, &etcdpb.RequestOp{
+				Request: &etcdpb.RequestOp_RequestDeleteRange{
+					RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+						Key: bkey,
+					},
+				},
+			})
+		}
+
+		if !isInternalKey(key) {
+			hi := indexes.forKey(key)
+			requests = append(requests, hi.ops(key, indexedHash(k.orig, k.modRev > 0), indexedHash(k.value, k.exists), k.lease, lease)...)
+		}
+	}
+
+	res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+		Compare: compares,
+		Success: requests,
+	})
+	if err != nil {
+		return 0, err
+	} else if !res.Succeeded {
+		return 0, nil
+	}
+	for _, key := range changed {
+		if k := state[key]; k.exists {
+			s.afterSet(ctx, key, k.value)
+		} else {
+			s.afterDelete(ctx, key)
+		}
+	}
+	return res.Header.Revision, nil
+}
+
+// readTxnKeys reads every key referenced by a transaction, returning the keys in the order they first appear.
+func (s *Server) readTxnKeys(ctx context.Context, req *pb.TxnRequest) ([]string, map[string]*txnKey, error) {
+	keys := []string{}
+	for _, c := range req.Compare {
+		keys = append(keys, c.Key)
+	}
+	for _, op := range req.Success {
+		keys = append(keys, op.Key)
+	}
+	for _, op := range req.Failure {
+		keys = append(keys, op.Key)
+	}
+
+	order := []string{}
+	state := map[string]*txnKey{}
+	for _, key := range keys {
+		if _, ok := state[key]; ok {
+			continue
+		}
+		k, err := s.readTxnKey(ctx, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		order = append(order, key)
+		state[key] = k
+	}
+	return order, state, nil
+}
+
+// readTxnKey reads the state of a single key for a transaction.
+func (s *Server) readTxnKey(ctx context.Context, key string) (*txnKey, error) {
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: util.StringToBytes(key)})
+	if err != nil {
+		return nil, err
+	}
+	k := &txnKey{}
+	if len(res.Kvs) > 0 {
+		k.orig = res.Kvs[0].Value
+		k.value = res.Kvs[0].Value
+		k.exists = true
+		k.modRev = res.Kvs[0].ModRevision
+		k.lease = res.Kvs[0].Lease
+		s.touch(res.Kvs[0].Key)
+	}
+	return k, nil
+}
+
+// txnCompare evaluates a comparison against the state of a key.
+func txnCompare(k *txnKey, c *pb.TxnCompare) (bool, error) {
+	ok := false
+	switch c.Type {
+	case pb.TxnCompare_EXISTS:
+		ok = k.exists
+	case pb.TxnCompare_VALUE_EQUAL:
+		ok = k.exists && bytes.Equal(k.value, c.Value)
+	case pb.TxnCompare_INT_GREATER:
+		if k.exists {
+			iv, err := txnInt(k.value)
+			if err != nil {
+				return false, err
+			}
+			ok = iv.Value > c.Int
+		}
+	case pb.TxnCompare_MOD_REVISION_EQUAL:
+		ok = k.modRev == c.ModRevision
+	case pb.TxnCompare_HASH_FIELD_EQUAL:
+		if k.exists {
+			h, err := txnHash(k.value)
+			if err != nil {
+				return false, err
+			}
+			b, has := h.Value[c.Field]
+			ok = has && bytes.Equal(b, c.Value)
+		}
+	default:
+		return false, util.ErrInvalidTxn
+	}
+	return ok != c.Not, nil
+}
+
+// txnApply applies an operation to the state of a key.
+func txnApply(k *txnKey, op *pb.TxnOp) error {
+	var m proto.Message
+	switch op.Type {
+	case pb.TxnOp_SET:
+		k.value = op.Value
+	case pb.TxnOp_SET_INT:
+		m = &pb.IntValue{Value: op.Int}
+	case pb.TxnOp_INCREMENT_INT:
+		iv := &pb.IntValue{}
+		if k.exists {
+			var err error
+			if iv, err = txnInt(k.value); err != nil {
+				return err
+			}
+		}
+		m = &pb.IntValue{Value: iv.Value + op.Int}
+	case pb.TxnOp_LIST_APPEND:
+		lst := &pb.List{Value: [][]byte{}}
+		if k.exists {
+			var err error
+			if lst, err = txnList(k.value); err != nil {
+				return err
+			}
+		}
+		lst.Value = append(lst.Value, op.Value)
+		enforceListLimit(lst)
+		m = lst
+	case pb.TxnOp_SET_HASH_FIELD:
+		h := &pb.Hash{}
+		if k.exists {
+			var err error
+			if h, err = txnHash(k.value); err != nil {
+				return err
+			}
+		}
+		if h.Value == nil {
+			h.Value = map[string][]byte{}
+		}
+		h.Value[op.Field] = op.Value
+		m = h
+	case pb.TxnOp_DELETE:
+		k.value = nil
+		k.exists = false
+		k.changed = true
+		return nil
+	default:
+		return util.ErrInvalidTxn
+	}
+
+	if m != nil {
+		b, err := proto.Marshal(m)
+		if err != nil {
+			return err
+		}
+		k.value = b
+	}
+	if k.value == nil {
+		k.value = []byte{}
+	}
+	k.exists = true
+	k.changed = true
+	return nil
+}
+
+func validateTxn(req *pb.TxnRequest) error {
+	keys := []string{}
+	for _, c := range req.Compare {
+		keys = append(keys, c.Key)
+	}
+	for _, op := range append(append([]*pb.TxnOp{}, req.Success...), req.Failure...) {
+		keys = append(keys, op.Key)
+	}
+	for _, key := range keys {
+		if len(key) == 0 || key == string(ZeroByte) {
+			return util.ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+func txnModCompare(key []byte, rev int64) *etcdpb.Compare {
+	return &etcdpb.Compare{
+		Key:    key,
+		Target: etcdpb.Compare_MOD,
+		Result: etcdpb.Compare_EQUAL,
+		TargetUnion: &etcdpb.Compare_ModRevision{
+			ModRevision: rev,
+		},
+	}
+}
+
+// modComparesHold returns true if the compares made with txnModCompare still hold, given the responses to ranges over
+// their keys.
+func modComparesHold(compares []*etcdpb.Compare, responses []*etcdpb.ResponseOp) bool {
+	for i, c := range compares {
+		rev := int64(0)
+		if kvs := responses[i].GetResponseRange().Kvs; len(kvs) > 0 {
+			rev = kvs[0].ModRevision
+		}
+		if rev != c.GetModRevision() {
+			return false
+		}
+	}
+	return true
+}
+
+func txnUnmarshal(b []byte, m proto.Message) error {
+	if err := proto.Unmarshal(b, m); err != nil && strings.HasPrefix(err.Error(), "proto: can't skip unknown wire type") {
+		return util.ErrTypeMismatch
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+func txnInt(b []byte) (*pb.IntValue, error) {
+	iv := &pb.IntValue{}
+	return iv, txnUnmarshal(b, iv)
+}
+
+func txnList(b []byte) (*pb.List, error) {
+	lst := &pb.List{}
+	return lst, txnUnmarshal(b, lst)
+}
+
+func txnHash(b []byte) (*pb.Hash, error) {
+	h := &pb.Hash{}
+	return h, txnUnmarshal(b, h)
+}
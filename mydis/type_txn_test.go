@@ -0,0 +1,216 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTxn(t *testing.T) {
+	testReset()
+
+	server.SetInt(ctx, &pb.IntValue{Key: "balance", Value: 100})
+	server.Set(ctx, &pb.ByteValue{Key: "status", Value: []byte("open")})
+
+	req := &pb.TxnRequest{
+		Compare: []*pb.TxnCompare{
+			{Type: pb.TxnCompare_INT_GREATER, Key: "balance", Int: 50},
+			{Type: pb.TxnCompare_VALUE_EQUAL, Key: "status", Value: []byte("open")},
+			{Type: pb.TxnCompare_EXISTS, Key: "missing", Not: true},
+		},
+		Success: []*pb.TxnOp{
+			{Type: pb.TxnOp_INCREMENT_INT, Key: "balance", Int: -50},
+			{Type: pb.TxnOp_INCREMENT_INT, Key: "balance", Int: -1},
+			{Type: pb.TxnOp_LIST_APPEND, Key: "history", Value: []byte("withdraw")},
+			{Type: pb.TxnOp_SET_HASH_FIELD, Key: "account", Field: "last", Value: []byte("withdraw")},
+			{Type: pb.TxnOp_DELETE, Key: "status"},
+		},
+		Failure: []*pb.TxnOp{
+			{Type: pb.TxnOp_SET, Key: "status", Value: []byte("rejected")},
+		},
+	}
+
+	if res, err := server.Txn(ctx, req); err != nil {
+		t.Error(err)
+	} else if !res.Succeeded || len(res.Results) != 5 || res.Revision == 0 {
+		t.Error("Unexpected value:", res)
+	}
+
+	if iv, err := server.GetInt(ctx, &pb.Key{Key: "balance"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != 49 {
+		t.Error("Unexpected value:", iv.Value)
+	}
+	if lst, err := server.GetList(ctx, &pb.Key{Key: "history"}); err != nil {
+		t.Error(err)
+	} else if len(lst.Value) != 1 || string(lst.Value[0]) != "withdraw" {
+		t.Error("Unexpected value:", lst.Value)
+	}
+	if bv, err := server.GetHashField(ctx, &pb.HashField{Key: "account", Field: "last"}); err != nil {
+		t.Error(err)
+	} else if string(bv.Value) != "withdraw" {
+		t.Error("Unexpected value:", string(bv.Value))
+	}
+	if _, err := server.Get(ctx, &pb.Key{Key: "status"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// status no longer exists, so the failure branch runs.
+	if res, err := server.Txn(ctx, req); err != nil {
+		t.Error(err)
+	} else if res.Succeeded || len(res.Results) != 1 {
+		t.Error("Unexpected value:", res)
+	}
+	if bv, err := server.Get(ctx, &pb.Key{Key: "status"}); err != nil {
+		t.Error(err)
+	} else if string(bv.Value) != "rejected" {
+		t.Error("Unexpected value:", string(bv.Value))
+	}
+	if iv, err := server.GetInt(ctx, &pb.Key{Key: "balance"}); err != nil {
+		t.Error(err)
+	} else if iv.Value != 49 {
+		t.Error("Unexpected value:", iv.Value)
+	}
+}
+
+func TestTxnCompares(t *testing.T) {
+	testReset()
+
+	server.SetHashField(ctx, &pb.HashField{Key: "user", Field: "name", Value: []byte("ross")})
+	res, err := server.Txn(ctx, &pb.TxnRequest{
+		Compare: []*pb.TxnCompare{{Type: pb.TxnCompare_HASH_FIELD_EQUAL, Key: "user", Field: "name", Value: []byte("ross")}},
+	})
+	if err != nil {
+		t.Error(err)
+	} else if !res.Succeeded {
+		t.Error("Unexpected value:", res)
+	}
+
+	// the mod revision of the hash is the revision of the transaction that last changed it.
+	res, err = server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET_HASH_FIELD, Key: "user", Field: "name", Value: []byte("bob")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev := res.Revision
+
+	for _, c := range []struct {
+		cmp      *pb.TxnCompare
+		expected bool
+	}{
+		{&pb.TxnCompare{Type: pb.TxnCompare_MOD_REVISION_EQUAL, Key: "user", ModRevision: rev}, true},
+		{&pb.TxnCompare{Type: pb.TxnCompare_MOD_REVISION_EQUAL, Key: "user", ModRevision: rev - 1}, false},
+		{&pb.TxnCompare{Type: pb.TxnCompare_MOD_REVISION_EQUAL, Key: "missing"}, true},
+		{&pb.TxnCompare{Type: pb.TxnCompare_HASH_FIELD_EQUAL, Key: "user", Field: "name", Value: []byte("ross")}, false},
+		{&pb.TxnCompare{Type: pb.TxnCompare_HASH_FIELD_EQUAL, Key: "user", Field: "name", Value: []byte("bob")}, true},
+		{&pb.TxnCompare{Type: pb.TxnCompare_HASH_FIELD_EQUAL, Key: "user", Field: "age", Value: []byte("bob")}, false},
+		{&pb.TxnCompare{Type: pb.TxnCompare_INT_GREATER, Key: "missing", Int: -1}, false},
+		{&pb.TxnCompare{Type: pb.TxnCompare_VALUE_EQUAL, Key: "missing", Not: true}, true},
+	} {
+		if res, err := server.Txn(ctx, &pb.TxnRequest{Compare: []*pb.TxnCompare{c.cmp}}); err != nil {
+			t.Error(err)
+		} else if res.Succeeded != c.expected {
+			t.Error("Unexpected value:", c.cmp, res.Succeeded)
+		}
+	}
+
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_INCREMENT_INT, Key: "key1", Int: 1}},
+	}); err != util.ErrTypeMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET, Key: ""}},
+	}); err != util.ErrInvalidKey {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestTxnLocked(t *testing.T) {
+	testReset()
+
+	server.Lock(ctx, &pb.Key{Key: "locked"})
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{
+			{Type: pb.TxnOp_SET, Key: "unlocked", Value: []byte("a")},
+			{Type: pb.TxnOp_SET, Key: "locked", Value: []byte("b")},
+		},
+	}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	// nothing is applied when any key is locked.
+	if _, err := server.Get(ctx, &pb.Key{Key: "unlocked"}); err != util.ErrKeyNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	server.Unlock(ctx, &pb.Key{Key: "locked"})
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET, Key: "locked", Value: []byte("b")}},
+	}); err != nil {
+		t.Error(err)
+	}
+
+	// the lock's owner can write the key while holding it, and it stays locked.
+	ownerCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "1", "lockowner", "owner1"))
+	otherCtx := metadata.NewContext(ctx, metadata.Pairs("maxlockwait", "0", "lockowner", "owner2"))
+	server.Lock(ownerCtx, &pb.Key{Key: "locked"})
+	defer server.Unlock(ownerCtx, &pb.Key{Key: "locked"})
+	if _, err := server.Txn(ownerCtx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET, Key: "locked", Value: []byte("c")}},
+	}); err != nil {
+		t.Error(err)
+	}
+	if _, err := server.Txn(otherCtx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET, Key: "locked", Value: []byte("d")}},
+	}); err != util.ErrKeyLocked {
+		t.Error("Unexpected or no error:", err)
+	}
+	if locks, err := server.ListLocks(ctx, &pb.Key{Key: "locked"}); err != nil || len(locks.Locks) != 1 {
+		t.Error("Expected key to stay locked:", locks, err)
+	}
+}
+
+func TestTxnFieldIndex(t *testing.T) {
+	testReset()
+
+	server.FieldIndexCreate(ctx, &pb.FieldIndex{Prefix: "txnuser/", Field: "email"})
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_SET_HASH_FIELD, Key: "txnuser/1", Field: "email", Value: []byte("a@example.com")}},
+	}); err != nil {
+		t.Error(err)
+	}
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "txnuser/", Field: "email", Value: []byte("a@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 1 || lst.Keys[0] != "txnuser/1" {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+
+	if _, err := server.Txn(ctx, &pb.TxnRequest{
+		Success: []*pb.TxnOp{{Type: pb.TxnOp_DELETE, Key: "txnuser/1"}},
+	}); err != nil {
+		t.Error(err)
+	}
+	if lst, err := server.FindByField(ctx, &pb.FieldQuery{Prefix: "txnuser/", Field: "email", Value: []byte("a@example.com")}); err != nil {
+		t.Error(err)
+	} else if len(lst.Keys) != 0 {
+		t.Error("Unexpected value:", lst.Keys)
+	}
+}
@@ -0,0 +1,651 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	// vectorMinPerList is the average number of vectors each list must have before the approximate index is trained.
+	vectorMinPerList = 4
+	// vectorTrainIterations is the number of k-means iterations used to train the approximate index.
+	vectorTrainIterations = 10
+)
+
+// vectorWriteBatchSize is the number of vectors moved per transaction when the approximate index is trained.
+var vectorWriteBatchSize = 100
+
+// Vector indexes are stored as a header at the index's key, holding its settings and centroids, while each vector
+// is stored in its own key under the list it belongs to: prefixForVectors + encoded index key + "L" + encoded list +
+// vector ID, so approximate searches only read the lists they probe. Vectors of untrained indexes are all in list 0.
+// The list of each vector is kept at prefixForVectors + encoded index key + "I" + vector ID, to find it by its ID.
+func getVectorPrefix(key string) string {
+	return prefixForVectors + encodeKeyPart(key)
+}
+
+func getVectorName(key, id string) []byte {
+	return util.StringToBytes(getVectorPrefix(key) + "I" + id)
+}
+
+func getVectorListsPrefix(key string) string {
+	return getVectorPrefix(key) + "L"
+}
+
+func getVectorListPrefix(key string, list int32) string {
+	return getVectorListsPrefix(key) + encodeKeyPart(strconv.Itoa(int(list)))
+}
+
+func getVectorListName(key string, list int32, id string) []byte {
+	return util.StringToBytes(getVectorListPrefix(key, list) + id)
+}
+
+// GetVectorIndex gets a vector index from the cache, including every vector in it.
+func (s *Server) GetVectorIndex(ctx context.Context, key *pb.Key) (*pb.VectorIndex, error) {
+	idx, err := s.getVectorHeader(ctx, key.Key)
+	if err != nil {
+		return nil, err
+	}
+	if idx.Entries, err = s.getVectorEntries(ctx, key.Key); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// getVectorHeader gets the settings and centroids of a vector index, without its vectors.
+func (s *Server) getVectorHeader(ctx context.Context, key string) (*pb.VectorIndex, error) {
+	res, err := s.Get(ctx, &pb.Key{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	idx := &pb.VectorIndex{}
+	if err := proto.Unmarshal(res.Value, idx); err != nil && strings.HasPrefix(err.Error(), "proto: can't skip unknown wire type") {
+		return nil, util.ErrTypeMismatch
+	} else if err != nil {
+		return nil, err
+	}
+	idx.Key = key
+	return idx, nil
+}
+
+// getVectorEntries gets every vector in an index.
+func (s *Server) getVectorEntries(ctx context.Context, key string) ([]*pb.VectorEntry, error) {
+	prefix := getVectorListsPrefix(key)
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+		Key:      util.StringToBytes(prefix),
+		RangeEnd: getPrefix(prefix),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vectorEntries(key, res.Kvs, nil)
+}
+
+// getVectorListEntries gets the vectors in the given lists of an index. The lists are read in a single transaction,
+// so a vector moved to another list while they're read isn't missed.
+func (s *Server) getVectorListEntries(ctx context.Context, key string, lists []int32) ([]*pb.VectorEntry, error) {
+	ops := make([]*etcdpb.RequestOp, 0, len(lists))
+	for _, list := range lists {
+		prefix := getVectorListPrefix(key, list)
+		ops = append(ops, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestRange{
+				RequestRange: &etcdpb.RangeRequest{
+					Key:      util.StringToBytes(prefix),
+					RangeEnd: getPrefix(prefix),
+				},
+			},
+		})
+	}
+	res, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{Success: ops})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*pb.VectorEntry{}
+	for _, r := range res.Responses {
+		if entries, err = vectorEntries(key, r.GetResponseRange().Kvs, entries); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// vectorEntries appends the vectors stored in the given keys of an index to entries.
+func vectorEntries(key string, kvs []*mvccpb.KeyValue, entries []*pb.VectorEntry) ([]*pb.VectorEntry, error) {
+	for _, kv := range kvs {
+		entry := &pb.VectorEntry{}
+		if err := proto.Unmarshal(kv.Value, entry); err != nil {
+			return nil, err
+		}
+		entry.Id = strings.TrimPrefix(util.BytesToString(kv.Key), getVectorListPrefix(key, entry.List))
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// getVectorEntry gets a single vector from an index, or nil if the index doesn't have it.
+func (s *Server) getVectorEntry(ctx context.Context, key, id string) (*pb.VectorEntry, error) {
+	for {
+		res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: getVectorName(key, id)})
+		if err != nil {
+			return nil, err
+		} else if len(res.Kvs) == 0 {
+			return nil, nil
+		}
+		entry := &pb.VectorEntry{}
+		if err := proto.Unmarshal(res.Kvs[0].Value, entry); err != nil {
+			return nil, err
+		}
+
+		// the vector is read at the same revision as its list, in case it's moved to another list in the meantime.
+		// if that revision has been compacted already, the list is read again.
+		vres, err := s.storage.Range(ctx, &etcdpb.RangeRequest{
+			Key:      getVectorListName(key, entry.List, id),
+			Revision: res.Header.Revision,
+		})
+		if err == mvcc.ErrCompacted {
+			continue
+		} else if err != nil {
+			return nil, err
+		} else if len(vres.Kvs) == 0 {
+			return nil, nil
+		}
+		if err := proto.Unmarshal(vres.Kvs[0].Value, entry); err != nil {
+			return nil, err
+		}
+		entry.Id = id
+		return entry, nil
+	}
+}
+
+// VectorIndexCreate creates a vector index. If Dimensions is zero, it's taken from the first vector added. If Lists
+// is greater than zero, an approximate index is kept that clusters the vectors into the given number of lists, once
+// there are enough vectors to train it. If the index already exists, ErrVectorIndexExists is returned.
+func (s *Server) VectorIndexCreate(ctx context.Context, vi *pb.VectorIndex) (*pb.Null, error) {
+	if vi.Dimensions < 0 || vi.Lists < 0 {
+		return null, util.ErrInvalidVectorIndex
+	}
+
+	key := &pb.Key{Key: vi.Key}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return null, err
+	}
+
+	if _, err := s.getVectorHeader(ctx, vi.Key); err == nil {
+		s.Unlock(ctx, key)
+		return null, util.ErrVectorIndexExists
+	} else if err != util.ErrKeyNotFound {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+
+	idx := &pb.VectorIndex{Key: vi.Key, Dimensions: vi.Dimensions, Metric: vi.Metric, Lists: vi.Lists}
+	return s.unlockThenSetVectorIndex(ctx, idx, []*etcdpb.RequestOp{clearVectorsOp(vi.Key)})
+}
+
+// GetVector gets a single vector from an index.
+func (s *Server) GetVector(ctx context.Context, item *pb.VectorItem) (*pb.VectorItem, error) {
+	entry, err := s.getVectorEntry(ctx, item.Key, item.Id)
+	if err != nil {
+		return nil, err
+	} else if entry == nil {
+		// the header is checked to tell a missing vector from a missing index.
+		if _, err := s.getVectorHeader(ctx, item.Key); err != nil {
+			return nil, err
+		}
+		return nil, util.ErrVectorNotFound
+	}
+	return &pb.VectorItem{Key: item.Key, Id: entry.Id, Values: entry.Values, HashKey: entry.HashKey}, nil
+}
+
+// VectorAdd adds or replaces a vector in an index, creates new index using the cosine metric if doesn't exist.
+// HashKey optionally attaches a hash to the vector, whose fields can be used to filter search results.
+func (s *Server) VectorAdd(ctx context.Context, item *pb.VectorItem) (*pb.Null, error) {
+	if len(item.Values) == 0 {
+		return null, util.ErrVectorDimensionMismatch
+	}
+
+	key := &pb.Key{Key: item.Key}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return null, err
+	}
+
+	ops := []*etcdpb.RequestOp{}
+	idx, err := s.getVectorHeader(ctx, item.Key)
+	if err == util.ErrKeyNotFound {
+		idx = &pb.VectorIndex{Key: item.Key}
+		ops = append(ops, clearVectorsOp(item.Key))
+	} else if err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+
+	if idx.Dimensions == 0 {
+		idx.Dimensions = int32(len(item.Values))
+	} else if int(idx.Dimensions) != len(item.Values) {
+		s.Unlock(ctx, key)
+		return null, util.ErrVectorDimensionMismatch
+	}
+
+	var existing *pb.VectorEntry
+	if len(ops) == 0 {
+		if existing, err = s.getVectorEntry(ctx, item.Key, item.Id); err != nil {
+			s.Unlock(ctx, key)
+			return null, err
+		} else if existing == nil {
+			idx.Size++
+		}
+	} else {
+		idx.Size = 1
+	}
+
+	entry := &pb.VectorEntry{Id: item.Id, Values: item.Values, HashKey: item.HashKey}
+	if needsVectorTraining(idx) {
+		if ok, err := s.retrainVectorIndex(ctx, idx, entry); err != nil {
+			s.Unlock(ctx, key)
+			return null, err
+		} else if !ok {
+			// the index was replaced or expired while it was being trained, so the vector is added to what's
+			// there now.
+			s.Unlock(ctx, key)
+			return s.VectorAdd(ctx, item)
+		}
+	} else if len(idx.Centroids) > 0 {
+		entry.List = int32(nearestCentroid(idx.Metric, idx.Centroids, entry.Values))
+	}
+	return s.unlockThenSetVectorIndex(ctx, idx, append(ops, putVectorOps(item.Key, entry, existing)...))
+}
+
+// retrainVectorIndex trains the approximate index of a locked vector index with the given vector added to it, and
+// moves the other vectors whose list changed. The moves are written in batches that each fit in a request, before
+// the header with the new centroids, and each batch is only written if the header hasn't changed since it was read.
+// If it has, false is returned and the header has to be read again.
+func (s *Server) retrainVectorIndex(ctx context.Context, idx *pb.VectorIndex, entry *pb.VectorEntry) (bool, error) {
+	bkey := util.StringToBytes(idx.Key)
+	res, err := s.storage.Range(ctx, &etcdpb.RangeRequest{Key: bkey})
+	if err != nil {
+		return false, err
+	}
+	rev := int64(0)
+	if len(res.Kvs) > 0 {
+		rev = res.Kvs[0].ModRevision
+	}
+
+	entries, err := s.getVectorEntries(ctx, idx.Key)
+	if err != nil {
+		return false, err
+	}
+	lists := make(map[string]int32, len(entries))
+	for _, e := range entries {
+		lists[e.Id] = e.List
+	}
+	entries = replaceVectorEntry(entries, entry)
+	trainVectorIndex(idx, entries)
+
+	batch := []*etcdpb.RequestOp{}
+	moved := 0
+	for i, e := range entries {
+		if list, ok := lists[e.Id]; ok && e != entry && list != e.List {
+			batch = append(batch, putVectorOps(idx.Key, e, &pb.VectorEntry{Id: e.Id, List: list})...)
+			moved++
+		}
+		if moved == 0 || (moved < vectorWriteBatchSize && i < len(entries)-1) {
+			continue
+		}
+		txn, err := s.storage.Txn(ctx, &etcdpb.TxnRequest{
+			Compare: []*etcdpb.Compare{txnModCompare(bkey, rev)},
+			Success: batch,
+		})
+		if err != nil {
+			return false, err
+		} else if !txn.Succeeded {
+			return false, nil
+		}
+		batch = []*etcdpb.RequestOp{}
+		moved = 0
+	}
+	return true, nil
+}
+
+// VectorDelete deletes a vector from an index.
+func (s *Server) VectorDelete(ctx context.Context, item *pb.VectorItem) (*pb.Null, error) {
+	key := &pb.Key{Key: item.Key}
+	if _, err := s.Lock(ctx, key); err != nil {
+		return null, err
+	}
+
+	idx, err := s.getVectorHeader(ctx, item.Key)
+	if err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	}
+	entry, err := s.getVectorEntry(ctx, item.Key, item.Id)
+	if err != nil {
+		s.Unlock(ctx, key)
+		return null, err
+	} else if entry == nil {
+		s.Unlock(ctx, key)
+		return null, util.ErrVectorNotFound
+	}
+
+	idx.Size--
+	return s.unlockThenSetVectorIndex(ctx, idx, []*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: getVectorName(item.Key, item.Id),
+				},
+			},
+		},
+		{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: getVectorListName(item.Key, entry.List, item.Id),
+				},
+			},
+		},
+	})
+}
+
+// VectorSearch gets the K nearest neighbours of the given vector, best match first. A K of zero returns all matches.
+// For the cosine and dot product metrics, the score is the similarity, for L2 it's the distance. If Approximate is
+// set and the index has been trained, only the vectors in the Probes lists nearest to the query are searched.
+// If Filter is given, only vectors whose attached hash has all of the given field values are returned.
+func (s *Server) VectorSearch(ctx context.Context, q *pb.VectorQuery) (*pb.VectorMatches, error) {
+	if q.K < 0 || q.Probes < 0 {
+		return nil, util.ErrInvalidVectorIndex
+	}
+
+	idx, err := s.getVectorHeader(ctx, q.Key)
+	if err != nil {
+		return nil, err
+	}
+	if int(idx.Dimensions) != len(q.Values) {
+		return nil, util.ErrVectorDimensionMismatch
+	}
+
+	// approximate searches only read the vectors in the lists they probe.
+	var lists []int32
+	if q.Approximate {
+		lists = probeVectorIndex(idx, q.Values, int(q.Probes))
+	}
+	var candidates []*pb.VectorEntry
+	if lists != nil {
+		candidates, err = s.getVectorListEntries(ctx, q.Key, lists)
+	} else {
+		candidates, err = s.getVectorEntries(ctx, q.Key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type scored struct {
+		entry    *pb.VectorEntry
+		distance float64
+	}
+	results := make([]scored, len(candidates))
+	for i, entry := range candidates {
+		results[i] = scored{entry: entry, distance: vectorDistance(idx.Metric, q.Values, entry.Values)}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].distance == results[j].distance {
+			return results[i].entry.Id < results[j].entry.Id
+		}
+		return results[i].distance < results[j].distance
+	})
+
+	matches := &pb.VectorMatches{Matches: []*pb.VectorMatch{}}
+	for _, res := range results {
+		if q.K > 0 && len(matches.Matches) >= int(q.K) {
+			break
+		}
+		if len(q.Filter) > 0 {
+			ok, err := s.vectorFilterMatches(ctx, res.entry.HashKey, q.Filter)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+		}
+		matches.Matches = append(matches.Matches, &pb.VectorMatch{
+			Id:      res.entry.Id,
+			Score:   vectorScore(idx.Metric, q.Values, res.entry.Values),
+			HashKey: res.entry.HashKey,
+		})
+	}
+	return matches, nil
+}
+
+// unlockThenSetVectorIndex sets the header of a vector index, running the given operations on its vectors in the
+// same transaction.
+func (s *Server) unlockThenSetVectorIndex(ctx context.Context, idx *pb.VectorIndex, ops []*etcdpb.RequestOp) (*pb.Null, error) {
+	header := *idx
+	header.Key = ""
+	header.Entries = nil
+	b, err := proto.Marshal(&header)
+	if err != nil {
+		s.Unlock(ctx, &pb.Key{Key: idx.Key})
+		return null, err
+	}
+	return s.unlockThenSetWithOps(ctx, &pb.ByteValue{Key: idx.Key, Value: b}, ops)
+}
+
+// putVectorOps returns the operations that store a vector in the key for its list, along with its list, and delete it
+// from the list it was in before, if any. The ID is the key's name, so it isn't stored in the value.
+func putVectorOps(key string, entry, old *pb.VectorEntry) []*etcdpb.RequestOp {
+	b, _ := proto.Marshal(&pb.VectorEntry{Values: entry.Values, HashKey: entry.HashKey, List: entry.List})
+	lb, _ := proto.Marshal(&pb.VectorEntry{List: entry.List})
+	ops := []*etcdpb.RequestOp{
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   getVectorListName(key, entry.List, entry.Id),
+					Value: b,
+				},
+			},
+		},
+		{
+			Request: &etcdpb.RequestOp_RequestPut{
+				RequestPut: &etcdpb.PutRequest{
+					Key:   getVectorName(key, entry.Id),
+					Value: lb,
+				},
+			},
+		},
+	}
+	if old != nil && old.List != entry.List {
+		ops = append(ops, &etcdpb.RequestOp{
+			Request: &etcdpb.RequestOp_RequestDeleteRange{
+				RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+					Key: getVectorListName(key, old.List, entry.Id),
+				},
+			},
+		})
+	}
+	return ops
+}
+
+// clearVectorsOp returns the operation that deletes every vector of an index. New indexes are created with it, in
+// case vectors were left behind by an index that was overwritten or expired.
+func clearVectorsOp(key string) *etcdpb.RequestOp {
+	prefix := getVectorPrefix(key)
+	return &etcdpb.RequestOp{
+		Request: &etcdpb.RequestOp_RequestDeleteRange{
+			RequestDeleteRange: &etcdpb.DeleteRangeRequest{
+				Key:      util.StringToBytes(prefix),
+				RangeEnd: getPrefix(prefix),
+			},
+		},
+	}
+}
+
+// vectorFilterMatches determines if the hash with the given key has all of the fields in the filter.
+func (s *Server) vectorFilterMatches(ctx context.Context, hashKey string, filter map[string][]byte) (bool, error) {
+	if len(hashKey) == 0 {
+		return false, nil
+	}
+
+	h, err := s.GetHash(ctx, &pb.Key{Key: hashKey})
+	if err == util.ErrKeyNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	for field, val := range filter {
+		if v, ok := h.Value[field]; !ok || !bytes.Equal(v, val) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// replaceVectorEntry replaces the vector with the same ID as the given one, or adds it if there isn't one.
+func replaceVectorEntry(entries []*pb.VectorEntry, entry *pb.VectorEntry) []*pb.VectorEntry {
+	for i, e := range entries {
+		if e.Id == entry.Id {
+			entries[i] = entry
+			return entries
+		}
+	}
+	return append(entries, entry)
+}
+
+// probeVectorIndex returns the given number of lists nearest to the query, defaulting to one list, or nil if the
+// index isn't trained or every list would be probed.
+func probeVectorIndex(idx *pb.VectorIndex, values []float32, probes int) []int32 {
+	if probes == 0 {
+		probes = 1
+	}
+	if len(idx.Centroids) == 0 || probes >= len(idx.Centroids) {
+		return nil
+	}
+
+	lists := make([]int32, len(idx.Centroids))
+	distances := make([]float64, len(idx.Centroids))
+	for i, centroid := range idx.Centroids {
+		lists[i] = int32(i)
+		distances[i] = vectorDistance(idx.Metric, values, centroid.Values)
+	}
+	sort.Slice(lists, func(i, j int) bool {
+		return distances[lists[i]] < distances[lists[j]]
+	})
+	return lists[:probes]
+}
+
+// needsVectorTraining returns true if the approximate index should be trained, which is when there are enough
+// vectors, and again each time the index doubles in size.
+func needsVectorTraining(idx *pb.VectorIndex) bool {
+	k := int64(idx.Lists)
+	return k > 0 && idx.Size >= k*vectorMinPerList && (idx.TrainedSize == 0 || idx.Size >= idx.TrainedSize*2)
+}
+
+// trainVectorIndex clusters the vectors into lists using k-means, setting the list of each vector.
+func trainVectorIndex(idx *pb.VectorIndex, entries []*pb.VectorEntry) {
+	k := int(idx.Lists)
+	n := len(entries)
+
+	// start with evenly spaced vectors so training is deterministic.
+	centroids := make([]*pb.Vector, k)
+	for i := range centroids {
+		centroids[i] = &pb.Vector{Values: append([]float32{}, entries[i*n/k].Values...)}
+	}
+
+	for iter := 0; iter < vectorTrainIterations; iter++ {
+		sums := make([][]float64, k)
+		counts := make([]int, k)
+		for _, entry := range entries {
+			list := nearestCentroid(idx.Metric, centroids, entry.Values)
+			entry.List = int32(list)
+			if sums[list] == nil {
+				sums[list] = make([]float64, idx.Dimensions)
+			}
+			for d, v := range entry.Values {
+				sums[list][d] += float64(v)
+			}
+			counts[list]++
+		}
+
+		for i := range centroids {
+			// empty lists keep their previous centroid.
+			if counts[i] == 0 {
+				continue
+			}
+			for d := range centroids[i].Values {
+				centroids[i].Values[d] = float32(sums[i][d] / float64(counts[i]))
+			}
+		}
+	}
+
+	for _, entry := range entries {
+		entry.List = int32(nearestCentroid(idx.Metric, centroids, entry.Values))
+	}
+	idx.Centroids = centroids
+	idx.TrainedSize = int64(n)
+}
+
+func nearestCentroid(metric pb.VectorMetric, centroids []*pb.Vector, values []float32) int {
+	nearest := 0
+	best := math.Inf(1)
+	for i, centroid := range centroids {
+		if d := vectorDistance(metric, values, centroid.Values); d < best {
+			best = d
+			nearest = i
+		}
+	}
+	return nearest
+}
+
+// vectorDistance returns a value where lower means closer, regardless of metric.
+func vectorDistance(metric pb.VectorMetric, a, b []float32) float64 {
+	if metric == pb.VectorMetric_L2 {
+		return vectorScore(metric, a, b)
+	}
+	return -vectorScore(metric, a, b)
+}
+
+func vectorScore(metric pb.VectorMetric, a, b []float32) float64 {
+	dot, normA, normB, sq := float64(0), float64(0), float64(0), float64(0)
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		dot += x * y
+		normA += x * x
+		normB += y * y
+		sq += (x - y) * (x - y)
+	}
+
+	switch metric {
+	case pb.VectorMetric_DOT:
+		return dot
+	case pb.VectorMetric_L2:
+		return math.Sqrt(sq)
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,235 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"testing"
+
+	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"github.com/gogo/protobuf/proto"
+)
+
+func TestVectorIndexCreate(t *testing.T) {
+	testReset()
+
+	if _, err := server.VectorIndexCreate(ctx, &pb.VectorIndex{Key: "vec1", Dimensions: 2, Metric: pb.VectorMetric_L2, Lists: 2}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.VectorIndexCreate(ctx, &pb.VectorIndex{Key: "vec2", Lists: -1}); err != util.ErrInvalidVectorIndex {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestVectorAdd(t *testing.T) {
+	// two clusters, one near (0, 0) and one near (10, 10).
+	for i, suffix := range []string{"a", "b", "c", "d"} {
+		f := float32(i) / 10
+		if _, err := server.VectorAdd(ctx, &pb.VectorItem{Key: "vec1", Id: "low" + suffix, Values: []float32{f, f}, HashKey: "vecmeta_low"}); err != nil {
+			t.Error(err)
+		}
+		if _, err := server.VectorAdd(ctx, &pb.VectorItem{Key: "vec1", Id: "high" + suffix, Values: []float32{10 + f, 10 + f}, HashKey: "vecmeta_high"}); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if _, err := server.VectorAdd(ctx, &pb.VectorItem{Key: "vec1", Id: "bad", Values: []float32{1, 2, 3}}); err != util.ErrVectorDimensionMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if idx, err := server.GetVectorIndex(ctx, &pb.Key{Key: "vec1"}); err != nil {
+		t.Error(err)
+	} else if len(idx.Entries) != 8 || len(idx.Centroids) != 2 || idx.TrainedSize != 8 {
+		t.Error("Unexpected value:", idx)
+	}
+
+	if v, err := server.GetVector(ctx, &pb.VectorItem{Key: "vec1", Id: "higha"}); err != nil {
+		t.Error(err)
+	} else if len(v.Values) != 2 || v.Values[0] != 10 || v.HashKey != "vecmeta_high" {
+		t.Error("Unexpected value:", v)
+	}
+}
+
+func TestVectorSearch(t *testing.T) {
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{9, 9}, K: 2}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 2 || res.Matches[0].Id != "higha" || res.Matches[1].Id != "highb" {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	// only the list nearest to the query is searched.
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{4, 4}, Approximate: true}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 4 || res.Matches[0].Id != "lowd" {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{4, 4}, Approximate: true, Probes: 2}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 8 {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	if _, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{4}}); err != util.ErrVectorDimensionMismatch {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestVectorSearchFilter(t *testing.T) {
+	server.SetHash(ctx, &pb.Hash{Key: "vecmeta_low", Value: map[string][]byte{"group": []byte("low")}})
+	server.SetHash(ctx, &pb.Hash{Key: "vecmeta_high", Value: map[string][]byte{"group": []byte("high")}})
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{0, 0}, K: 1, Filter: map[string][]byte{"group": []byte("high")}}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 1 || res.Matches[0].Id != "higha" {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec1", Values: []float32{0, 0}, Filter: map[string][]byte{"group": []byte("none")}}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 0 {
+		t.Error("Unexpected value:", res.Matches)
+	}
+}
+
+func TestVectorMetrics(t *testing.T) {
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec2", Id: "x", Values: []float32{1, 0}})
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec2", Id: "y", Values: []float32{0, 3}})
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec2", Id: "xy", Values: []float32{2, 2}})
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec2", Values: []float32{1, 1}}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 3 || res.Matches[0].Id != "xy" || res.Matches[0].Score < 0.999 {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	// existing indexes keep their settings.
+	if _, err := server.VectorIndexCreate(ctx, &pb.VectorIndex{Key: "vec2", Metric: pb.VectorMetric_DOT}); err != util.ErrVectorIndexExists {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if _, err := server.VectorIndexCreate(ctx, &pb.VectorIndex{Key: "vec3", Metric: pb.VectorMetric_DOT}); err != nil {
+		t.Error(err)
+	}
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec3", Id: "x", Values: []float32{1, 0}})
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec3", Id: "y", Values: []float32{0, 3}})
+	server.VectorAdd(ctx, &pb.VectorItem{Key: "vec3", Id: "xy", Values: []float32{2, 2}})
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec3", Values: []float32{0, 1}, K: 1}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 1 || res.Matches[0].Id != "y" || res.Matches[0].Score != 3 {
+		t.Error("Unexpected value:", res.Matches)
+	}
+}
+
+func TestVectorDelete(t *testing.T) {
+	if _, err := server.VectorDelete(ctx, &pb.VectorItem{Key: "vec1", Id: "higha"}); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := server.GetVector(ctx, &pb.VectorItem{Key: "vec1", Id: "higha"}); err != util.ErrVectorNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+
+	if _, err := server.VectorDelete(ctx, &pb.VectorItem{Key: "vec1", Id: "higha"}); err != util.ErrVectorNotFound {
+		t.Error("Unexpected or no error:", err)
+	}
+}
+
+func TestVectorStorage(t *testing.T) {
+	// each vector is stored in its own key, so the index's key only holds its settings.
+	if res, err := server.Get(ctx, &pb.Key{Key: "vec1"}); err != nil {
+		t.Fatal(err)
+	} else if idx := (&pb.VectorIndex{}); proto.Unmarshal(res.Value, idx) != nil || len(idx.Entries) != 0 || idx.Size != 7 {
+		t.Error("Unexpected header:", idx)
+	}
+	if idx, err := server.GetVectorIndex(ctx, &pb.Key{Key: "vec1"}); err != nil {
+		t.Error(err)
+	} else if len(idx.Entries) != 7 {
+		t.Error("Unexpected value:", idx.Entries)
+	}
+
+	// vectors are deleted with their index, and aren't brought back by a new index with the same key.
+	if _, err := server.Delete(ctx, &pb.Key{Key: "vec1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := server.VectorAdd(ctx, &pb.VectorItem{Key: "vec1", Id: "new", Values: []float32{1, 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if idx, err := server.GetVectorIndex(ctx, &pb.Key{Key: "vec1"}); err != nil {
+		t.Error(err)
+	} else if len(idx.Entries) != 1 || idx.Size != 1 || idx.Entries[0].Id != "new" {
+		t.Error("Unexpected value:", idx.Entries)
+	}
+}
+
+func TestVectorLists(t *testing.T) {
+	testReset()
+
+	// training moves vectors in batches, which are kept small so the moves can take several transactions.
+	batchSize := vectorWriteBatchSize
+	vectorWriteBatchSize = 2
+	defer func() { vectorWriteBatchSize = batchSize }()
+
+	server.VectorIndexCreate(ctx, &pb.VectorIndex{Key: "vec4", Dimensions: 1, Metric: pb.VectorMetric_L2, Lists: 2})
+	for i := 0; i < 16; i++ {
+		f := float32(i % 2 * 10)
+		if _, err := server.VectorAdd(ctx, &pb.VectorItem{Key: "vec4", Id: string(rune('a' + i)), Values: []float32{f + float32(i)/100}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	idx, err := server.GetVectorIndex(ctx, &pb.Key{Key: "vec4"})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(idx.Entries) != 16 || idx.TrainedSize != 16 {
+		t.Fatal("Unexpected value:", idx)
+	}
+
+	// each vector is in the list of its nearest centroid, and only in that list.
+	for _, entry := range idx.Entries {
+		if list := int32(nearestCentroid(idx.Metric, idx.Centroids, entry.Values)); entry.List != list {
+			t.Error("Unexpected list:", entry)
+		}
+		if v, err := server.getVectorEntry(ctx, "vec4", entry.Id); err != nil {
+			t.Error(err)
+		} else if v == nil || v.List != entry.List {
+			t.Error("Unexpected value:", v)
+		}
+	}
+	for list := int32(0); list < 2; list++ {
+		if entries, err := server.getVectorListEntries(ctx, "vec4", []int32{list}); err != nil {
+			t.Error(err)
+		} else if len(entries) != 8 {
+			t.Error("Unexpected value:", entries)
+		}
+	}
+
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec4", Values: []float32{10}, Approximate: true}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 8 || res.Matches[0].Id != "b" {
+		t.Error("Unexpected value:", res.Matches)
+	}
+
+	if _, err := server.VectorDelete(ctx, &pb.VectorItem{Key: "vec4", Id: "b"}); err != nil {
+		t.Error(err)
+	}
+	if res, err := server.VectorSearch(ctx, &pb.VectorQuery{Key: "vec4", Values: []float32{10}, Approximate: true}); err != nil {
+		t.Error(err)
+	} else if len(res.Matches) != 7 || res.Matches[0].Id != "d" {
+		t.Error("Unexpected value:", res.Matches)
+	}
+}
@@ -29,7 +29,24 @@ import (
 
 var null = &pb.Null{}
 var suffixForKeysUsingPrefix = "*_MYDIS_WITHPREFIX"
-var suffixForLocks = "*_MYDIS_LOCK"
+
+// internal keys are kept in a reserved namespace of keys starting with a zero byte, which sorts before every user
+// key, so it can be left out of range reads and watches.
+var prefixForInternal = "\x00_MYDIS_"
+var prefixForLocks = prefixForInternal + "LOCK\x00"
+var prefixForExpirations = prefixForInternal + "EXPIRE\x00"
+var prefixForLeases = prefixForInternal + "LEASE\x00"
+var searchIndexRegistry = prefixForInternal + "SEARCHINDEXES"
+var fieldIndexRegistry = prefixForInternal + "FIELDINDEXES"
+var prefixForFieldIndexes = prefixForInternal + "FIELDINDEX\x00"
+var prefixForSearch = prefixForInternal + "SEARCH\x00"
+var prefixForScripts = prefixForInternal + "SCRIPT\x00"
+var prefixForVectors = prefixForInternal + "VECTOR\x00"
+var prefixForEvictions = prefixForInternal + "EVICT\x00"
+var prefixForPubSub = prefixForInternal + "PUBSUB\x00"
+
+// firstUserKey is the lowest key outside of the reserved namespace.
+var firstUserKey = []byte{1}
 
 // ZeroByte represents a single zero byte in a byte slice.
 var ZeroByte = []byte{0}
@@ -57,7 +74,53 @@ func CopyConfig(config *embed.Config) *embed.Config {
 }
 
 func getLockName(key string) []byte {
-	return util.StringToBytes(key + suffixForLocks)
+	return util.StringToBytes(prefixForLocks + key)
+}
+
+func getExpirationName(key string) []byte {
+	return util.StringToBytes(prefixForExpirations + key)
+}
+
+func getEvictionName(key string) []byte {
+	return util.StringToBytes(prefixForEvictions + key)
+}
+
+// encodeKeyPart encodes part of an internal key name so that it ends where the next part starts, even if it contains
+// zero bytes, and sorts the same way the part does. Zero bytes are escaped as \x00\xff, and the part ends with \x00\x01.
+func encodeKeyPart(part string) string {
+	return strings.Replace(part, "\x00", "\x00\xff", -1) + "\x00\x01"
+}
+
+// decodeKeyPart decodes the first part of an internal key name encoded with encodeKeyPart, returning the part and
+// the rest of the name, or false if the name doesn't have an encoded part.
+func decodeKeyPart(name string) (string, string, bool) {
+	for i := 0; i+1 < len(name); i++ {
+		if name[i] != 0 {
+			continue
+		}
+		if name[i+1] == 1 {
+			return strings.Replace(name[:i], "\x00\xff", "\x00", -1), name[i+2:], true
+		}
+		i++
+	}
+	return "", "", false
+}
+
+// isInternalKey determines if the key is in the reserved namespace.
+func isInternalKey(key string) bool {
+	return strings.HasPrefix(key, "\x00")
+}
+
+// getUserRange returns the range of user keys with the given prefix, leaving out the reserved namespace.
+// If the prefix is in the reserved namespace, false is returned.
+func getUserRange(prefix string) (key []byte, rangeEnd []byte, ok bool) {
+	if len(prefix) == 0 {
+		return firstUserKey, ZeroByte, true
+	}
+	if isInternalKey(prefix) {
+		return nil, nil, false
+	}
+	return util.StringToBytes(prefix), getPrefix(prefix), true
 }
 
 func kvsToList(kvs []*mvccpb.KeyValue) *pb.KeysList {
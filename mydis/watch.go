@@ -15,86 +15,392 @@
 package mydis
 
 import (
+	"io"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/coreos/etcd/etcdserver"
 	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/deejross/mydis/pb"
 	"github.com/deejross/mydis/util"
 )
 
+// watchBufferSize is the number of events that can be waiting to be sent on a watch stream. A stream that falls this
+// far behind is a slow consumer, and is disconnected so it doesn't hold up other streams. The client resumes its
+// watches from the last revision it got when it reconnects, so no events are lost.
+var watchBufferSize = 1024
+
+// watchProgressInterval is how often every watch is sent the current revision, so it can be resumed from there even
+// if it hasn't had any events.
+var watchProgressInterval = time.Minute
+
 // WatchController object.
 type WatchController struct {
 	closeCh  chan struct{}
-	server   *etcdserver.EtcdServer
+	closed   bool
+	storage  Storage
+	stream   mvcc.WatchStream
+	feeds    map[string]*watchFeed
+	feedIDs  map[mvcc.WatchID]*watchFeed
 	watchers map[int64]*Watcher
 	nextID   int64
-	lock     sync.RWMutex
+	lock     sync.Mutex
+
+	// the names of the named leases at a revision, by lease, which every delete in a revoked lease looks up.
+	leaseNames    map[int64]*mvccpb.KeyValue
+	leaseNamesRev int64
+}
+
+// watchFeed is an etcd watch, which is shared by every watch for new events on the same keys.
+type watchFeed struct {
+	id      mvcc.WatchID
+	hash    string
+	key     string
+	prefix  bool
+	locks   bool
+	watches map[*watch]struct{}
+	// the revision the etcd watch has sent every change up to.
+	rev int64
+}
+
+// watch is a watch request on a Watcher, and the etcd watches its events come from.
+type watch struct {
+	watcher  *Watcher
+	request  *pb.WatchRequest
+	feed     *watchFeed
+	lockFeed *watchFeed
 }
 
 // NewWatchController returns a new WatchController object.
-func NewWatchController(server *etcdserver.EtcdServer) *WatchController {
+func NewWatchController(storage Storage) *WatchController {
 	wc := &WatchController{
 		closeCh:  make(chan struct{}),
-		server:   server,
+		storage:  storage,
+		stream:   storage.NewWatchStream(),
+		feeds:    map[string]*watchFeed{},
+		feedIDs:  map[mvcc.WatchID]*watchFeed{},
 		watchers: map[int64]*Watcher{},
 	}
+	go wc.backgroundProcess()
 	return wc
 }
 
 // NewWatcher returns a new Watcher object.
 func (w *WatchController) NewWatcher() *Watcher {
 	watcher := &Watcher{
-		closeCh:    make(chan struct{}),
-		reqCh:      make(chan *pb.WatchRequest),
-		resCh:      make(chan struct{}),
-		eventCh:    make(chan *pb.Event),
 		controller: w,
-		watching:   map[string]*pb.WatchRequest{},
+		events:     make(chan *pb.Event, watchBufferSize),
+		done:       make(chan struct{}),
+		watches:    map[string]*watch{},
 	}
 
 	w.lock.Lock()
-	w.watchers[w.nextID] = watcher
+	if w.closed {
+		watcher.stop(nil)
+	} else {
+		watcher.id = w.nextID
+		w.watchers[w.nextID] = watcher
+		w.nextID++
+	}
+	w.lock.Unlock()
 
-	go func(id int64) {
-		watcher.backgroundProcess()
-		w.lock.Lock()
-		delete(w.watchers, id)
-		w.lock.Unlock()
-	}(w.nextID)
+	return watcher
+}
 
-	w.nextID++
+// RequestProgress sends the current revision to every watch that has been sent all of its events.
+func (w *WatchController) RequestProgress() {
+	w.lock.Lock()
+	for id, feed := range w.feedIDs {
+		if !feed.locks {
+			w.stream.RequestProgress(id)
+		}
+	}
 	w.lock.Unlock()
+}
 
-	return watcher
+// caughtUp returns true once every etcd watch that looks up why keys were deleted has sent its events up to the
+// given revision, after which the revisions before it can be compacted.
+func (w *WatchController) caughtUp(rev int64) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, feed := range w.feedIDs {
+		if !feed.locks && feed.rev < rev {
+			return false
+		}
+	}
+	return true
 }
 
-// Close the WatchController.
+// Close the WatchController, ending every Watcher.
 func (w *WatchController) Close() {
-	watchers := []*Watcher{}
-
-	// get list of watchers then loop through and Close to avoid deadlock.
-	w.lock.RLock()
+	w.lock.Lock()
+	if w.closed {
+		w.lock.Unlock()
+		return
+	}
+	w.closed = true
 	for _, watcher := range w.watchers {
-		watchers = append(watchers, watcher)
+		watcher.stop(nil)
+	}
+	w.lock.Unlock()
+
+	close(w.closeCh)
+	w.stream.Close()
+}
+
+func (w *WatchController) backgroundProcess() {
+	ticker := time.NewTicker(watchProgressInterval)
+	defer ticker.Stop()
+	streamCh := w.stream.Chan()
+
+	for {
+		select {
+		case <-w.closeCh:
+			return
+		case <-ticker.C:
+			w.RequestProgress()
+		case r, ok := <-streamCh:
+			if !ok {
+				return
+			}
+			w.lock.Lock()
+			w.process(r)
+			w.lock.Unlock()
+		}
+	}
+}
+
+// process sends the events of an etcd watch to the watches sharing it.
+func (w *WatchController) process(r mvcc.WatchResponse) {
+	feed, ok := w.feedIDs[r.WatchID]
+	if !ok {
+		// the etcd watch was canceled after the events were sent.
+		return
+	}
+
+	if r.CompactRevision != 0 {
+		// the revision the etcd watch started from has been compacted, so the events since then are gone. It carries
+		// on from the current revision, and the watches are told to resync.
+		rev := w.storage.Rev()
+		delete(w.feedIDs, feed.id)
+		feed.id = w.watch(feed, rev+1)
+		feed.rev = rev
+		w.feedIDs[feed.id] = feed
+
+		if !feed.locks {
+			for wa := range feed.watches {
+				wa.send(&pb.Event{
+					Type:     pb.Event_COMPACTED,
+					Current:  &pb.ByteValue{Key: wa.request.Key},
+					Previous: &pb.ByteValue{},
+					Rev:      rev,
+				})
+			}
+		}
+		return
+	}
+
+	defer func() {
+		if r.Revision > feed.rev {
+			feed.rev = r.Revision
+		}
+	}()
+
+	if len(r.Events) == 0 {
+		// the etcd watch has sent every event up to the revision.
+		if !feed.locks {
+			for wa := range feed.watches {
+				wa.send(&pb.Event{Type: pb.Event_PROGRESS, Current: &pb.ByteValue{}, Previous: &pb.ByteValue{}, Rev: r.Revision})
+			}
+		}
+		return
 	}
-	w.lock.RUnlock()
 
-	for _, watcher := range watchers {
-		watcher.Close()
+	for _, e := range r.Events {
+		key := util.BytesToString(e.Kv.Key)
+		reason := pb.Event_WRITTEN
+		var prev *mvccpb.KeyValue
+
+		if isInternalKey(key) {
+			// internal keys are never sent to watchers, but a released lock is sent as an event on its key.
+			if !feed.locks || e.Type != mvccpb.DELETE || !strings.HasPrefix(key, prefixForLocks) {
+				continue
+			}
+			key = strings.TrimPrefix(key, prefixForLocks)
+			reason = pb.Event_LOCK_RELEASED
+		} else if e.Type == mvccpb.DELETE {
+			reason, prev = w.deleteReason(e.Kv)
+		}
+
+		ev := &pb.Event{
+			Type:     pb.Event_EventType(e.Type),
+			Current:  &pb.ByteValue{Key: key},
+			Previous: &pb.ByteValue{},
+			Reason:   reason,
+			Rev:      e.Kv.ModRevision,
+		}
+		if e.Type == mvccpb.PUT {
+			ev.Current.Value = e.Kv.Value
+		}
+		if e.PrevKv != nil {
+			prev = e.PrevKv
+		}
+		if prev != nil {
+			ev.Previous = &pb.ByteValue{Key: util.BytesToString(prev.Key), Value: prev.Value}
+		}
+
+		// the value before a write is only looked up if a filter needs it.
+		looked := false
+		previous := func() ([]byte, bool) {
+			if !looked && prev == nil && e.Type == mvccpb.PUT {
+				prev = w.previous(e.Kv)
+			}
+			looked = true
+			if prev == nil {
+				return nil, false
+			}
+			return prev.Value, true
+		}
+
+		// watches that want deltas instead of whole values share the deltas for each type of value.
+		deltas := map[pb.WatchRequest_Deltas][]*pb.Delta{}
+		changes := func(mode pb.WatchRequest_Deltas) ([]*pb.Delta, bool) {
+			if mode == pb.WatchRequest_NONE || e.Type != mvccpb.PUT || reason == pb.Event_LOCK_RELEASED {
+				return nil, false
+			}
+			d, ok := deltas[mode]
+			if !ok {
+				if d, ok = watchDeltas(mode, e.Kv.Value, previous); !ok {
+					d = nil
+				}
+				deltas[mode] = d
+			}
+			return d, d != nil
+		}
+
+		for wa := range feed.watches {
+			if !watchMatches(wa.request, key, ev, previous) {
+				continue
+			}
+			if d, ok := changes(wa.request.Deltas); ok {
+				dev := *ev
+				dev.Current = &pb.ByteValue{Key: key}
+				dev.Previous = &pb.ByteValue{Key: ev.Previous.Key}
+				dev.Deltas = d
+				wa.send(&dev)
+			} else {
+				wa.send(ev)
+			}
+		}
 	}
 }
 
-// Watcher object.
+// subscribe adds a watch to the etcd watch for its keys, or their locks, starting a new one if needed. Only watches
+// for new events share etcd watches, since the others each start from their own revision.
+func (w *WatchController) subscribe(wa *watch, locks bool) *watchFeed {
+	r := wa.request
+	hash := wa.watcher.RequestID(&pb.WatchRequest{Key: r.Key, Prefix: r.Prefix})
+	if locks {
+		hash = prefixForLocks + hash
+	}
+
+	if r.Rev == 0 {
+		if feed, ok := w.feeds[hash]; ok {
+			feed.watches[wa] = struct{}{}
+			return feed
+		}
+	}
+
+	feed := &watchFeed{
+		key:     r.Key,
+		prefix:  r.Prefix,
+		locks:   locks,
+		watches: map[*watch]struct{}{wa: {}},
+	}
+	feed.id = w.watch(feed, r.Rev)
+	feed.rev = w.storage.Rev()
+	if r.Rev > 0 {
+		feed.rev = r.Rev - 1
+	}
+	w.feedIDs[feed.id] = feed
+	if r.Rev == 0 {
+		feed.hash = hash
+		w.feeds[hash] = feed
+	}
+	return feed
+}
+
+// unsubscribe removes a watch from an etcd watch, canceling it if no other watches share it.
+func (w *WatchController) unsubscribe(feed *watchFeed, wa *watch) {
+	delete(feed.watches, wa)
+	if len(feed.watches) > 0 {
+		return
+	}
+
+	w.stream.Cancel(feed.id)
+	delete(w.feedIDs, feed.id)
+	if len(feed.hash) > 0 {
+		delete(w.feeds, feed.hash)
+	}
+}
+
+// watch starts an etcd watch for the keys of a feed, from the given revision.
+func (w *WatchController) watch(feed *watchFeed, rev int64) mvcc.WatchID {
+	key := feed.key
+	if feed.locks {
+		key = prefixForLocks + key
+	}
+
+	// a nil range end watches a single key, while an empty one watches every key after it.
+	var end []byte
+	if feed.prefix {
+		end = getPrefix(key)
+	}
+	return w.stream.Watch(util.StringToBytes(key), end, rev)
+}
+
+// watchLocks adds the watch to the etcd watch for the locks of its keys if it wants lock releases, and removes it
+// otherwise.
+func (w *WatchController) watchLocks(wa *watch) {
+	r := wa.request
+	wants := len(r.Reasons) > 0 && watchesReason(r, pb.Event_LOCK_RELEASED)
+	if wa.lockFeed != nil && !wants {
+		w.unsubscribe(wa.lockFeed, wa)
+		wa.lockFeed = nil
+	} else if wa.lockFeed == nil && wants {
+		wa.lockFeed = w.subscribe(wa, true)
+	}
+}
+
+// cancel removes the watch from its etcd watches.
+func (w *WatchController) cancel(wa *watch) {
+	w.unsubscribe(wa.feed, wa)
+	if wa.lockFeed != nil {
+		w.unsubscribe(wa.lockFeed, wa)
+		wa.lockFeed = nil
+	}
+}
+
+// send an event to the watch's stream.
+func (wa *watch) send(ev *pb.Event) {
+	e := *ev
+	e.WatchKey = wa.request.Key
+	e.WatchPrefix = wa.request.Prefix
+	e.Subscription = wa.request.Subscription
+	wa.watcher.send(&e)
+}
+
+// Watcher object, which holds the watches of a single stream.
 type Watcher struct {
 	controller *WatchController
-	closeCh    chan struct{}
-	reqCh      chan *pb.WatchRequest
-	resCh      chan struct{}
-	eventCh    chan *pb.Event
-	server     *etcdserver.EtcdServer
-	watching   map[string]*pb.WatchRequest
+	id         int64
+	events     chan *pb.Event
+	done       chan struct{}
+	closed     bool
+	err        error
+	watches    map[string]*watch
 }
 
 // RequestID gets the string ID from the WatchRequest.
@@ -103,78 +409,143 @@ func (w *Watcher) RequestID(r *pb.WatchRequest) string {
 	if r.Prefix {
 		id += suffixForKeysUsingPrefix
 	}
+	if r.Subscription != 0 {
+		id += "\x00" + strconv.FormatInt(r.Subscription, 10)
+	}
 	return id
 }
 
-// Close the Watcher.
-func (w *Watcher) Close() {
-	w.closeCh <- struct{}{}
+// Watch starts, changes or cancels a watch.
+func (w *Watcher) Watch(r *pb.WatchRequest) {
+	c := w.controller
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	hash := w.RequestID(r)
+	wa, ok := w.watches[hash]
+	if r.Cancel {
+		if ok {
+			c.cancel(wa)
+			delete(w.watches, hash)
+		}
+		return
+	} else if ok {
+		// prevent duplcates, but allow the reasons, filters and deltas to be changed.
+		wa.request.Reasons = r.Reasons
+		wa.request.Types = r.Types
+		wa.request.Glob = r.Glob
+		wa.request.Filters = r.Filters
+		wa.request.Deltas = r.Deltas
+		c.watchLocks(wa)
+		return
+	}
+
+	wa = &watch{watcher: w, request: r}
+	wa.feed = c.subscribe(wa, false)
+	c.watchLocks(wa)
+	w.watches[hash] = wa
+
+	// a watch for new events is sent the revision it starts after, so it can be resumed before it has any events.
+	if r.Rev == 0 {
+		wa.send(&pb.Event{Type: pb.Event_PROGRESS, Current: &pb.ByteValue{}, Previous: &pb.ByteValue{}, Rev: c.storage.Rev()})
+	}
 }
 
-func (w *Watcher) backgroundProcess() {
-	stream := w.controller.server.Watchable().NewWatchStream()
-	streamCh := stream.Chan()
+// Err returns the error that ended the Watcher, if any.
+func (w *Watcher) Err() error {
+	w.controller.lock.Lock()
+	defer w.controller.lock.Unlock()
+	return w.err
+}
 
-	defer func() {
-		stream.Close()
-		close(w.closeCh)
-		close(w.reqCh)
-		close(w.resCh)
-		close(w.eventCh)
-	}()
+// Close the Watcher, canceling its watches.
+func (w *Watcher) Close() {
+	c := w.controller
+	c.lock.Lock()
+	defer c.lock.Unlock()
 
-	for {
-		select {
-		case <-w.closeCh:
-			return
-		case r := <-w.reqCh:
-			hash := w.RequestID(r)
+	for hash, wa := range w.watches {
+		c.cancel(wa)
+		delete(w.watches, hash)
+	}
+	delete(c.watchers, w.id)
+	w.stop(nil)
+}
 
-			if wr, ok := w.watching[hash]; ok && r.Cancel {
-				// process cancelation.
-				stream.Cancel(mvcc.WatchID(wr.Id))
-				delete(w.watching, hash)
+// send an event to the stream without waiting, disconnecting it as a slow consumer if its buffer is full.
+func (w *Watcher) send(ev *pb.Event) {
+	if w.closed {
+		return
+	}
 
-				w.resCh <- struct{}{}
-				continue
-			} else if ok {
-				// prevent duplcates.
-				w.resCh <- struct{}{}
-				continue
-			}
+	select {
+	case w.events <- ev:
+	default:
+		w.stop(util.ErrSlowConsumer)
+	}
+}
 
-			bkey := util.StringToBytes(r.Key)
-			end := []byte{}
-			if r.Prefix {
-				end = getPrefix(r.Key)
-			}
+// stop the Watcher, which ends its stream with the given error.
+func (w *Watcher) stop(err error) {
+	if !w.closed {
+		w.closed = true
+		w.err = err
+		close(w.done)
+	}
+}
 
-			r.Id = int64(stream.Watch(bkey, end, r.Rev))
-			w.watching[hash] = r
-			w.resCh <- struct{}{}
-		case r := <-streamCh:
-			for _, e := range r.Events {
-				ev := &pb.Event{
-					Type:     pb.Event_EventType(e.Type),
-					Current:  &pb.ByteValue{},
-					Previous: &pb.ByteValue{},
-				}
-				if e.Kv != nil {
-					ev.Current = &pb.ByteValue{Key: util.BytesToString(e.Kv.Key), Value: e.Kv.Value}
-				}
-				if e.PrevKv != nil {
-					ev.Previous = &pb.ByteValue{Key: util.BytesToString(e.PrevKv.Key), Value: e.PrevKv.Value}
-				}
+// deleteReason determines why a key was deleted, and returns the key as it was before.
+func (w *WatchController) deleteReason(kv *mvccpb.KeyValue) (pb.Event_Reason, *mvccpb.KeyValue) {
+	prev := w.previous(kv)
+	if prev == nil {
+		return pb.Event_DELETED, nil
+	}
+	key := util.BytesToString(kv.Key)
 
-				for _, r := range w.watching {
-					key := util.BytesToString(e.Kv.Key)
-					if r.Key == key || (r.Prefix && strings.HasPrefix(key, r.Key)) {
-						w.eventCh <- ev
-					}
-				}
-			}
+	// evicted keys leave a marker that's written in the same revision they're deleted in.
+	marker := w.storage.KeyAt(getEvictionName(key), kv.ModRevision)
+	if marker != nil && marker.ModRevision == kv.ModRevision {
+		return pb.Event_EVICTED, prev
+	}
+	if prev.Lease == 0 {
+		return pb.Event_DELETED, prev
+	}
+
+	// a lease deletes its keys in the revision it ends in, along with the key's expiration or the lease's name, which
+	// nothing else deletes with the key. A named lease's name is written again before it's revoked, so a name that
+	// was written more than once belonged to a revoked lease rather than an expired one.
+	if exp := w.storage.KeyAt(getExpirationName(key), kv.ModRevision-1); exp != nil && exp.Lease == prev.Lease {
+		if w.storage.KeyAt(exp.Key, kv.ModRevision) == nil {
+			return pb.Event_EXPIRED, prev
+		}
+	} else if name := w.leaseName(prev.Lease, kv.ModRevision-1); name != nil && name.Version == 1 {
+		if w.storage.KeyAt(name.Key, kv.ModRevision) == nil {
+			return pb.Event_EXPIRED, prev
+		}
+	}
+	return pb.Event_DELETED, prev
+}
+
+// leaseName returns the name of a named lease as it was at the given revision, or nil if it isn't a named lease.
+// The lock must be held.
+func (w *WatchController) leaseName(lease, rev int64) *mvccpb.KeyValue {
+	if w.leaseNames == nil || w.leaseNamesRev != rev {
+		w.leaseNames = map[int64]*mvccpb.KeyValue{}
+		w.leaseNamesRev = rev
+		for _, kv := range w.storage.KeysAt(util.StringToBytes(prefixForLeases), getPrefix(prefixForLeases), rev) {
+			w.leaseNames[kv.Lease] = kv
 		}
 	}
+	return w.leaseNames[lease]
+}
+
+// previous returns the key as it was before the change that left it as the given key, or nil if it didn't exist.
+func (w *WatchController) previous(kv *mvccpb.KeyValue) *mvccpb.KeyValue {
+	return w.storage.KeyAt(kv.Key, kv.ModRevision-1)
 }
 
 // Watch a key for changes.
@@ -182,22 +553,33 @@ func (s *Server) Watch(stream pb.Mydis_WatchServer) error {
 	watcher := s.wc.NewWatcher()
 	defer watcher.Close()
 
-	// sender
+	// receiver
+	recvCh := make(chan error, 1)
 	go func() {
 		for {
-			ev := <-watcher.eventCh
-			stream.Send(ev)
+			r, err := stream.Recv()
+			if err != nil {
+				recvCh <- err
+				return
+			}
+			watcher.Watch(r)
 		}
 	}()
 
-	// receiver
+	// sender
 	for {
-		r, err := stream.Recv()
-		if err != nil {
+		select {
+		case ev := <-watcher.events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-watcher.done:
+			return watcher.Err()
+		case err := <-recvCh:
+			if err == io.EOF {
+				return nil
+			}
 			return err
 		}
-
-		watcher.reqCh <- r
-		<-watcher.resCh
 	}
 }
@@ -0,0 +1,189 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/deejross/mydis/pb"
+)
+
+// watchDeltas returns the deltas that turn the value of a key before a write into the value after it, or false if
+// either value isn't the type of value the deltas are for.
+func watchDeltas(mode pb.WatchRequest_Deltas, value []byte, previous func() ([]byte, bool)) ([]*pb.Delta, bool) {
+	switch mode {
+	case pb.WatchRequest_LIST:
+		cur, err := txnList(value)
+		if err != nil {
+			return nil, false
+		}
+		old := &pb.List{}
+		if b, ok := previous(); ok {
+			if old, err = txnList(b); err != nil {
+				return nil, false
+			}
+		}
+		return listDeltas(old.Value, cur.Value), true
+	case pb.WatchRequest_HASH:
+		cur, err := txnHash(value)
+		if err != nil {
+			return nil, false
+		}
+		old := &pb.Hash{}
+		if b, ok := previous(); ok {
+			if old, err = txnHash(b); err != nil {
+				return nil, false
+			}
+		}
+		return hashDeltas(old.Value, cur.Value), true
+	}
+	return nil, false
+}
+
+// listDeltas returns the deltas that turn one list into another. Each delta's index is into the list as it is when
+// the delta is applied, after the deltas before it. Lists only say what they hold and not how they got there, so the
+// deltas are the fewest that make the change, which aren't always the calls that made it.
+func listDeltas(old, cur [][]byte) []*pb.Delta {
+	// the items that changed are between the items the lists start and end with.
+	start := 0
+	for start < len(old) && start < len(cur) && bytes.Equal(old[start], cur[start]) {
+		start++
+	}
+	end := 0
+	for end < len(old)-start && end < len(cur)-start && bytes.Equal(old[len(old)-1-end], cur[len(cur)-1-end]) {
+		end++
+	}
+	best := listSplice(len(old), start, len(old)-start-end, cur[start:len(cur)-end])
+	if len(best) <= 2 {
+		return best
+	}
+
+	// items taken off one end and added to the other, like in a capped list, would otherwise look like every item
+	// changed.
+	if n := listShift(old, cur); n > 0 {
+		kept := len(old) - n
+		d := listSplice(len(old), 0, n, nil)
+		d = append(d, listSplice(kept, kept, 0, cur[kept:])...)
+		if len(d) < len(best) {
+			best = d
+		}
+	}
+	if n := listShift(cur, old); n > 0 {
+		kept := len(cur) - n
+		d := listSplice(len(old), kept, len(old)-kept, nil)
+		d = append(d, listSplice(kept, 0, 0, cur[:n])...)
+		if len(d) < len(best) {
+			best = d
+		}
+	}
+	return best
+}
+
+// listShift returns the number of items taken off the front of a list, where the rest of the items are at the front
+// of the other list, or zero if there aren't any. Only the first place the other list's first item is found is
+// checked, which keeps it from taking too long on lists with many of the same item.
+func listShift(from, to [][]byte) int {
+	if len(to) == 0 {
+		return 0
+	}
+	for n := 1; n < len(from); n++ {
+		if !bytes.Equal(from[n], to[0]) {
+			continue
+		}
+		if len(from)-n > len(to) {
+			return 0
+		}
+		for i := n; i < len(from); i++ {
+			if !bytes.Equal(from[i], to[i-n]) {
+				return 0
+			}
+		}
+		return n
+	}
+	return 0
+}
+
+// listSplice returns the deltas that replace the given number of items at an index of a list of the given length
+// with new items. Items replaced one for one are set in place, the rest are deleted or inserted.
+func listSplice(length, index, removed int, added [][]byte) []*pb.Delta {
+	deltas := []*pb.Delta{}
+	set := removed
+	if len(added) < set {
+		set = len(added)
+	}
+	for i := 0; i < set; i++ {
+		deltas = append(deltas, &pb.Delta{Type: pb.Delta_LIST_SET, Index: int64(index + i), Value: added[i]})
+	}
+	index += set
+	removed -= set
+	added = added[set:]
+
+	// items at the front are popped from the front, others are deleted from the back so items at the end are popped.
+	for i := 0; i < removed; i++ {
+		at := index + removed - 1 - i
+		if index == 0 {
+			at = 0
+		}
+		typ := pb.Delta_LIST_DELETE
+		if at == 0 || at == length-1 {
+			typ = pb.Delta_LIST_POP
+		}
+		deltas = append(deltas, &pb.Delta{Type: typ, Index: int64(at)})
+		length--
+	}
+
+	// items at the front are pushed onto the front in reverse, so they end up in order.
+	front := index == 0 && length > 0
+	for i := range added {
+		at, value := index+i, added[i]
+		if front {
+			at, value = 0, added[len(added)-1-i]
+		}
+		typ := pb.Delta_LIST_INSERT
+		if at == 0 || at == length {
+			typ = pb.Delta_LIST_PUSH
+		}
+		deltas = append(deltas, &pb.Delta{Type: typ, Index: int64(at), Value: value})
+		length++
+	}
+	return deltas
+}
+
+// hashDeltas returns the deltas that turn one hash into another, in order of field name.
+func hashDeltas(old, cur map[string][]byte) []*pb.Delta {
+	fields := []string{}
+	for field, value := range cur {
+		if b, ok := old[field]; !ok || !bytes.Equal(b, value) {
+			fields = append(fields, field)
+		}
+	}
+	for field := range old {
+		if _, ok := cur[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	deltas := []*pb.Delta{}
+	for _, field := range fields {
+		if value, ok := cur[field]; ok {
+			deltas = append(deltas, &pb.Delta{Type: pb.Delta_HASH_SET, Field: field, Value: value})
+		} else {
+			deltas = append(deltas, &pb.Delta{Type: pb.Delta_HASH_DELETE, Field: field})
+		}
+	}
+	return deltas
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 Ross Peoples
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mydis
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/deejross/mydis/pb"
+)
+
+// watchMatches determines if an event on the given key should be sent for a watch request. The previous value of
+// the key is only looked up if one of the request's filters needs it.
+func watchMatches(r *pb.WatchRequest, key string, ev *pb.Event, previous func() ([]byte, bool)) bool {
+	if r.Key != key && !(r.Prefix && strings.HasPrefix(key, r.Key)) {
+		return false
+	}
+	if !watchesReason(r, ev.Reason) {
+		return false
+	}
+	if len(r.Types) > 0 {
+		found := false
+		for _, t := range r.Types {
+			if t == ev.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.Glob) > 0 && !globMatch(r.Glob, key) {
+		return false
+	}
+
+	// the filters are run against the value of the key after the change, which deleted keys don't have.
+	for _, f := range r.Filters {
+		if !watchFilterMatches(f, ev.Current.Value, ev.Type == pb.Event_PUT, previous) {
+			return false
+		}
+	}
+	return true
+}
+
+// watchesReason determines if a watch request wants events with the given reason. Requests that don't list any
+// reasons get every event that changes a key's value, which leaves out lock releases.
+func watchesReason(r *pb.WatchRequest, reason pb.Event_Reason) bool {
+	if len(r.Reasons) == 0 {
+		return reason != pb.Event_LOCK_RELEASED
+	}
+	for _, rr := range r.Reasons {
+		if rr == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// watchFilterMatches determines if a value passes a filter. Values that aren't the type the filter expects don't
+// pass it. A threshold is crossed when a write takes an int from one side of it to the other, and a key that didn't
+// exist or didn't hold an int before the write counts as having been on neither side.
+func watchFilterMatches(f *pb.WatchFilter, value []byte, exists bool, previous func() ([]byte, bool)) bool {
+	ok := false
+	switch f.Type {
+	case pb.WatchFilter_VALUE_EQUAL:
+		ok = exists && bytes.Equal(value, f.Value)
+	case pb.WatchFilter_INT_GREATER:
+		i, isInt := watchInt(value, exists)
+		ok = isInt && i > f.Int
+	case pb.WatchFilter_INT_LESS:
+		i, isInt := watchInt(value, exists)
+		ok = isInt && i < f.Int
+	case pb.WatchFilter_INT_CROSSED_ABOVE:
+		if i, isInt := watchInt(value, exists); isInt && i > f.Int {
+			p, wasInt := watchInt(previous())
+			ok = !wasInt || p <= f.Int
+		}
+	case pb.WatchFilter_INT_CROSSED_BELOW:
+		if i, isInt := watchInt(value, exists); isInt && i < f.Int {
+			p, wasInt := watchInt(previous())
+			ok = !wasInt || p >= f.Int
+		}
+	case pb.WatchFilter_HASH_FIELD_EQUAL:
+		if exists {
+			if h, err := txnHash(value); err == nil {
+				b, has := h.Value[f.Field]
+				ok = has && bytes.Equal(b, f.Value)
+			}
+		}
+	}
+	return ok != f.Not
+}
+
+// watchInt returns the int held by a value, or false if it doesn't exist or isn't an int.
+func watchInt(value []byte, exists bool) (int64, bool) {
+	if !exists {
+		return 0, false
+	}
+	i, err := txnInt(value)
+	if err != nil {
+		return 0, false
+	}
+	return i.Value, true
+}
+
+// globMatch determines if a key matches a glob pattern, where * matches any number of characters, ? matches a
+// single character, and \ matches the character after it literally.
+func globMatch(pattern, key string) bool {
+	p, k := 0, 0
+	starP, starK := -1, 0
+	for k < len(key) {
+		if p < len(pattern) {
+			switch c := pattern[p]; {
+			case c == '*':
+				starP, starK = p, k
+				p++
+				continue
+			case c == '?':
+				p++
+				k++
+				continue
+			case c == '\\' && p+1 < len(pattern) && pattern[p+1] == key[k]:
+				p += 2
+				k++
+				continue
+			case c != '\\' && c == key[k]:
+				p++
+				k++
+				continue
+			}
+		}
+		if starP < 0 {
+			return false
+		}
+
+		// backtrack to the last *, letting it match one more character.
+		starK++
+		p, k = starP+1, starK
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -16,10 +16,18 @@ package mydis
 
 import (
 	"bytes"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	etcdpb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	myc "github.com/deejross/mydis/client"
 	"github.com/deejross/mydis/pb"
+	"github.com/deejross/mydis/util"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestWatch(t *testing.T) {
@@ -73,3 +81,574 @@ func TestWatch(t *testing.T) {
 
 	client.CloseEventChannel(id)
 }
+
+func TestWatchReasons(t *testing.T) {
+	testReset()
+
+	ch, id := client.NewEventChannel()
+	defer client.CloseEventChannel(id)
+	client.WatchReasons("watch2", false, pb.Event_DELETED, pb.Event_EXPIRED, pb.Event_LOCK_RELEASED)
+	defer client.Unwatch("watch2", false)
+	time.Sleep(100 * time.Millisecond)
+
+	next := func() *pb.Event {
+		select {
+		case ev := <-ch:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Error("Never got event")
+			return &pb.Event{Current: &pb.ByteValue{}, Previous: &pb.ByteValue{}}
+		}
+	}
+
+	// writes aren't sent, since they weren't asked for.
+	if err := client.Set("watch2", "value"); err != nil {
+		t.Error(err)
+	}
+	if err := client.Delete("watch2"); err != nil {
+		t.Error(err)
+	}
+	if ev := next(); ev.Type != pb.Event_DELETE || ev.Reason != pb.Event_DELETED || string(ev.Previous.Value) != "value" {
+		t.Error("Unexpected event:", ev)
+	}
+
+	if err := client.Lock("watch2"); err != nil {
+		t.Error(err)
+	}
+	if err := client.Unlock("watch2"); err != nil {
+		t.Error(err)
+	}
+	if ev := next(); ev.Reason != pb.Event_LOCK_RELEASED || ev.Current.Key != "watch2" {
+		t.Error("Unexpected event:", ev)
+	}
+
+	t.Log("INFO: Waiting for key expiration")
+	if err := client.SetWithExpire("watch2", "value", 1); err != nil {
+		t.Error(err)
+	}
+	if ev := next(); ev.Type != pb.Event_DELETE || ev.Reason != pb.Event_EXPIRED || ev.Current.Key != "watch2" {
+		t.Error("Unexpected event:", ev)
+	}
+
+	// keys that expire are only expired by their expiration, not when they're deleted or cleared before it.
+	for _, clear := range []bool{false, true} {
+		if err := client.SetWithExpire("watch2", "value", 30); err != nil {
+			t.Error(err)
+		}
+		var err error
+		if clear {
+			err = client.Clear()
+		} else {
+			err = client.Delete("watch2")
+		}
+		if err != nil {
+			t.Error(err)
+		}
+		if ev := next(); ev.Type != pb.Event_DELETE || ev.Reason != pb.Event_DELETED {
+			t.Error("Unexpected event:", ev)
+		}
+	}
+
+	// revoking a named lease deletes its keys, while letting it run out expires them.
+	leaseCtx := metadata.NewContext(ctx, metadata.Pairs("lease", "watchlease"))
+	for _, revoke := range []bool{true, false} {
+		if _, err := server.GrantLease(ctx, &pb.Expiration{Key: "watchlease", Exp: 1}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := server.Set(leaseCtx, &pb.ByteValue{Key: "watch2", Value: []byte("value")}); err != nil {
+			t.Error(err)
+		}
+		reason := pb.Event_EXPIRED
+		if revoke {
+			reason = pb.Event_DELETED
+			if _, err := server.RevokeLease(ctx, &pb.Key{Key: "watchlease"}); err != nil {
+				t.Error(err)
+			}
+		}
+		if ev := next(); ev.Type != pb.Event_DELETE || ev.Reason != reason || ev.Current.Key != "watch2" {
+			t.Error("Unexpected event:", ev)
+		}
+	}
+}
+
+func TestWatchFilters(t *testing.T) {
+	testReset()
+
+	ch, id := client.NewEventChannel()
+	defer client.CloseEventChannel(id)
+	client.WatchWith(&pb.WatchRequest{
+		Key:     "filter",
+		Prefix:  true,
+		Types:   []pb.Event_EventType{pb.Event_PUT},
+		Glob:    "filter*.count",
+		Filters: []*pb.WatchFilter{{Type: pb.WatchFilter_INT_CROSSED_ABOVE, Int: 5}},
+	})
+	defer client.Unwatch("filter", true)
+	time.Sleep(100 * time.Millisecond)
+
+	if err := client.Set("filter1.name", "value"); err != nil {
+		t.Error(err)
+	}
+	for _, by := range []int64{3, 3, 1} {
+		if _, err := client.IncrementInt("filter1.count", by); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := client.Delete("filter1.count"); err != nil {
+		t.Error(err)
+	}
+
+	// only the increment that took the count from 3 to 6 crosses the threshold.
+	select {
+	case ev := <-ch:
+		if i, err := txnInt(ev.Current.Value); err != nil || i.Value != 6 || ev.Current.Key != "filter1.count" {
+			t.Error("Unexpected event:", ev)
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Never got event")
+	}
+
+	select {
+	case ev := <-ch:
+		t.Error("Unexpected event:", ev)
+	case <-time.After(100 * time.Millisecond):
+		// good
+	}
+}
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{"*", "anything", true},
+		{"user:*:name", "user:1:name", true},
+		{"user:*:name", "user:1:age", false},
+		{"key?", "key1", true},
+		{"key?", "key12", false},
+		{"a*b*c", "aXbYbZc", true},
+		{`key\*`, "key*", true},
+		{`key\*`, "key1", false},
+	}
+
+	for _, test := range tests {
+		if globMatch(test.pattern, test.key) != test.match {
+			t.Error("Unexpected result for", test.pattern, test.key)
+		}
+	}
+}
+
+func TestWatchResume(t *testing.T) {
+	testReset()
+
+	ch, id := client.NewEventChannel()
+	defer client.CloseEventChannel(id)
+
+	next := func() *pb.Event {
+		select {
+		case ev := <-ch:
+			return ev
+		case <-time.After(1 * time.Second):
+			t.Error("Never got event")
+			return &pb.Event{Current: &pb.ByteValue{}, Previous: &pb.ByteValue{}}
+		}
+	}
+
+	client.Watch("resume1", false)
+	time.Sleep(100 * time.Millisecond)
+	revs := []int64{}
+	for _, value := range []string{"value1", "value2", "value3"} {
+		if err := client.Set("resume1", value); err != nil {
+			t.Error(err)
+		}
+		ev := next()
+		if ev.Rev == 0 || ev.WatchKey != "resume1" || ev.WatchPrefix {
+			t.Error("Unexpected event:", ev)
+		}
+		revs = append(revs, ev.Rev)
+	}
+	client.Unwatch("resume1", false)
+	time.Sleep(100 * time.Millisecond)
+
+	// watching from a revision sends the events since then.
+	client.WatchWith(&pb.WatchRequest{Key: "resume1", Rev: revs[1]})
+	for i, value := range []string{"value2", "value3"} {
+		if ev := next(); ev.Rev != revs[i+1] || string(ev.Current.Value) != value {
+			t.Error("Unexpected event:", ev)
+		}
+	}
+	client.Unwatch("resume1", false)
+	time.Sleep(100 * time.Millisecond)
+
+	// watching from a compacted revision sends a COMPACTED event, then carries on from the current revision.
+	if _, err := server.storage.Compact(ctx, &etcdpb.CompactionRequest{Revision: revs[2], Physical: true}); err != nil {
+		t.Error(err)
+	}
+	client.WatchWith(&pb.WatchRequest{Key: "resume1", Rev: revs[0]})
+	defer client.Unwatch("resume1", false)
+	if ev := next(); ev.Type != pb.Event_COMPACTED || ev.WatchKey != "resume1" || ev.Rev < revs[2] {
+		t.Error("Unexpected event:", ev)
+	}
+	if err := client.Set("resume1", "value4"); err != nil {
+		t.Error(err)
+	}
+	if ev := next(); ev.Type != pb.Event_PUT || string(ev.Current.Value) != "value4" {
+		t.Error("Unexpected event:", ev)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	testReset()
+
+	sub1 := client.Watch("sub1", false)
+	sub2 := client.Watch("sub2", false)
+	defer sub2.Cancel()
+	time.Sleep(100 * time.Millisecond)
+
+	// each subscription only gets the events of its own watch.
+	if err := client.Set("sub1", "value"); err != nil {
+		t.Error(err)
+	}
+	select {
+	case ev := <-sub1.Events():
+		if ev.Current.Key != "sub1" || ev.Subscription == 0 {
+			t.Error("Unexpected event:", ev)
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Never got event")
+	}
+	select {
+	case ev := <-sub2.Events():
+		t.Error("Unexpected event:", ev)
+	case <-time.After(100 * time.Millisecond):
+		// good
+	}
+
+	sub1.Cancel()
+	if _, ok := <-sub1.Events(); ok {
+		t.Error("Expected channel to be closed")
+	}
+	if sub1.Err() != nil {
+		t.Error("Expected no error, got:", sub1.Err())
+	}
+
+	// dropping the oldest events keeps the latest ones.
+	drop := client.Subscribe(&pb.WatchRequest{Key: "sub3"}, 1, myc.OverflowDropOldest)
+	defer drop.Cancel()
+	fail := client.Subscribe(&pb.WatchRequest{Key: "sub3"}, 1, myc.OverflowError)
+	defer fail.Cancel()
+	time.Sleep(100 * time.Millisecond)
+
+	for _, value := range []string{"value1", "value2", "value3"} {
+		if err := client.Set("sub3", value); err != nil {
+			t.Error(err)
+		}
+	}
+	time.Sleep(200 * time.Millisecond)
+
+	if ev := <-drop.Events(); string(ev.Current.Value) != "value3" {
+		t.Error("Unexpected event:", ev)
+	}
+
+	// overflowing cancels the subscription, after the events that fit.
+	if ev := <-fail.Events(); string(ev.Current.Value) != "value1" {
+		t.Error("Unexpected event:", ev)
+	}
+	if _, ok := <-fail.Events(); ok {
+		t.Error("Expected channel to be closed")
+	}
+	if fail.Err() != util.ErrWatchOverflow {
+		t.Error("Expected ErrWatchOverflow, got:", fail.Err())
+	}
+
+	// an event channel that isn't read drops its oldest events instead of holding up the subscriptions.
+	ch, id := client.NewEventChannel()
+	defer client.CloseEventChannel(id)
+	sub4 := client.Subscribe(&pb.WatchRequest{Key: "sub4"}, 200, myc.OverflowError)
+	defer sub4.Cancel()
+	time.Sleep(100 * time.Millisecond)
+
+	for i := 0; i < 150; i++ {
+		if err := client.Set("sub4", strconv.Itoa(i)); err != nil {
+			t.Error(err)
+		}
+	}
+	for i := 0; i < 150; i++ {
+		select {
+		case ev := <-sub4.Events():
+			if string(ev.Current.Value) != strconv.Itoa(i) {
+				t.Error("Unexpected event:", ev)
+			}
+		case <-time.After(1 * time.Second):
+			t.Fatal("Never got event", i)
+		}
+	}
+	var last *pb.Event
+	for len(ch) > 0 {
+		last = <-ch
+	}
+	if last == nil || string(last.Current.Value) != "149" {
+		t.Error("Unexpected event:", last)
+	}
+}
+
+// nextWatcherEvent returns the next event sent to a Watcher that isn't a progress event.
+func nextWatcherEvent(w *Watcher) *pb.Event {
+	for {
+		select {
+		case ev := <-w.events:
+			if ev.Type != pb.Event_PROGRESS {
+				return ev
+			}
+		case <-time.After(5 * time.Second):
+			return nil
+		}
+	}
+}
+
+func TestWatchFanOut(t *testing.T) {
+	testReset()
+
+	count := 2000
+	watchers := make([]*Watcher, count)
+	for i := range watchers {
+		key := "fanout"
+		if i%2 == 1 {
+			key += strconv.Itoa(i % 10)
+		}
+		watchers[i] = server.wc.NewWatcher()
+		defer watchers[i].Close()
+		watchers[i].Watch(&pb.WatchRequest{Key: key})
+	}
+
+	// identical requests share an etcd watch.
+	server.wc.lock.Lock()
+	feeds := 0
+	for _, feed := range server.wc.feedIDs {
+		if strings.HasPrefix(feed.key, "fanout") {
+			feeds++
+		}
+	}
+	server.wc.lock.Unlock()
+	if feeds != 6 {
+		t.Error("Expected 6 etcd watches, got:", feeds)
+	}
+
+	for _, key := range []string{"fanout", "fanout1", "fanout3", "fanout5", "fanout7", "fanout9"} {
+		if err := client.Set(key, "value"); err != nil {
+			t.Error(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i, w := range watchers {
+		wg.Add(1)
+		go func(i int, w *Watcher) {
+			defer wg.Done()
+			key := "fanout"
+			if i%2 == 1 {
+				key += strconv.Itoa(i % 10)
+			}
+			if ev := nextWatcherEvent(w); ev == nil || ev.Current.Key != key || ev.WatchKey != key {
+				t.Error("Unexpected event:", ev)
+			}
+		}(i, w)
+	}
+	wg.Wait()
+
+	for _, w := range watchers {
+		w.Close()
+	}
+	server.wc.lock.Lock()
+	if len(server.wc.feeds) != 0 {
+		t.Error("Expected etcd watches to be canceled, got:", len(server.wc.feeds))
+	}
+	server.wc.lock.Unlock()
+}
+
+func TestWatchProgress(t *testing.T) {
+	testReset()
+
+	w := server.wc.NewWatcher()
+	defer w.Close()
+	w.Watch(&pb.WatchRequest{Key: "progress1"})
+
+	// the watch is sent the revision it starts after.
+	select {
+	case ev := <-w.events:
+		if ev.Type != pb.Event_PROGRESS || ev.Rev == 0 || ev.WatchKey != "progress1" {
+			t.Error("Unexpected event:", ev)
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Never got progress event")
+	}
+
+	if err := client.Set("progress1", "value"); err != nil {
+		t.Error(err)
+	}
+	ev := nextWatcherEvent(w)
+	if ev == nil {
+		t.Fatal("Never got event")
+	}
+
+	server.wc.RequestProgress()
+	select {
+	case p := <-w.events:
+		if p.Type != pb.Event_PROGRESS || p.Rev < ev.Rev {
+			t.Error("Unexpected event:", p)
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Never got progress event")
+	}
+}
+
+func TestWatchSlowConsumer(t *testing.T) {
+	testReset()
+
+	size := watchBufferSize
+	watchBufferSize = 2
+	w := server.wc.NewWatcher()
+	watchBufferSize = size
+	defer w.Close()
+	w.Watch(&pb.WatchRequest{Key: "slow1"})
+
+	// the progress event and the first write fill the buffer, so the second write disconnects the stream.
+	for _, value := range []string{"value1", "value2"} {
+		if err := client.Set("slow1", value); err != nil {
+			t.Error(err)
+		}
+	}
+
+	select {
+	case <-w.done:
+		if w.Err() != util.ErrSlowConsumer {
+			t.Error("Expected ErrSlowConsumer, got:", w.Err())
+		}
+	case <-time.After(1 * time.Second):
+		t.Error("Expected stream to be disconnected")
+	}
+}
+
+func TestWatchDeltas(t *testing.T) {
+	testReset()
+
+	lsub := client.WatchWith(&pb.WatchRequest{Key: "delta1", Deltas: pb.WatchRequest_LIST})
+	defer lsub.Cancel()
+	hsub := client.WatchWith(&pb.WatchRequest{Key: "delta2", Deltas: pb.WatchRequest_HASH})
+	defer hsub.Cancel()
+	time.Sleep(100 * time.Millisecond)
+
+	next := func(sub *myc.Subscription) *pb.Event {
+		select {
+		case ev := <-sub.Events():
+			return ev
+		case <-time.After(1 * time.Second):
+			t.Error("Never got event")
+			return &pb.Event{Current: &pb.ByteValue{}, Previous: &pb.ByteValue{}}
+		}
+	}
+
+	// the deltas of each write rebuild the list as it is on the server.
+	lst := [][]byte{}
+	writes := []func() error{
+		func() error { return client.ListAppend("delta1", "a") },
+		func() error { return client.ListAppend("delta1", "b") },
+		func() error { return client.ListInsert("delta1", 1, "x") },
+		func() error { return client.ListPopLeft("delta1").Error() },
+		func() error { return client.ListDelete("delta1", 1) },
+	}
+	for i, write := range writes {
+		if err := write(); err != nil {
+			t.Error(err)
+		}
+		ev := next(lsub)
+		if len(ev.Current.Value) > 0 || len(ev.Deltas) == 0 {
+			t.Error("Unexpected event:", ev)
+		}
+		if i == 0 && (ev.Deltas[0].Type != pb.Delta_LIST_PUSH || ev.Deltas[0].Index != 0) {
+			t.Error("Unexpected delta:", ev.Deltas[0])
+		}
+
+		var err error
+		if lst, err = myc.ApplyListDeltas(lst, ev.Deltas); err != nil {
+			t.Error(err)
+		}
+		expected, err := server.GetList(ctx, &pb.Key{Key: "delta1"})
+		if err != nil {
+			t.Error(err)
+		} else if !reflect.DeepEqual(lst, expected.Value) {
+			t.Error("Expected", expected.Value, "got", lst)
+		}
+	}
+
+	if err := client.SetHashField("delta2", "field1", "value1"); err != nil {
+		t.Error(err)
+	}
+	if ev := next(hsub); len(ev.Deltas) != 1 || ev.Deltas[0].Type != pb.Delta_HASH_SET || ev.Deltas[0].Field != "field1" {
+		t.Error("Unexpected event:", ev)
+	}
+	if err := client.DelHashField("delta2", "field1"); err != nil {
+		t.Error(err)
+	}
+	if ev := next(hsub); len(ev.Deltas) != 1 || ev.Deltas[0].Type != pb.Delta_HASH_DELETE || ev.Deltas[0].Field != "field1" {
+		t.Error("Unexpected event:", ev)
+	}
+}
+
+func TestWatchChangeDeltas(t *testing.T) {
+	testReset()
+
+	w := server.wc.NewWatcher()
+	defer w.Close()
+	w.Watch(&pb.WatchRequest{Key: "delta3"})
+
+	// watching the same key again changes the deltas of the existing watch.
+	w.Watch(&pb.WatchRequest{Key: "delta3", Deltas: pb.WatchRequest_HASH})
+	if err := client.SetHashField("delta3", "field1", "value1"); err != nil {
+		t.Error(err)
+	}
+	if ev := nextWatcherEvent(w); ev == nil {
+		t.Error("Never got event")
+	} else if len(ev.Deltas) != 1 || ev.Deltas[0].Type != pb.Delta_HASH_SET || ev.Deltas[0].Field != "field1" {
+		t.Error("Unexpected event:", ev)
+	}
+}
+
+func TestListDeltas(t *testing.T) {
+	list := func(items ...string) [][]byte {
+		lst := [][]byte{}
+		for _, item := range items {
+			lst = append(lst, []byte(item))
+		}
+		return lst
+	}
+
+	tests := []struct {
+		old    [][]byte
+		cur    [][]byte
+		deltas int
+	}{
+		{list(), list("a", "b"), 2},
+		{list("a", "b"), list(), 2},
+		{list("a", "b"), list("x", "a", "b"), 1},
+		{list("a", "b"), list("a", "x", "b"), 1},
+		{list("a", "b", "c"), list("a", "c"), 1},
+		{list("a", "b", "c"), list("a", "x", "c"), 1},
+		{list("a", "b", "c", "d"), list("b", "c", "d", "e"), 2},
+		{list("a", "b", "c", "d"), list("x", "a", "b", "c"), 2},
+		{list("a", "b", "c"), list("x", "y", "z", "w"), 4},
+	}
+
+	for _, test := range tests {
+		deltas := listDeltas(test.old, test.cur)
+		if len(deltas) != test.deltas {
+			t.Error("Expected", test.deltas, "deltas, got:", deltas)
+		}
+		if lst, err := myc.ApplyListDeltas(test.old, deltas); err != nil {
+			t.Error(err)
+		} else if !reflect.DeepEqual(lst, test.cur) {
+			t.Error("Expected", test.cur, "got", lst)
+		}
+	}
+}
@@ -13,6 +13,16 @@ It has these top-level messages:
 	Key
 	Bool
 	Expiration
+	ExpiringValue
+	LeaseInfo
+	KeysExpiration
+	LockHolder
+	LockInfo
+	LockInfos
+	PubSubMessage
+	SubscribeRequest
+	PubSubChannel
+	PubSubChannelList
 	ByteValue
 	IntValue
 	FloatValue
@@ -24,8 +34,47 @@ It has these top-level messages:
 	Hash
 	HashField
 	HashFieldSet
+	Sample
+	DownsampleRule
+	TimeSeries
+	TimeSeriesSample
+	TimeSeriesQuery
+	JSONPath
+	JSONValue
+	JSONNumber
+	Vector
+	VectorEntry
+	VectorIndex
+	VectorItem
+	VectorQuery
+	VectorMatch
+	VectorMatches
+	SearchPosting
+	SearchPostings
+	SearchDocument
+	SearchIndex
+	SearchQuery
+	SearchResult
+	SearchResults
+	FieldIndex
+	FieldIndexes
+	FieldQuery
+	RevisionValue
+	RevisionValues
+	CompareAndSwapRequest
+	SwapResult
+	TxnCompare
+	TxnOp
+	TxnRequest
+	TxnResponse
+	Script
+	EvalRequest
+	EvalResponse
+	CommandRequest
 	WatchRequest
+	WatchFilter
 	Event
+	Delta
 	Permission
 	ResponseHeader
 	AuthEnableRequest
@@ -84,26 +133,279 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Aggregation type.
+type Aggregation int32
+
+const (
+	Aggregation_NONE  Aggregation = 0
+	Aggregation_AVG   Aggregation = 1
+	Aggregation_MIN   Aggregation = 2
+	Aggregation_MAX   Aggregation = 3
+	Aggregation_SUM   Aggregation = 4
+	Aggregation_COUNT Aggregation = 5
+)
+
+var Aggregation_name = map[int32]string{
+	0: "NONE",
+	1: "AVG",
+	2: "MIN",
+	3: "MAX",
+	4: "SUM",
+	5: "COUNT",
+}
+var Aggregation_value = map[string]int32{
+	"NONE":  0,
+	"AVG":   1,
+	"MIN":   2,
+	"MAX":   3,
+	"SUM":   4,
+	"COUNT": 5,
+}
+
+func (x Aggregation) String() string {
+	return proto.EnumName(Aggregation_name, int32(x))
+}
+func (Aggregation) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+// VectorMetric type.
+type VectorMetric int32
+
+const (
+	VectorMetric_COSINE VectorMetric = 0
+	VectorMetric_DOT    VectorMetric = 1
+	VectorMetric_L2     VectorMetric = 2
+)
+
+var VectorMetric_name = map[int32]string{
+	0: "COSINE",
+	1: "DOT",
+	2: "L2",
+}
+var VectorMetric_value = map[string]int32{
+	"COSINE": 0,
+	"DOT":    1,
+	"L2":     2,
+}
+
+func (x VectorMetric) String() string {
+	return proto.EnumName(VectorMetric_name, int32(x))
+}
+func (VectorMetric) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type TxnCompare_Type int32
+
+const (
+	TxnCompare_EXISTS             TxnCompare_Type = 0
+	TxnCompare_VALUE_EQUAL        TxnCompare_Type = 1
+	TxnCompare_INT_GREATER        TxnCompare_Type = 2
+	TxnCompare_MOD_REVISION_EQUAL TxnCompare_Type = 3
+	TxnCompare_HASH_FIELD_EQUAL   TxnCompare_Type = 4
+)
+
+var TxnCompare_Type_name = map[int32]string{
+	0: "EXISTS",
+	1: "VALUE_EQUAL",
+	2: "INT_GREATER",
+	3: "MOD_REVISION_EQUAL",
+	4: "HASH_FIELD_EQUAL",
+}
+var TxnCompare_Type_value = map[string]int32{
+	"EXISTS":             0,
+	"VALUE_EQUAL":        1,
+	"INT_GREATER":        2,
+	"MOD_REVISION_EQUAL": 3,
+	"HASH_FIELD_EQUAL":   4,
+}
+
+func (x TxnCompare_Type) String() string {
+	return proto.EnumName(TxnCompare_Type_name, int32(x))
+}
+func (TxnCompare_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{54, 0} }
+
+type TxnOp_Type int32
+
+const (
+	TxnOp_SET            TxnOp_Type = 0
+	TxnOp_SET_INT        TxnOp_Type = 1
+	TxnOp_INCREMENT_INT  TxnOp_Type = 2
+	TxnOp_LIST_APPEND    TxnOp_Type = 3
+	TxnOp_SET_HASH_FIELD TxnOp_Type = 4
+	TxnOp_DELETE         TxnOp_Type = 5
+)
+
+var TxnOp_Type_name = map[int32]string{
+	0: "SET",
+	1: "SET_INT",
+	2: "INCREMENT_INT",
+	3: "LIST_APPEND",
+	4: "SET_HASH_FIELD",
+	5: "DELETE",
+}
+var TxnOp_Type_value = map[string]int32{
+	"SET":            0,
+	"SET_INT":        1,
+	"INCREMENT_INT":  2,
+	"LIST_APPEND":    3,
+	"SET_HASH_FIELD": 4,
+	"DELETE":         5,
+}
+
+func (x TxnOp_Type) String() string {
+	return proto.EnumName(TxnOp_Type_name, int32(x))
+}
+func (TxnOp_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{55, 0} }
+
+// Deltas is the type of value the watched keys hold, which writes are sent as deltas of.
+type WatchRequest_Deltas int32
+
+const (
+	WatchRequest_NONE WatchRequest_Deltas = 0
+	WatchRequest_LIST WatchRequest_Deltas = 1
+	WatchRequest_HASH WatchRequest_Deltas = 2
+)
+
+var WatchRequest_Deltas_name = map[int32]string{
+	0: "NONE",
+	1: "LIST",
+	2: "HASH",
+}
+var WatchRequest_Deltas_value = map[string]int32{
+	"NONE": 0,
+	"LIST": 1,
+	"HASH": 2,
+}
+
+func (x WatchRequest_Deltas) String() string {
+	return proto.EnumName(WatchRequest_Deltas_name, int32(x))
+}
+func (WatchRequest_Deltas) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{62, 0} }
+
+type WatchFilter_Type int32
+
+const (
+	WatchFilter_VALUE_EQUAL       WatchFilter_Type = 0
+	WatchFilter_INT_GREATER       WatchFilter_Type = 1
+	WatchFilter_INT_LESS          WatchFilter_Type = 2
+	WatchFilter_INT_CROSSED_ABOVE WatchFilter_Type = 3
+	WatchFilter_INT_CROSSED_BELOW WatchFilter_Type = 4
+	WatchFilter_HASH_FIELD_EQUAL  WatchFilter_Type = 5
+)
+
+var WatchFilter_Type_name = map[int32]string{
+	0: "VALUE_EQUAL",
+	1: "INT_GREATER",
+	2: "INT_LESS",
+	3: "INT_CROSSED_ABOVE",
+	4: "INT_CROSSED_BELOW",
+	5: "HASH_FIELD_EQUAL",
+}
+var WatchFilter_Type_value = map[string]int32{
+	"VALUE_EQUAL":       0,
+	"INT_GREATER":       1,
+	"INT_LESS":          2,
+	"INT_CROSSED_ABOVE": 3,
+	"INT_CROSSED_BELOW": 4,
+	"HASH_FIELD_EQUAL":  5,
+}
+
+func (x WatchFilter_Type) String() string {
+	return proto.EnumName(WatchFilter_Type_name, int32(x))
+}
+func (WatchFilter_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{63, 0} }
+
 type Event_EventType int32
 
 const (
-	Event_PUT    Event_EventType = 0
-	Event_DELETE Event_EventType = 1
+	Event_PUT       Event_EventType = 0
+	Event_DELETE    Event_EventType = 1
+	Event_COMPACTED Event_EventType = 2
+	Event_PROGRESS  Event_EventType = 3
 )
 
 var Event_EventType_name = map[int32]string{
 	0: "PUT",
 	1: "DELETE",
+	2: "COMPACTED",
+	3: "PROGRESS",
 }
 var Event_EventType_value = map[string]int32{
-	"PUT":    0,
-	"DELETE": 1,
+	"PUT":       0,
+	"DELETE":    1,
+	"COMPACTED": 2,
+	"PROGRESS":  3,
 }
 
 func (x Event_EventType) String() string {
 	return proto.EnumName(Event_EventType_name, int32(x))
 }
-func (Event_EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{16, 0} }
+func (Event_EventType) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 0} }
+
+// Reason is why a key changed.
+type Event_Reason int32
+
+const (
+	Event_WRITTEN       Event_Reason = 0
+	Event_DELETED       Event_Reason = 1
+	Event_EXPIRED       Event_Reason = 2
+	Event_EVICTED       Event_Reason = 3
+	Event_LOCK_RELEASED Event_Reason = 4
+)
+
+var Event_Reason_name = map[int32]string{
+	0: "WRITTEN",
+	1: "DELETED",
+	2: "EXPIRED",
+	3: "EVICTED",
+	4: "LOCK_RELEASED",
+}
+var Event_Reason_value = map[string]int32{
+	"WRITTEN":       0,
+	"DELETED":       1,
+	"EXPIRED":       2,
+	"EVICTED":       3,
+	"LOCK_RELEASED": 4,
+}
+
+func (x Event_Reason) String() string {
+	return proto.EnumName(Event_Reason_name, int32(x))
+}
+func (Event_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{64, 1} }
+
+type Delta_Type int32
+
+const (
+	Delta_LIST_PUSH   Delta_Type = 0
+	Delta_LIST_POP    Delta_Type = 1
+	Delta_LIST_INSERT Delta_Type = 2
+	Delta_LIST_DELETE Delta_Type = 3
+	Delta_LIST_SET    Delta_Type = 4
+	Delta_HASH_SET    Delta_Type = 5
+	Delta_HASH_DELETE Delta_Type = 6
+)
+
+var Delta_Type_name = map[int32]string{
+	0: "LIST_PUSH",
+	1: "LIST_POP",
+	2: "LIST_INSERT",
+	3: "LIST_DELETE",
+	4: "LIST_SET",
+	5: "HASH_SET",
+	6: "HASH_DELETE",
+}
+var Delta_Type_value = map[string]int32{
+	"LIST_PUSH":   0,
+	"LIST_POP":    1,
+	"LIST_INSERT": 2,
+	"LIST_DELETE": 3,
+	"LIST_SET":    4,
+	"HASH_SET":    5,
+	"HASH_DELETE": 6,
+}
+
+func (x Delta_Type) String() string {
+	return proto.EnumName(Delta_Type_name, int32(x))
+}
+func (Delta_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{65, 0} }
 
 type Permission_Type int32
 
@@ -127,7 +429,7 @@ var Permission_Type_value = map[string]int32{
 func (x Permission_Type) String() string {
 	return proto.EnumName(Permission_Type_name, int32(x))
 }
-func (Permission_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{17, 0} }
+func (Permission_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{66, 0} }
 
 // Null object.
 type Null struct {
@@ -261,6 +563,320 @@ func (m *Expiration) GetExp() int64 {
 	return 0
 }
 
+// ExpiringValue object.
+type ExpiringValue struct {
+	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Exp   int64  `protobuf:"zigzag64,3,opt,name=exp" json:"exp,omitempty"`
+}
+
+func (m *ExpiringValue) Reset()                    { *m = ExpiringValue{} }
+func (m *ExpiringValue) String() string            { return proto.CompactTextString(m) }
+func (*ExpiringValue) ProtoMessage()               {}
+func (*ExpiringValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ExpiringValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ExpiringValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ExpiringValue) GetExp() int64 {
+	if m != nil {
+		return m.Exp
+	}
+	return 0
+}
+
+// LeaseInfo object.
+type LeaseInfo struct {
+	Name       string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Ttl        int64    `protobuf:"varint,2,opt,name=ttl" json:"ttl,omitempty"`
+	GrantedTTL int64    `protobuf:"varint,3,opt,name=grantedTTL" json:"grantedTTL,omitempty"`
+	Keys       []string `protobuf:"bytes,4,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *LeaseInfo) Reset()                    { *m = LeaseInfo{} }
+func (m *LeaseInfo) String() string            { return proto.CompactTextString(m) }
+func (*LeaseInfo) ProtoMessage()               {}
+func (*LeaseInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *LeaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LeaseInfo) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+func (m *LeaseInfo) GetGrantedTTL() int64 {
+	if m != nil {
+		return m.GrantedTTL
+	}
+	return 0
+}
+
+func (m *LeaseInfo) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// KeysExpiration object.
+type KeysExpiration struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+	Exp  int64    `protobuf:"zigzag64,2,opt,name=exp" json:"exp,omitempty"`
+}
+
+func (m *KeysExpiration) Reset()                    { *m = KeysExpiration{} }
+func (m *KeysExpiration) String() string            { return proto.CompactTextString(m) }
+func (*KeysExpiration) ProtoMessage()               {}
+func (*KeysExpiration) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *KeysExpiration) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *KeysExpiration) GetExp() int64 {
+	if m != nil {
+		return m.Exp
+	}
+	return 0
+}
+
+// LockHolder object.
+type LockHolder struct {
+	Owner    string `protobuf:"bytes,1,opt,name=owner" json:"owner,omitempty"`
+	Count    int64  `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Acquired int64  `protobuf:"varint,3,opt,name=acquired" json:"acquired,omitempty"`
+}
+
+func (m *LockHolder) Reset()                    { *m = LockHolder{} }
+func (m *LockHolder) String() string            { return proto.CompactTextString(m) }
+func (*LockHolder) ProtoMessage()               {}
+func (*LockHolder) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *LockHolder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockHolder) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LockHolder) GetAcquired() int64 {
+	if m != nil {
+		return m.Acquired
+	}
+	return 0
+}
+
+// LockInfo object.
+type LockInfo struct {
+	Key      string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Owner    string `protobuf:"bytes,2,opt,name=owner" json:"owner,omitempty"`
+	Count    int64  `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`
+	Acquired int64  `protobuf:"varint,4,opt,name=acquired" json:"acquired,omitempty"`
+	Ttl      int64  `protobuf:"varint,5,opt,name=ttl" json:"ttl,omitempty"`
+}
+
+func (m *LockInfo) Reset()                    { *m = LockInfo{} }
+func (m *LockInfo) String() string            { return proto.CompactTextString(m) }
+func (*LockInfo) ProtoMessage()               {}
+func (*LockInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *LockInfo) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LockInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockInfo) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *LockInfo) GetAcquired() int64 {
+	if m != nil {
+		return m.Acquired
+	}
+	return 0
+}
+
+func (m *LockInfo) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+// LockInfos object.
+type LockInfos struct {
+	Locks []*LockInfo `protobuf:"bytes,1,rep,name=locks" json:"locks,omitempty"`
+}
+
+func (m *LockInfos) Reset()                    { *m = LockInfos{} }
+func (m *LockInfos) String() string            { return proto.CompactTextString(m) }
+func (*LockInfos) ProtoMessage()               {}
+func (*LockInfos) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *LockInfos) GetLocks() []*LockInfo {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+// PubSubMessage object.
+type PubSubMessage struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	Message []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Pattern string `protobuf:"bytes,3,opt,name=pattern" json:"pattern,omitempty"`
+}
+
+func (m *PubSubMessage) Reset()                    { *m = PubSubMessage{} }
+func (m *PubSubMessage) String() string            { return proto.CompactTextString(m) }
+func (*PubSubMessage) ProtoMessage()               {}
+func (*PubSubMessage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *PubSubMessage) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PubSubMessage) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *PubSubMessage) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+// SubscribeRequest object.
+type SubscribeRequest struct {
+	Channels    []string `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+	Patterns    []string `protobuf:"bytes,2,rep,name=patterns" json:"patterns,omitempty"`
+	Unsubscribe bool     `protobuf:"varint,3,opt,name=unsubscribe" json:"unsubscribe,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *SubscribeRequest) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetPatterns() []string {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetUnsubscribe() bool {
+	if m != nil {
+		return m.Unsubscribe
+	}
+	return false
+}
+
+// PubSubChannel object.
+type PubSubChannel struct {
+	Channel     string `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
+	Subscribers int64  `protobuf:"varint,2,opt,name=subscribers" json:"subscribers,omitempty"`
+}
+
+func (m *PubSubChannel) Reset()                    { *m = PubSubChannel{} }
+func (m *PubSubChannel) String() string            { return proto.CompactTextString(m) }
+func (*PubSubChannel) ProtoMessage()               {}
+func (*PubSubChannel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *PubSubChannel) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *PubSubChannel) GetSubscribers() int64 {
+	if m != nil {
+		return m.Subscribers
+	}
+	return 0
+}
+
+// PubSubChannelList object.
+type PubSubChannelList struct {
+	Channels []*PubSubChannel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+	Patterns int64            `protobuf:"varint,2,opt,name=patterns" json:"patterns,omitempty"`
+}
+
+func (m *PubSubChannelList) Reset()                    { *m = PubSubChannelList{} }
+func (m *PubSubChannelList) String() string            { return proto.CompactTextString(m) }
+func (*PubSubChannelList) ProtoMessage()               {}
+func (*PubSubChannelList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *PubSubChannelList) GetChannels() []*PubSubChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *PubSubChannelList) GetPatterns() int64 {
+	if m != nil {
+		return m.Patterns
+	}
+	return 0
+}
+
 // ByteValue object.
 type ByteValue struct {
 	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
@@ -270,7 +886,7 @@ type ByteValue struct {
 func (m *ByteValue) Reset()                    { *m = ByteValue{} }
 func (m *ByteValue) String() string            { return proto.CompactTextString(m) }
 func (*ByteValue) ProtoMessage()               {}
-func (*ByteValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*ByteValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *ByteValue) GetKey() string {
 	if m != nil {
@@ -295,7 +911,7 @@ type IntValue struct {
 func (m *IntValue) Reset()                    { *m = IntValue{} }
 func (m *IntValue) String() string            { return proto.CompactTextString(m) }
 func (*IntValue) ProtoMessage()               {}
-func (*IntValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*IntValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *IntValue) GetKey() string {
 	if m != nil {
@@ -320,7 +936,7 @@ type FloatValue struct {
 func (m *FloatValue) Reset()                    { *m = FloatValue{} }
 func (m *FloatValue) String() string            { return proto.CompactTextString(m) }
 func (*FloatValue) ProtoMessage()               {}
-func (*FloatValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*FloatValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *FloatValue) GetKey() string {
 	if m != nil {
@@ -344,7 +960,7 @@ type KeysList struct {
 func (m *KeysList) Reset()                    { *m = KeysList{} }
 func (m *KeysList) String() string            { return proto.CompactTextString(m) }
 func (*KeysList) ProtoMessage()               {}
-func (*KeysList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*KeysList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *KeysList) GetKeys() []string {
 	if m != nil {
@@ -363,7 +979,7 @@ type List struct {
 func (m *List) Reset()                    { *m = List{} }
 func (m *List) String() string            { return proto.CompactTextString(m) }
 func (*List) ProtoMessage()               {}
-func (*List) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*List) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *List) GetKey() string {
 	if m != nil {
@@ -396,7 +1012,7 @@ type ListItem struct {
 func (m *ListItem) Reset()                    { *m = ListItem{} }
 func (m *ListItem) String() string            { return proto.CompactTextString(m) }
 func (*ListItem) ProtoMessage()               {}
-func (*ListItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ListItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *ListItem) GetKey() string {
 	if m != nil {
@@ -427,7 +1043,7 @@ type ErrorHash struct {
 func (m *ErrorHash) Reset()                    { *m = ErrorHash{} }
 func (m *ErrorHash) String() string            { return proto.CompactTextString(m) }
 func (*ErrorHash) ProtoMessage()               {}
-func (*ErrorHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ErrorHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *ErrorHash) GetErrors() map[string]string {
 	if m != nil {
@@ -445,7 +1061,7 @@ type StringHash struct {
 func (m *StringHash) Reset()                    { *m = StringHash{} }
 func (m *StringHash) String() string            { return proto.CompactTextString(m) }
 func (*StringHash) ProtoMessage()               {}
-func (*StringHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*StringHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *StringHash) GetKey() string {
 	if m != nil {
@@ -470,7 +1086,7 @@ type Hash struct {
 func (m *Hash) Reset()                    { *m = Hash{} }
 func (m *Hash) String() string            { return proto.CompactTextString(m) }
 func (*Hash) ProtoMessage()               {}
-func (*Hash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*Hash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *Hash) GetKey() string {
 	if m != nil {
@@ -496,7 +1112,7 @@ type HashField struct {
 func (m *HashField) Reset()                    { *m = HashField{} }
 func (m *HashField) String() string            { return proto.CompactTextString(m) }
 func (*HashField) ProtoMessage()               {}
-func (*HashField) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*HashField) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *HashField) GetKey() string {
 	if m != nil {
@@ -529,7 +1145,7 @@ type HashFieldSet struct {
 func (m *HashFieldSet) Reset()                    { *m = HashFieldSet{} }
 func (m *HashFieldSet) String() string            { return proto.CompactTextString(m) }
 func (*HashFieldSet) ProtoMessage()               {}
-func (*HashFieldSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*HashFieldSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *HashFieldSet) GetKey() string {
 	if m != nil {
@@ -552,770 +1168,2235 @@ func (m *HashFieldSet) GetValue() [][]byte {
 	return nil
 }
 
-// WatchRequest object.
-type WatchRequest struct {
-	Key    string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
-	Prefix bool   `protobuf:"varint,2,opt,name=prefix" json:"prefix,omitempty"`
-	Rev    int64  `protobuf:"varint,3,opt,name=rev" json:"rev,omitempty"`
-	Id     int64  `protobuf:"varint,4,opt,name=id" json:"id,omitempty"`
-	Cancel bool   `protobuf:"varint,5,opt,name=cancel" json:"cancel,omitempty"`
+// Sample object.
+type Sample struct {
+	Timestamp int64   `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	Value     float64 `protobuf:"fixed64,2,opt,name=value" json:"value,omitempty"`
 }
 
-func (m *WatchRequest) Reset()                    { *m = WatchRequest{} }
-func (m *WatchRequest) String() string            { return proto.CompactTextString(m) }
-func (*WatchRequest) ProtoMessage()               {}
-func (*WatchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (m *Sample) Reset()                    { *m = Sample{} }
+func (m *Sample) String() string            { return proto.CompactTextString(m) }
+func (*Sample) ProtoMessage()               {}
+func (*Sample) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
-func (m *WatchRequest) GetKey() string {
+func (m *Sample) GetTimestamp() int64 {
 	if m != nil {
-		return m.Key
+		return m.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (m *WatchRequest) GetPrefix() bool {
+func (m *Sample) GetValue() float64 {
 	if m != nil {
-		return m.Prefix
+		return m.Value
 	}
-	return false
+	return 0
 }
 
-func (m *WatchRequest) GetRev() int64 {
+// DownsampleRule object.
+type DownsampleRule struct {
+	DestKey     string      `protobuf:"bytes,1,opt,name=destKey" json:"destKey,omitempty"`
+	Aggregation Aggregation `protobuf:"varint,2,opt,name=aggregation,enum=pb.Aggregation" json:"aggregation,omitempty"`
+	Bucket      int64       `protobuf:"varint,3,opt,name=bucket" json:"bucket,omitempty"`
+}
+
+func (m *DownsampleRule) Reset()                    { *m = DownsampleRule{} }
+func (m *DownsampleRule) String() string            { return proto.CompactTextString(m) }
+func (*DownsampleRule) ProtoMessage()               {}
+func (*DownsampleRule) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *DownsampleRule) GetDestKey() string {
 	if m != nil {
-		return m.Rev
+		return m.DestKey
 	}
-	return 0
+	return ""
 }
 
-func (m *WatchRequest) GetId() int64 {
+func (m *DownsampleRule) GetAggregation() Aggregation {
 	if m != nil {
-		return m.Id
+		return m.Aggregation
 	}
-	return 0
+	return Aggregation_NONE
 }
 
-func (m *WatchRequest) GetCancel() bool {
+func (m *DownsampleRule) GetBucket() int64 {
 	if m != nil {
-		return m.Cancel
+		return m.Bucket
 	}
-	return false
+	return 0
 }
 
-// Event object.
-type Event struct {
-	Type     Event_EventType `protobuf:"varint,1,opt,name=type,enum=pb.Event_EventType" json:"type,omitempty"`
-	Current  *ByteValue      `protobuf:"bytes,3,opt,name=current" json:"current,omitempty"`
-	Previous *ByteValue      `protobuf:"bytes,4,opt,name=previous" json:"previous,omitempty"`
+// TimeSeries object.
+type TimeSeries struct {
+	Key       string            `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Samples   []*Sample         `protobuf:"bytes,2,rep,name=samples" json:"samples,omitempty"`
+	Retention int64             `protobuf:"varint,3,opt,name=retention" json:"retention,omitempty"`
+	Rules     []*DownsampleRule `protobuf:"bytes,4,rep,name=rules" json:"rules,omitempty"`
 }
 
-func (m *Event) Reset()                    { *m = Event{} }
-func (m *Event) String() string            { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (m *TimeSeries) Reset()                    { *m = TimeSeries{} }
+func (m *TimeSeries) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeries) ProtoMessage()               {}
+func (*TimeSeries) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
-func (m *Event) GetType() Event_EventType {
+func (m *TimeSeries) GetKey() string {
 	if m != nil {
-		return m.Type
+		return m.Key
 	}
-	return Event_PUT
+	return ""
 }
 
-func (m *Event) GetCurrent() *ByteValue {
+func (m *TimeSeries) GetSamples() []*Sample {
 	if m != nil {
-		return m.Current
+		return m.Samples
 	}
 	return nil
 }
 
-func (m *Event) GetPrevious() *ByteValue {
+func (m *TimeSeries) GetRetention() int64 {
 	if m != nil {
-		return m.Previous
+		return m.Retention
+	}
+	return 0
+}
+
+func (m *TimeSeries) GetRules() []*DownsampleRule {
+	if m != nil {
+		return m.Rules
 	}
 	return nil
 }
 
-// -- Etcd auth passthrough messages
-// Permission is a single entity
-type Permission struct {
-	PermType Permission_Type `protobuf:"varint,1,opt,name=permType,enum=pb.Permission_Type" json:"permType,omitempty"`
-	Key      []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	RangeEnd []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+// TimeSeriesSample object.
+type TimeSeriesSample struct {
+	Key       string  `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Timestamp int64   `protobuf:"varint,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Value     float64 `protobuf:"fixed64,3,opt,name=value" json:"value,omitempty"`
 }
 
-func (m *Permission) Reset()                    { *m = Permission{} }
-func (m *Permission) String() string            { return proto.CompactTextString(m) }
-func (*Permission) ProtoMessage()               {}
-func (*Permission) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (m *TimeSeriesSample) Reset()                    { *m = TimeSeriesSample{} }
+func (m *TimeSeriesSample) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesSample) ProtoMessage()               {}
+func (*TimeSeriesSample) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
-func (m *Permission) GetPermType() Permission_Type {
+func (m *TimeSeriesSample) GetKey() string {
 	if m != nil {
-		return m.PermType
+		return m.Key
 	}
-	return Permission_READ
+	return ""
 }
 
-func (m *Permission) GetKey() []byte {
+func (m *TimeSeriesSample) GetTimestamp() int64 {
 	if m != nil {
-		return m.Key
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *Permission) GetRangeEnd() []byte {
+func (m *TimeSeriesSample) GetValue() float64 {
 	if m != nil {
-		return m.RangeEnd
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-type ResponseHeader struct {
-	// cluster_id is the ID of the cluster which sent the response.
-	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId" json:"cluster_id,omitempty"`
-	// member_id is the ID of the member which sent the response.
-	MemberId uint64 `protobuf:"varint,2,opt,name=member_id,json=memberId" json:"member_id,omitempty"`
-	// revision is the key-value store revision when the request was applied.
-	Revision int64 `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
-	// raft_term is the raft term when the request was applied.
-	RaftTerm uint64 `protobuf:"varint,4,opt,name=raft_term,json=raftTerm" json:"raft_term,omitempty"`
+// TimeSeriesQuery object.
+type TimeSeriesQuery struct {
+	Key         string      `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	From        int64       `protobuf:"varint,2,opt,name=from" json:"from,omitempty"`
+	To          int64       `protobuf:"varint,3,opt,name=to" json:"to,omitempty"`
+	Aggregation Aggregation `protobuf:"varint,4,opt,name=aggregation,enum=pb.Aggregation" json:"aggregation,omitempty"`
+	Bucket      int64       `protobuf:"varint,5,opt,name=bucket" json:"bucket,omitempty"`
 }
 
-func (m *ResponseHeader) Reset()                    { *m = ResponseHeader{} }
-func (m *ResponseHeader) String() string            { return proto.CompactTextString(m) }
-func (*ResponseHeader) ProtoMessage()               {}
-func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (m *TimeSeriesQuery) Reset()                    { *m = TimeSeriesQuery{} }
+func (m *TimeSeriesQuery) String() string            { return proto.CompactTextString(m) }
+func (*TimeSeriesQuery) ProtoMessage()               {}
+func (*TimeSeriesQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
-func (m *ResponseHeader) GetClusterId() uint64 {
+func (m *TimeSeriesQuery) GetKey() string {
 	if m != nil {
-		return m.ClusterId
+		return m.Key
 	}
-	return 0
+	return ""
 }
 
-func (m *ResponseHeader) GetMemberId() uint64 {
+func (m *TimeSeriesQuery) GetFrom() int64 {
 	if m != nil {
-		return m.MemberId
+		return m.From
 	}
 	return 0
 }
 
-func (m *ResponseHeader) GetRevision() int64 {
+func (m *TimeSeriesQuery) GetTo() int64 {
 	if m != nil {
-		return m.Revision
+		return m.To
 	}
 	return 0
 }
 
-func (m *ResponseHeader) GetRaftTerm() uint64 {
+func (m *TimeSeriesQuery) GetAggregation() Aggregation {
 	if m != nil {
-		return m.RaftTerm
+		return m.Aggregation
+	}
+	return Aggregation_NONE
+}
+
+func (m *TimeSeriesQuery) GetBucket() int64 {
+	if m != nil {
+		return m.Bucket
 	}
 	return 0
 }
 
-type AuthEnableRequest struct {
+// JSONPath object.
+type JSONPath struct {
+	Key  string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
 }
 
-func (m *AuthEnableRequest) Reset()                    { *m = AuthEnableRequest{} }
-func (m *AuthEnableRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthEnableRequest) ProtoMessage()               {}
-func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *JSONPath) Reset()                    { *m = JSONPath{} }
+func (m *JSONPath) String() string            { return proto.CompactTextString(m) }
+func (*JSONPath) ProtoMessage()               {}
+func (*JSONPath) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
-type AuthDisableRequest struct {
+func (m *JSONPath) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *AuthDisableRequest) Reset()                    { *m = AuthDisableRequest{} }
-func (m *AuthDisableRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthDisableRequest) ProtoMessage()               {}
-func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (m *JSONPath) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
 
-type AuthenticateRequest struct {
-	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+// JSONValue object.
+type JSONValue struct {
+	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Path  string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *AuthenticateRequest) Reset()                    { *m = AuthenticateRequest{} }
-func (m *AuthenticateRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthenticateRequest) ProtoMessage()               {}
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *JSONValue) Reset()                    { *m = JSONValue{} }
+func (m *JSONValue) String() string            { return proto.CompactTextString(m) }
+func (*JSONValue) ProtoMessage()               {}
+func (*JSONValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
-func (m *AuthenticateRequest) GetName() string {
+func (m *JSONValue) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-func (m *AuthenticateRequest) GetPassword() string {
+func (m *JSONValue) GetPath() string {
 	if m != nil {
-		return m.Password
+		return m.Path
 	}
 	return ""
 }
 
-type AuthUserAddRequest struct {
-	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+func (m *JSONValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *AuthUserAddRequest) Reset()                    { *m = AuthUserAddRequest{} }
-func (m *AuthUserAddRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserAddRequest) ProtoMessage()               {}
-func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+// JSONNumber object.
+type JSONNumber struct {
+	Key   string  `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Path  string  `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Value float64 `protobuf:"fixed64,3,opt,name=value" json:"value,omitempty"`
+}
 
-func (m *AuthUserAddRequest) GetName() string {
+func (m *JSONNumber) Reset()                    { *m = JSONNumber{} }
+func (m *JSONNumber) String() string            { return proto.CompactTextString(m) }
+func (*JSONNumber) ProtoMessage()               {}
+func (*JSONNumber) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *JSONNumber) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-func (m *AuthUserAddRequest) GetPassword() string {
+func (m *JSONNumber) GetPath() string {
 	if m != nil {
-		return m.Password
+		return m.Path
 	}
 	return ""
 }
 
-type AuthUserGetRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+func (m *JSONNumber) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func (m *AuthUserGetRequest) Reset()                    { *m = AuthUserGetRequest{} }
-func (m *AuthUserGetRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserGetRequest) ProtoMessage()               {}
-func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+// Vector object.
+type Vector struct {
+	Values []float32 `protobuf:"fixed32,1,rep,packed,name=values" json:"values,omitempty"`
+}
 
-func (m *AuthUserGetRequest) GetName() string {
+func (m *Vector) Reset()                    { *m = Vector{} }
+func (m *Vector) String() string            { return proto.CompactTextString(m) }
+func (*Vector) ProtoMessage()               {}
+func (*Vector) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *Vector) GetValues() []float32 {
 	if m != nil {
-		return m.Name
+		return m.Values
 	}
-	return ""
+	return nil
 }
 
-type AuthUserDeleteRequest struct {
-	// name is the name of the user to delete.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+// VectorEntry object.
+type VectorEntry struct {
+	Id      string    `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Values  []float32 `protobuf:"fixed32,2,rep,packed,name=values" json:"values,omitempty"`
+	HashKey string    `protobuf:"bytes,3,opt,name=hashKey" json:"hashKey,omitempty"`
+	List    int32     `protobuf:"varint,4,opt,name=list" json:"list,omitempty"`
 }
 
-func (m *AuthUserDeleteRequest) Reset()                    { *m = AuthUserDeleteRequest{} }
-func (m *AuthUserDeleteRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserDeleteRequest) ProtoMessage()               {}
-func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (m *VectorEntry) Reset()                    { *m = VectorEntry{} }
+func (m *VectorEntry) String() string            { return proto.CompactTextString(m) }
+func (*VectorEntry) ProtoMessage()               {}
+func (*VectorEntry) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
-func (m *AuthUserDeleteRequest) GetName() string {
+func (m *VectorEntry) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-type AuthUserChangePasswordRequest struct {
-	// name is the name of the user whose password is being changed.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	// password is the new password for the user.
-	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+func (m *VectorEntry) GetValues() []float32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *AuthUserChangePasswordRequest) Reset()                    { *m = AuthUserChangePasswordRequest{} }
-func (m *AuthUserChangePasswordRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserChangePasswordRequest) ProtoMessage()               {}
-func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
-
-func (m *AuthUserChangePasswordRequest) GetName() string {
+func (m *VectorEntry) GetHashKey() string {
 	if m != nil {
-		return m.Name
+		return m.HashKey
 	}
 	return ""
 }
 
-func (m *AuthUserChangePasswordRequest) GetPassword() string {
+func (m *VectorEntry) GetList() int32 {
 	if m != nil {
-		return m.Password
+		return m.List
 	}
-	return ""
+	return 0
 }
 
-type AuthUserGrantRoleRequest struct {
-	// user is the name of the user which should be granted a given role.
-	User string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
-	// role is the name of the role to grant to the user.
-	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+// VectorIndex object.
+type VectorIndex struct {
+	Key         string         `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Dimensions  int32          `protobuf:"varint,2,opt,name=dimensions" json:"dimensions,omitempty"`
+	Metric      VectorMetric   `protobuf:"varint,3,opt,name=metric,enum=pb.VectorMetric" json:"metric,omitempty"`
+	Lists       int32          `protobuf:"varint,4,opt,name=lists" json:"lists,omitempty"`
+	Entries     []*VectorEntry `protobuf:"bytes,5,rep,name=entries" json:"entries,omitempty"`
+	Centroids   []*Vector      `protobuf:"bytes,6,rep,name=centroids" json:"centroids,omitempty"`
+	TrainedSize int64          `protobuf:"varint,7,opt,name=trainedSize" json:"trainedSize,omitempty"`
+	Size        int64          `protobuf:"varint,8,opt,name=size" json:"size,omitempty"`
 }
 
-func (m *AuthUserGrantRoleRequest) Reset()                    { *m = AuthUserGrantRoleRequest{} }
-func (m *AuthUserGrantRoleRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserGrantRoleRequest) ProtoMessage()               {}
-func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *VectorIndex) Reset()                    { *m = VectorIndex{} }
+func (m *VectorIndex) String() string            { return proto.CompactTextString(m) }
+func (*VectorIndex) ProtoMessage()               {}
+func (*VectorIndex) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
-func (m *AuthUserGrantRoleRequest) GetUser() string {
+func (m *VectorIndex) GetKey() string {
 	if m != nil {
-		return m.User
+		return m.Key
 	}
 	return ""
 }
 
-func (m *AuthUserGrantRoleRequest) GetRole() string {
+func (m *VectorIndex) GetDimensions() int32 {
 	if m != nil {
-		return m.Role
+		return m.Dimensions
 	}
-	return ""
+	return 0
 }
 
-type AuthUserRevokeRoleRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+func (m *VectorIndex) GetMetric() VectorMetric {
+	if m != nil {
+		return m.Metric
+	}
+	return VectorMetric_COSINE
 }
 
-func (m *AuthUserRevokeRoleRequest) Reset()                    { *m = AuthUserRevokeRoleRequest{} }
-func (m *AuthUserRevokeRoleRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserRevokeRoleRequest) ProtoMessage()               {}
-func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
-
-func (m *AuthUserRevokeRoleRequest) GetName() string {
+func (m *VectorIndex) GetLists() int32 {
 	if m != nil {
-		return m.Name
+		return m.Lists
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthUserRevokeRoleRequest) GetRole() string {
+func (m *VectorIndex) GetEntries() []*VectorEntry {
 	if m != nil {
-		return m.Role
+		return m.Entries
 	}
-	return ""
+	return nil
 }
 
-type AuthRoleAddRequest struct {
-	// name is the name of the role to add to the authentication system.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+func (m *VectorIndex) GetCentroids() []*Vector {
+	if m != nil {
+		return m.Centroids
+	}
+	return nil
 }
 
-func (m *AuthRoleAddRequest) Reset()                    { *m = AuthRoleAddRequest{} }
-func (m *AuthRoleAddRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleAddRequest) ProtoMessage()               {}
-func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (m *VectorIndex) GetTrainedSize() int64 {
+	if m != nil {
+		return m.TrainedSize
+	}
+	return 0
+}
 
-func (m *AuthRoleAddRequest) GetName() string {
+func (m *VectorIndex) GetSize() int64 {
 	if m != nil {
-		return m.Name
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-type AuthRoleGetRequest struct {
-	Role string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+// VectorItem object.
+type VectorItem struct {
+	Key     string    `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Id      string    `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	Values  []float32 `protobuf:"fixed32,3,rep,packed,name=values" json:"values,omitempty"`
+	HashKey string    `protobuf:"bytes,4,opt,name=hashKey" json:"hashKey,omitempty"`
 }
 
-func (m *AuthRoleGetRequest) Reset()                    { *m = AuthRoleGetRequest{} }
-func (m *AuthRoleGetRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleGetRequest) ProtoMessage()               {}
-func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *VectorItem) Reset()                    { *m = VectorItem{} }
+func (m *VectorItem) String() string            { return proto.CompactTextString(m) }
+func (*VectorItem) ProtoMessage()               {}
+func (*VectorItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
-func (m *AuthRoleGetRequest) GetRole() string {
+func (m *VectorItem) GetKey() string {
 	if m != nil {
-		return m.Role
+		return m.Key
 	}
 	return ""
 }
 
-type AuthUserListRequest struct {
+func (m *VectorItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *AuthUserListRequest) Reset()                    { *m = AuthUserListRequest{} }
-func (m *AuthUserListRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserListRequest) ProtoMessage()               {}
-func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
-
-type AuthRoleListRequest struct {
+func (m *VectorItem) GetValues() []float32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *AuthRoleListRequest) Reset()                    { *m = AuthRoleListRequest{} }
-func (m *AuthRoleListRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleListRequest) ProtoMessage()               {}
-func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
-
-type AuthRoleDeleteRequest struct {
-	Role string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-}
-
-func (m *AuthRoleDeleteRequest) Reset()                    { *m = AuthRoleDeleteRequest{} }
-func (m *AuthRoleDeleteRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleDeleteRequest) ProtoMessage()               {}
-func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
-
-func (m *AuthRoleDeleteRequest) GetRole() string {
+func (m *VectorItem) GetHashKey() string {
 	if m != nil {
-		return m.Role
+		return m.HashKey
 	}
 	return ""
 }
 
-type AuthRoleGrantPermissionRequest struct {
-	// name is the name of the role which will be granted the permission.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	// perm is the permission to grant to the role.
-	Perm *Permission `protobuf:"bytes,2,opt,name=perm" json:"perm,omitempty"`
+// VectorQuery object.
+type VectorQuery struct {
+	Key         string            `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Values      []float32         `protobuf:"fixed32,2,rep,packed,name=values" json:"values,omitempty"`
+	K           int32             `protobuf:"varint,3,opt,name=k" json:"k,omitempty"`
+	Approximate bool              `protobuf:"varint,4,opt,name=approximate" json:"approximate,omitempty"`
+	Probes      int32             `protobuf:"varint,5,opt,name=probes" json:"probes,omitempty"`
+	Filter      map[string][]byte `protobuf:"bytes,6,rep,name=filter" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *AuthRoleGrantPermissionRequest) Reset()                    { *m = AuthRoleGrantPermissionRequest{} }
-func (m *AuthRoleGrantPermissionRequest) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleGrantPermissionRequest) ProtoMessage()               {}
-func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (m *VectorQuery) Reset()                    { *m = VectorQuery{} }
+func (m *VectorQuery) String() string            { return proto.CompactTextString(m) }
+func (*VectorQuery) ProtoMessage()               {}
+func (*VectorQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
-func (m *AuthRoleGrantPermissionRequest) GetName() string {
+func (m *VectorQuery) GetKey() string {
 	if m != nil {
-		return m.Name
+		return m.Key
 	}
 	return ""
 }
 
-func (m *AuthRoleGrantPermissionRequest) GetPerm() *Permission {
+func (m *VectorQuery) GetValues() []float32 {
 	if m != nil {
-		return m.Perm
+		return m.Values
 	}
 	return nil
 }
 
-type AuthRoleRevokePermissionRequest struct {
-	Role     string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-	Key      string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
-	RangeEnd string `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd" json:"range_end,omitempty"`
-}
-
-func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokePermissionRequest{} }
-func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
-func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{34}
-}
-
-func (m *AuthRoleRevokePermissionRequest) GetRole() string {
+func (m *VectorQuery) GetK() int32 {
 	if m != nil {
-		return m.Role
+		return m.K
 	}
-	return ""
+	return 0
 }
 
-func (m *AuthRoleRevokePermissionRequest) GetKey() string {
+func (m *VectorQuery) GetApproximate() bool {
 	if m != nil {
-		return m.Key
+		return m.Approximate
 	}
-	return ""
+	return false
 }
 
-func (m *AuthRoleRevokePermissionRequest) GetRangeEnd() string {
+func (m *VectorQuery) GetProbes() int32 {
 	if m != nil {
-		return m.RangeEnd
+		return m.Probes
 	}
-	return ""
-}
-
-type AuthEnableResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	return 0
 }
 
-func (m *AuthEnableResponse) Reset()                    { *m = AuthEnableResponse{} }
-func (m *AuthEnableResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthEnableResponse) ProtoMessage()               {}
-func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
-
-func (m *AuthEnableResponse) GetHeader() *ResponseHeader {
+func (m *VectorQuery) GetFilter() map[string][]byte {
 	if m != nil {
-		return m.Header
+		return m.Filter
 	}
 	return nil
 }
 
-type AuthDisableResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// VectorMatch object.
+type VectorMatch struct {
+	Id      string  `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Score   float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
+	HashKey string  `protobuf:"bytes,3,opt,name=hashKey" json:"hashKey,omitempty"`
 }
 
-func (m *AuthDisableResponse) Reset()                    { *m = AuthDisableResponse{} }
-func (m *AuthDisableResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthDisableResponse) ProtoMessage()               {}
-func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (m *VectorMatch) Reset()                    { *m = VectorMatch{} }
+func (m *VectorMatch) String() string            { return proto.CompactTextString(m) }
+func (*VectorMatch) ProtoMessage()               {}
+func (*VectorMatch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
-func (m *AuthDisableResponse) GetHeader() *ResponseHeader {
+func (m *VectorMatch) GetId() string {
 	if m != nil {
-		return m.Header
+		return m.Id
 	}
-	return nil
-}
-
-type AuthenticateResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	// token is an authorized token that can be used in succeeding RPCs
-	Token string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	return ""
 }
 
-func (m *AuthenticateResponse) Reset()                    { *m = AuthenticateResponse{} }
-func (m *AuthenticateResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthenticateResponse) ProtoMessage()               {}
-func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
-
-func (m *AuthenticateResponse) GetHeader() *ResponseHeader {
+func (m *VectorMatch) GetScore() float64 {
 	if m != nil {
-		return m.Header
+		return m.Score
 	}
-	return nil
+	return 0
 }
 
-func (m *AuthenticateResponse) GetToken() string {
+func (m *VectorMatch) GetHashKey() string {
 	if m != nil {
-		return m.Token
+		return m.HashKey
 	}
 	return ""
 }
 
-type AuthUserAddResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// VectorMatches object.
+type VectorMatches struct {
+	Matches []*VectorMatch `protobuf:"bytes,1,rep,name=matches" json:"matches,omitempty"`
 }
 
-func (m *AuthUserAddResponse) Reset()                    { *m = AuthUserAddResponse{} }
-func (m *AuthUserAddResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserAddResponse) ProtoMessage()               {}
-func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (m *VectorMatches) Reset()                    { *m = VectorMatches{} }
+func (m *VectorMatches) String() string            { return proto.CompactTextString(m) }
+func (*VectorMatches) ProtoMessage()               {}
+func (*VectorMatches) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
-func (m *AuthUserAddResponse) GetHeader() *ResponseHeader {
+func (m *VectorMatches) GetMatches() []*VectorMatch {
 	if m != nil {
-		return m.Header
+		return m.Matches
 	}
 	return nil
 }
 
-type AuthUserGetResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Roles  []string        `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+// SearchPosting object.
+type SearchPosting struct {
+	Key       string  `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Field     string  `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+	Positions []int32 `protobuf:"varint,3,rep,packed,name=positions" json:"positions,omitempty"`
 }
 
-func (m *AuthUserGetResponse) Reset()                    { *m = AuthUserGetResponse{} }
-func (m *AuthUserGetResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserGetResponse) ProtoMessage()               {}
-func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (m *SearchPosting) Reset()                    { *m = SearchPosting{} }
+func (m *SearchPosting) String() string            { return proto.CompactTextString(m) }
+func (*SearchPosting) ProtoMessage()               {}
+func (*SearchPosting) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
-func (m *AuthUserGetResponse) GetHeader() *ResponseHeader {
+func (m *SearchPosting) GetKey() string {
 	if m != nil {
-		return m.Header
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *AuthUserGetResponse) GetRoles() []string {
+func (m *SearchPosting) GetField() string {
 	if m != nil {
-		return m.Roles
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SearchPosting) GetPositions() []int32 {
+	if m != nil {
+		return m.Positions
 	}
 	return nil
 }
 
-type AuthUserDeleteResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// SearchPostings object.
+type SearchPostings struct {
+	Postings []*SearchPosting `protobuf:"bytes,1,rep,name=postings" json:"postings,omitempty"`
 }
 
-func (m *AuthUserDeleteResponse) Reset()                    { *m = AuthUserDeleteResponse{} }
-func (m *AuthUserDeleteResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserDeleteResponse) ProtoMessage()               {}
-func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (m *SearchPostings) Reset()                    { *m = SearchPostings{} }
+func (m *SearchPostings) String() string            { return proto.CompactTextString(m) }
+func (*SearchPostings) ProtoMessage()               {}
+func (*SearchPostings) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
-func (m *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
+func (m *SearchPostings) GetPostings() []*SearchPosting {
 	if m != nil {
-		return m.Header
+		return m.Postings
 	}
 	return nil
 }
 
-type AuthUserChangePasswordResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// SearchDocument object.
+type SearchDocument struct {
+	Length int32 `protobuf:"varint,1,opt,name=length" json:"length,omitempty"`
 }
 
-func (m *AuthUserChangePasswordResponse) Reset()                    { *m = AuthUserChangePasswordResponse{} }
-func (m *AuthUserChangePasswordResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserChangePasswordResponse) ProtoMessage()               {}
-func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *SearchDocument) Reset()                    { *m = SearchDocument{} }
+func (m *SearchDocument) String() string            { return proto.CompactTextString(m) }
+func (*SearchDocument) ProtoMessage()               {}
+func (*SearchDocument) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
-func (m *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
+func (m *SearchDocument) GetLength() int32 {
 	if m != nil {
-		return m.Header
+		return m.Length
 	}
-	return nil
+	return 0
 }
 
-type AuthUserGrantRoleResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// SearchIndex object.
+type SearchIndex struct {
+	Key    string   `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Prefix string   `protobuf:"bytes,2,opt,name=prefix" json:"prefix,omitempty"`
+	Fields []string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
 }
 
-func (m *AuthUserGrantRoleResponse) Reset()                    { *m = AuthUserGrantRoleResponse{} }
-func (m *AuthUserGrantRoleResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserGrantRoleResponse) ProtoMessage()               {}
-func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (m *SearchIndex) Reset()                    { *m = SearchIndex{} }
+func (m *SearchIndex) String() string            { return proto.CompactTextString(m) }
+func (*SearchIndex) ProtoMessage()               {}
+func (*SearchIndex) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
-func (m *AuthUserGrantRoleResponse) GetHeader() *ResponseHeader {
+func (m *SearchIndex) GetKey() string {
 	if m != nil {
-		return m.Header
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type AuthUserRevokeRoleResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+func (m *SearchIndex) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
-func (m *AuthUserRevokeRoleResponse) Reset()                    { *m = AuthUserRevokeRoleResponse{} }
-func (m *AuthUserRevokeRoleResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserRevokeRoleResponse) ProtoMessage()               {}
-func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
-
-func (m *AuthUserRevokeRoleResponse) GetHeader() *ResponseHeader {
+func (m *SearchIndex) GetFields() []string {
 	if m != nil {
-		return m.Header
+		return m.Fields
 	}
 	return nil
 }
 
-type AuthRoleAddResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// SearchQuery object.
+type SearchQuery struct {
+	Key    string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Query  string `protobuf:"bytes,2,opt,name=query" json:"query,omitempty"`
+	Offset int32  `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Limit  int32  `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
 }
 
-func (m *AuthRoleAddResponse) Reset()                    { *m = AuthRoleAddResponse{} }
-func (m *AuthRoleAddResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleAddResponse) ProtoMessage()               {}
-func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (m *SearchQuery) Reset()                    { *m = SearchQuery{} }
+func (m *SearchQuery) String() string            { return proto.CompactTextString(m) }
+func (*SearchQuery) ProtoMessage()               {}
+func (*SearchQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
-func (m *AuthRoleAddResponse) GetHeader() *ResponseHeader {
+func (m *SearchQuery) GetKey() string {
 	if m != nil {
-		return m.Header
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type AuthRoleGetResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Perm   []*Permission   `protobuf:"bytes,2,rep,name=perm" json:"perm,omitempty"`
+func (m *SearchQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
 
-func (m *AuthRoleGetResponse) Reset()                    { *m = AuthRoleGetResponse{} }
-func (m *AuthRoleGetResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleGetResponse) ProtoMessage()               {}
-func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
-
-func (m *AuthRoleGetResponse) GetHeader() *ResponseHeader {
+func (m *SearchQuery) GetOffset() int32 {
 	if m != nil {
-		return m.Header
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *AuthRoleGetResponse) GetPerm() []*Permission {
+func (m *SearchQuery) GetLimit() int32 {
 	if m != nil {
-		return m.Perm
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-type AuthRoleListResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Roles  []string        `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+// SearchResult object.
+type SearchResult struct {
+	Key   string  `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Score float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
 }
 
-func (m *AuthRoleListResponse) Reset()                    { *m = AuthRoleListResponse{} }
-func (m *AuthRoleListResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleListResponse) ProtoMessage()               {}
-func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (m *SearchResult) Reset()                    { *m = SearchResult{} }
+func (m *SearchResult) String() string            { return proto.CompactTextString(m) }
+func (*SearchResult) ProtoMessage()               {}
+func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
-func (m *AuthRoleListResponse) GetHeader() *ResponseHeader {
+func (m *SearchResult) GetKey() string {
 	if m != nil {
-		return m.Header
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-func (m *AuthRoleListResponse) GetRoles() []string {
+func (m *SearchResult) GetScore() float64 {
 	if m != nil {
-		return m.Roles
+		return m.Score
 	}
-	return nil
+	return 0
 }
 
-type AuthUserListResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Users  []string        `protobuf:"bytes,2,rep,name=users" json:"users,omitempty"`
+// SearchResults object.
+type SearchResults struct {
+	Total   int64           `protobuf:"varint,1,opt,name=total" json:"total,omitempty"`
+	Results []*SearchResult `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
 }
 
-func (m *AuthUserListResponse) Reset()                    { *m = AuthUserListResponse{} }
-func (m *AuthUserListResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthUserListResponse) ProtoMessage()               {}
-func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (m *SearchResults) Reset()                    { *m = SearchResults{} }
+func (m *SearchResults) String() string            { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()               {}
+func (*SearchResults) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
-func (m *AuthUserListResponse) GetHeader() *ResponseHeader {
+func (m *SearchResults) GetTotal() int64 {
 	if m != nil {
-		return m.Header
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-func (m *AuthUserListResponse) GetUsers() []string {
+func (m *SearchResults) GetResults() []*SearchResult {
 	if m != nil {
-		return m.Users
+		return m.Results
 	}
 	return nil
 }
 
-type AuthRoleDeleteResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// FieldIndex object.
+type FieldIndex struct {
+	Prefix  string `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
+	Field   string `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+	Numeric bool   `protobuf:"varint,3,opt,name=numeric" json:"numeric,omitempty"`
 }
 
-func (m *AuthRoleDeleteResponse) Reset()                    { *m = AuthRoleDeleteResponse{} }
-func (m *AuthRoleDeleteResponse) String() string            { return proto.CompactTextString(m) }
-func (*AuthRoleDeleteResponse) ProtoMessage()               {}
-func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (m *FieldIndex) Reset()                    { *m = FieldIndex{} }
+func (m *FieldIndex) String() string            { return proto.CompactTextString(m) }
+func (*FieldIndex) ProtoMessage()               {}
+func (*FieldIndex) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
-func (m *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
+func (m *FieldIndex) GetPrefix() string {
 	if m != nil {
-		return m.Header
+		return m.Prefix
 	}
-	return nil
+	return ""
 }
 
-type AuthRoleGrantPermissionResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+func (m *FieldIndex) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
 }
 
-func (m *AuthRoleGrantPermissionResponse) Reset()         { *m = AuthRoleGrantPermissionResponse{} }
-func (m *AuthRoleGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleGrantPermissionResponse) ProtoMessage()    {}
-func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{49}
+func (m *FieldIndex) GetNumeric() bool {
+	if m != nil {
+		return m.Numeric
+	}
+	return false
 }
 
-func (m *AuthRoleGrantPermissionResponse) GetHeader() *ResponseHeader {
+// FieldIndexes object.
+type FieldIndexes struct {
+	Indexes []*FieldIndex `protobuf:"bytes,1,rep,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *FieldIndexes) Reset()                    { *m = FieldIndexes{} }
+func (m *FieldIndexes) String() string            { return proto.CompactTextString(m) }
+func (*FieldIndexes) ProtoMessage()               {}
+func (*FieldIndexes) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+
+func (m *FieldIndexes) GetIndexes() []*FieldIndex {
 	if m != nil {
-		return m.Header
+		return m.Indexes
 	}
 	return nil
 }
 
-type AuthRoleRevokePermissionResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+// FieldQuery object.
+type FieldQuery struct {
+	Prefix string  `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"`
+	Field  string  `protobuf:"bytes,2,opt,name=field" json:"field,omitempty"`
+	Value  []byte  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Min    float64 `protobuf:"fixed64,4,opt,name=min" json:"min,omitempty"`
+	Max    float64 `protobuf:"fixed64,5,opt,name=max" json:"max,omitempty"`
+	Limit  int64   `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`
+	NoMin  bool    `protobuf:"varint,7,opt,name=noMin" json:"noMin,omitempty"`
+	NoMax  bool    `protobuf:"varint,8,opt,name=noMax" json:"noMax,omitempty"`
 }
 
-func (m *AuthRoleRevokePermissionResponse) Reset()         { *m = AuthRoleRevokePermissionResponse{} }
-func (m *AuthRoleRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthRoleRevokePermissionResponse) ProtoMessage()    {}
-func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{50}
+func (m *FieldQuery) Reset()                    { *m = FieldQuery{} }
+func (m *FieldQuery) String() string            { return proto.CompactTextString(m) }
+func (*FieldQuery) ProtoMessage()               {}
+func (*FieldQuery) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *FieldQuery) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
-func (m *AuthRoleRevokePermissionResponse) GetHeader() *ResponseHeader {
+func (m *FieldQuery) GetField() string {
 	if m != nil {
-		return m.Header
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldQuery) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
 	return nil
 }
 
-func init() {
+func (m *FieldQuery) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *FieldQuery) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *FieldQuery) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FieldQuery) GetNoMin() bool {
+	if m != nil {
+		return m.NoMin
+	}
+	return false
+}
+
+func (m *FieldQuery) GetNoMax() bool {
+	if m != nil {
+		return m.NoMax
+	}
+	return false
+}
+
+// RevisionValue object.
+type RevisionValue struct {
+	Key         string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Value       []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ModRevision int64  `protobuf:"varint,3,opt,name=modRevision" json:"modRevision,omitempty"`
+}
+
+func (m *RevisionValue) Reset()                    { *m = RevisionValue{} }
+func (m *RevisionValue) String() string            { return proto.CompactTextString(m) }
+func (*RevisionValue) ProtoMessage()               {}
+func (*RevisionValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *RevisionValue) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RevisionValue) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *RevisionValue) GetModRevision() int64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+// RevisionValues object.
+type RevisionValues struct {
+	Values []*RevisionValue `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *RevisionValues) Reset()                    { *m = RevisionValues{} }
+func (m *RevisionValues) String() string            { return proto.CompactTextString(m) }
+func (*RevisionValues) ProtoMessage()               {}
+func (*RevisionValues) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *RevisionValues) GetValues() []*RevisionValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// CompareAndSwapRequest object.
+type CompareAndSwapRequest struct {
+	Key      string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Expected []byte `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Value    []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *CompareAndSwapRequest) Reset()                    { *m = CompareAndSwapRequest{} }
+func (m *CompareAndSwapRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompareAndSwapRequest) ProtoMessage()               {}
+func (*CompareAndSwapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *CompareAndSwapRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CompareAndSwapRequest) GetExpected() []byte {
+	if m != nil {
+		return m.Expected
+	}
+	return nil
+}
+
+func (m *CompareAndSwapRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// SwapResult object.
+type SwapResult struct {
+	Swapped     bool  `protobuf:"varint,1,opt,name=swapped" json:"swapped,omitempty"`
+	ModRevision int64 `protobuf:"varint,2,opt,name=modRevision" json:"modRevision,omitempty"`
+}
+
+func (m *SwapResult) Reset()                    { *m = SwapResult{} }
+func (m *SwapResult) String() string            { return proto.CompactTextString(m) }
+func (*SwapResult) ProtoMessage()               {}
+func (*SwapResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *SwapResult) GetSwapped() bool {
+	if m != nil {
+		return m.Swapped
+	}
+	return false
+}
+
+func (m *SwapResult) GetModRevision() int64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+// TxnCompare object.
+type TxnCompare struct {
+	Type        TxnCompare_Type `protobuf:"varint,1,opt,name=type,enum=pb.TxnCompare_Type" json:"type,omitempty"`
+	Key         string          `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	Value       []byte          `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Int         int64           `protobuf:"varint,4,opt,name=int" json:"int,omitempty"`
+	ModRevision int64           `protobuf:"varint,5,opt,name=modRevision" json:"modRevision,omitempty"`
+	Field       string          `protobuf:"bytes,6,opt,name=field" json:"field,omitempty"`
+	Not         bool            `protobuf:"varint,7,opt,name=not" json:"not,omitempty"`
+}
+
+func (m *TxnCompare) Reset()                    { *m = TxnCompare{} }
+func (m *TxnCompare) String() string            { return proto.CompactTextString(m) }
+func (*TxnCompare) ProtoMessage()               {}
+func (*TxnCompare) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+
+func (m *TxnCompare) GetType() TxnCompare_Type {
+	if m != nil {
+		return m.Type
+	}
+	return TxnCompare_EXISTS
+}
+
+func (m *TxnCompare) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TxnCompare) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TxnCompare) GetInt() int64 {
+	if m != nil {
+		return m.Int
+	}
+	return 0
+}
+
+func (m *TxnCompare) GetModRevision() int64 {
+	if m != nil {
+		return m.ModRevision
+	}
+	return 0
+}
+
+func (m *TxnCompare) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *TxnCompare) GetNot() bool {
+	if m != nil {
+		return m.Not
+	}
+	return false
+}
+
+// TxnOp object.
+type TxnOp struct {
+	Type  TxnOp_Type `protobuf:"varint,1,opt,name=type,enum=pb.TxnOp_Type" json:"type,omitempty"`
+	Key   string     `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	Value []byte     `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Int   int64      `protobuf:"varint,4,opt,name=int" json:"int,omitempty"`
+	Field string     `protobuf:"bytes,5,opt,name=field" json:"field,omitempty"`
+}
+
+func (m *TxnOp) Reset()                    { *m = TxnOp{} }
+func (m *TxnOp) String() string            { return proto.CompactTextString(m) }
+func (*TxnOp) ProtoMessage()               {}
+func (*TxnOp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+
+func (m *TxnOp) GetType() TxnOp_Type {
+	if m != nil {
+		return m.Type
+	}
+	return TxnOp_SET
+}
+
+func (m *TxnOp) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *TxnOp) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TxnOp) GetInt() int64 {
+	if m != nil {
+		return m.Int
+	}
+	return 0
+}
+
+func (m *TxnOp) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+// TxnRequest object.
+type TxnRequest struct {
+	Compare []*TxnCompare `protobuf:"bytes,1,rep,name=compare" json:"compare,omitempty"`
+	Success []*TxnOp      `protobuf:"bytes,2,rep,name=success" json:"success,omitempty"`
+	Failure []*TxnOp      `protobuf:"bytes,3,rep,name=failure" json:"failure,omitempty"`
+}
+
+func (m *TxnRequest) Reset()                    { *m = TxnRequest{} }
+func (m *TxnRequest) String() string            { return proto.CompactTextString(m) }
+func (*TxnRequest) ProtoMessage()               {}
+func (*TxnRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *TxnRequest) GetCompare() []*TxnCompare {
+	if m != nil {
+		return m.Compare
+	}
+	return nil
+}
+
+func (m *TxnRequest) GetSuccess() []*TxnOp {
+	if m != nil {
+		return m.Success
+	}
+	return nil
+}
+
+func (m *TxnRequest) GetFailure() []*TxnOp {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+// TxnResponse object.
+type TxnResponse struct {
+	Succeeded bool         `protobuf:"varint,1,opt,name=succeeded" json:"succeeded,omitempty"`
+	Results   []*ByteValue `protobuf:"bytes,2,rep,name=results" json:"results,omitempty"`
+	Revision  int64        `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
+}
+
+func (m *TxnResponse) Reset()                    { *m = TxnResponse{} }
+func (m *TxnResponse) String() string            { return proto.CompactTextString(m) }
+func (*TxnResponse) ProtoMessage()               {}
+func (*TxnResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *TxnResponse) GetSucceeded() bool {
+	if m != nil {
+		return m.Succeeded
+	}
+	return false
+}
+
+func (m *TxnResponse) GetResults() []*ByteValue {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *TxnResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// Script object.
+type Script struct {
+	Script string `protobuf:"bytes,1,opt,name=script" json:"script,omitempty"`
+	Sha    string `protobuf:"bytes,2,opt,name=sha" json:"sha,omitempty"`
+}
+
+func (m *Script) Reset()                    { *m = Script{} }
+func (m *Script) String() string            { return proto.CompactTextString(m) }
+func (*Script) ProtoMessage()               {}
+func (*Script) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *Script) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *Script) GetSha() string {
+	if m != nil {
+		return m.Sha
+	}
+	return ""
+}
+
+// EvalRequest object.
+type EvalRequest struct {
+	Script string   `protobuf:"bytes,1,opt,name=script" json:"script,omitempty"`
+	Sha    string   `protobuf:"bytes,2,opt,name=sha" json:"sha,omitempty"`
+	Keys   []string `protobuf:"bytes,3,rep,name=keys" json:"keys,omitempty"`
+	Args   []string `protobuf:"bytes,4,rep,name=args" json:"args,omitempty"`
+}
+
+func (m *EvalRequest) Reset()                    { *m = EvalRequest{} }
+func (m *EvalRequest) String() string            { return proto.CompactTextString(m) }
+func (*EvalRequest) ProtoMessage()               {}
+func (*EvalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *EvalRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *EvalRequest) GetSha() string {
+	if m != nil {
+		return m.Sha
+	}
+	return ""
+}
+
+func (m *EvalRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *EvalRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// EvalResponse object.
+type EvalResponse struct {
+	Value    []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision" json:"revision,omitempty"`
+}
+
+func (m *EvalResponse) Reset()                    { *m = EvalResponse{} }
+func (m *EvalResponse) String() string            { return proto.CompactTextString(m) }
+func (*EvalResponse) ProtoMessage()               {}
+func (*EvalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *EvalResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *EvalResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// CommandRequest object.
+type CommandRequest struct {
+	Name string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Args [][]byte `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *CommandRequest) Reset()                    { *m = CommandRequest{} }
+func (m *CommandRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommandRequest) ProtoMessage()               {}
+func (*CommandRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *CommandRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CommandRequest) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// WatchRequest object.
+type WatchRequest struct {
+	Key          string              `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	Prefix       bool                `protobuf:"varint,2,opt,name=prefix" json:"prefix,omitempty"`
+	Rev          int64               `protobuf:"varint,3,opt,name=rev" json:"rev,omitempty"`
+	Id           int64               `protobuf:"varint,4,opt,name=id" json:"id,omitempty"`
+	Cancel       bool                `protobuf:"varint,5,opt,name=cancel" json:"cancel,omitempty"`
+	Reasons      []Event_Reason      `protobuf:"varint,6,rep,packed,name=reasons,enum=pb.Event_Reason" json:"reasons,omitempty"`
+	Types        []Event_EventType   `protobuf:"varint,7,rep,packed,name=types,enum=pb.Event_EventType" json:"types,omitempty"`
+	Glob         string              `protobuf:"bytes,8,opt,name=glob" json:"glob,omitempty"`
+	Filters      []*WatchFilter      `protobuf:"bytes,9,rep,name=filters" json:"filters,omitempty"`
+	Subscription int64               `protobuf:"varint,10,opt,name=subscription" json:"subscription,omitempty"`
+	Deltas       WatchRequest_Deltas `protobuf:"varint,11,opt,name=deltas,enum=pb.WatchRequest_Deltas" json:"deltas,omitempty"`
+}
+
+func (m *WatchRequest) Reset()                    { *m = WatchRequest{} }
+func (m *WatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()               {}
+func (*WatchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *WatchRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetPrefix() bool {
+	if m != nil {
+		return m.Prefix
+	}
+	return false
+}
+
+func (m *WatchRequest) GetRev() int64 {
+	if m != nil {
+		return m.Rev
+	}
+	return 0
+}
+
+func (m *WatchRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WatchRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+func (m *WatchRequest) GetReasons() []Event_Reason {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetTypes() []Event_EventType {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetFilters() []*WatchFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetSubscription() int64 {
+	if m != nil {
+		return m.Subscription
+	}
+	return 0
+}
+
+func (m *WatchRequest) GetDeltas() WatchRequest_Deltas {
+	if m != nil {
+		return m.Deltas
+	}
+	return WatchRequest_NONE
+}
+
+// WatchFilter object.
+type WatchFilter struct {
+	Type  WatchFilter_Type `protobuf:"varint,1,opt,name=type,enum=pb.WatchFilter_Type" json:"type,omitempty"`
+	Value []byte           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Int   int64            `protobuf:"varint,3,opt,name=int" json:"int,omitempty"`
+	Field string           `protobuf:"bytes,4,opt,name=field" json:"field,omitempty"`
+	Not   bool             `protobuf:"varint,5,opt,name=not" json:"not,omitempty"`
+}
+
+func (m *WatchFilter) Reset()                    { *m = WatchFilter{} }
+func (m *WatchFilter) String() string            { return proto.CompactTextString(m) }
+func (*WatchFilter) ProtoMessage()               {}
+func (*WatchFilter) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *WatchFilter) GetType() WatchFilter_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchFilter_VALUE_EQUAL
+}
+
+func (m *WatchFilter) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *WatchFilter) GetInt() int64 {
+	if m != nil {
+		return m.Int
+	}
+	return 0
+}
+
+func (m *WatchFilter) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *WatchFilter) GetNot() bool {
+	if m != nil {
+		return m.Not
+	}
+	return false
+}
+
+// Event object.
+type Event struct {
+	Type         Event_EventType `protobuf:"varint,1,opt,name=type,enum=pb.Event_EventType" json:"type,omitempty"`
+	Current      *ByteValue      `protobuf:"bytes,3,opt,name=current" json:"current,omitempty"`
+	Previous     *ByteValue      `protobuf:"bytes,4,opt,name=previous" json:"previous,omitempty"`
+	Reason       Event_Reason    `protobuf:"varint,5,opt,name=reason,enum=pb.Event_Reason" json:"reason,omitempty"`
+	Rev          int64           `protobuf:"varint,6,opt,name=rev" json:"rev,omitempty"`
+	WatchKey     string          `protobuf:"bytes,7,opt,name=watchKey" json:"watchKey,omitempty"`
+	WatchPrefix  bool            `protobuf:"varint,8,opt,name=watchPrefix" json:"watchPrefix,omitempty"`
+	Subscription int64           `protobuf:"varint,9,opt,name=subscription" json:"subscription,omitempty"`
+	Deltas       []*Delta        `protobuf:"bytes,10,rep,name=deltas" json:"deltas,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *Event) GetType() Event_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return Event_PUT
+}
+
+func (m *Event) GetCurrent() *ByteValue {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *Event) GetPrevious() *ByteValue {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *Event) GetReason() Event_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Event_WRITTEN
+}
+
+func (m *Event) GetRev() int64 {
+	if m != nil {
+		return m.Rev
+	}
+	return 0
+}
+
+func (m *Event) GetWatchKey() string {
+	if m != nil {
+		return m.WatchKey
+	}
+	return ""
+}
+
+func (m *Event) GetWatchPrefix() bool {
+	if m != nil {
+		return m.WatchPrefix
+	}
+	return false
+}
+
+func (m *Event) GetSubscription() int64 {
+	if m != nil {
+		return m.Subscription
+	}
+	return 0
+}
+
+func (m *Event) GetDeltas() []*Delta {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+// Delta object.
+type Delta struct {
+	Type  Delta_Type `protobuf:"varint,1,opt,name=type,enum=pb.Delta_Type" json:"type,omitempty"`
+	Index int64      `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+	Field string     `protobuf:"bytes,3,opt,name=field" json:"field,omitempty"`
+	Value []byte     `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Delta) Reset()                    { *m = Delta{} }
+func (m *Delta) String() string            { return proto.CompactTextString(m) }
+func (*Delta) ProtoMessage()               {}
+func (*Delta) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+
+func (m *Delta) GetType() Delta_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Delta_LIST_PUSH
+}
+
+func (m *Delta) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Delta) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Delta) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// -- Etcd auth passthrough messages
+// Permission is a single entity
+type Permission struct {
+	PermType Permission_Type `protobuf:"varint,1,opt,name=permType,enum=pb.Permission_Type" json:"permType,omitempty"`
+	Key      []byte          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RangeEnd []byte          `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+}
+
+func (m *Permission) Reset()                    { *m = Permission{} }
+func (m *Permission) String() string            { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()               {}
+func (*Permission) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *Permission) GetPermType() Permission_Type {
+	if m != nil {
+		return m.PermType
+	}
+	return Permission_READ
+}
+
+func (m *Permission) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *Permission) GetRangeEnd() []byte {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return nil
+}
+
+type ResponseHeader struct {
+	// cluster_id is the ID of the cluster which sent the response.
+	ClusterId uint64 `protobuf:"varint,1,opt,name=cluster_id,json=clusterId" json:"cluster_id,omitempty"`
+	// member_id is the ID of the member which sent the response.
+	MemberId uint64 `protobuf:"varint,2,opt,name=member_id,json=memberId" json:"member_id,omitempty"`
+	// revision is the key-value store revision when the request was applied.
+	Revision int64 `protobuf:"varint,3,opt,name=revision" json:"revision,omitempty"`
+	// raft_term is the raft term when the request was applied.
+	RaftTerm uint64 `protobuf:"varint,4,opt,name=raft_term,json=raftTerm" json:"raft_term,omitempty"`
+}
+
+func (m *ResponseHeader) Reset()                    { *m = ResponseHeader{} }
+func (m *ResponseHeader) String() string            { return proto.CompactTextString(m) }
+func (*ResponseHeader) ProtoMessage()               {}
+func (*ResponseHeader) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *ResponseHeader) GetClusterId() uint64 {
+	if m != nil {
+		return m.ClusterId
+	}
+	return 0
+}
+
+func (m *ResponseHeader) GetMemberId() uint64 {
+	if m != nil {
+		return m.MemberId
+	}
+	return 0
+}
+
+func (m *ResponseHeader) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ResponseHeader) GetRaftTerm() uint64 {
+	if m != nil {
+		return m.RaftTerm
+	}
+	return 0
+}
+
+type AuthEnableRequest struct {
+}
+
+func (m *AuthEnableRequest) Reset()                    { *m = AuthEnableRequest{} }
+func (m *AuthEnableRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthEnableRequest) ProtoMessage()               {}
+func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+type AuthDisableRequest struct {
+}
+
+func (m *AuthDisableRequest) Reset()                    { *m = AuthDisableRequest{} }
+func (m *AuthDisableRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthDisableRequest) ProtoMessage()               {}
+func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
+type AuthenticateRequest struct {
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *AuthenticateRequest) Reset()                    { *m = AuthenticateRequest{} }
+func (m *AuthenticateRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthenticateRequest) ProtoMessage()               {}
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *AuthenticateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthenticateRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AuthUserAddRequest struct {
+	Name     string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *AuthUserAddRequest) Reset()                    { *m = AuthUserAddRequest{} }
+func (m *AuthUserAddRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserAddRequest) ProtoMessage()               {}
+func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *AuthUserAddRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthUserAddRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AuthUserGetRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *AuthUserGetRequest) Reset()                    { *m = AuthUserGetRequest{} }
+func (m *AuthUserGetRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserGetRequest) ProtoMessage()               {}
+func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+
+func (m *AuthUserGetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AuthUserDeleteRequest struct {
+	// name is the name of the user to delete.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *AuthUserDeleteRequest) Reset()                    { *m = AuthUserDeleteRequest{} }
+func (m *AuthUserDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserDeleteRequest) ProtoMessage()               {}
+func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+
+func (m *AuthUserDeleteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AuthUserChangePasswordRequest struct {
+	// name is the name of the user whose password is being changed.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// password is the new password for the user.
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *AuthUserChangePasswordRequest) Reset()                    { *m = AuthUserChangePasswordRequest{} }
+func (m *AuthUserChangePasswordRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserChangePasswordRequest) ProtoMessage()               {}
+func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+
+func (m *AuthUserChangePasswordRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthUserChangePasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AuthUserGrantRoleRequest struct {
+	// user is the name of the user which should be granted a given role.
+	User string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	// role is the name of the role to grant to the user.
+	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *AuthUserGrantRoleRequest) Reset()                    { *m = AuthUserGrantRoleRequest{} }
+func (m *AuthUserGrantRoleRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserGrantRoleRequest) ProtoMessage()               {}
+func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+
+func (m *AuthUserGrantRoleRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *AuthUserGrantRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthUserRevokeRoleRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *AuthUserRevokeRoleRequest) Reset()                    { *m = AuthUserRevokeRoleRequest{} }
+func (m *AuthUserRevokeRoleRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserRevokeRoleRequest) ProtoMessage()               {}
+func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+
+func (m *AuthUserRevokeRoleRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthUserRevokeRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthRoleAddRequest struct {
+	// name is the name of the role to add to the authentication system.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *AuthRoleAddRequest) Reset()                    { *m = AuthRoleAddRequest{} }
+func (m *AuthRoleAddRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleAddRequest) ProtoMessage()               {}
+func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+
+func (m *AuthRoleAddRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AuthRoleGetRequest struct {
+	Role string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *AuthRoleGetRequest) Reset()                    { *m = AuthRoleGetRequest{} }
+func (m *AuthRoleGetRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleGetRequest) ProtoMessage()               {}
+func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+
+func (m *AuthRoleGetRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthUserListRequest struct {
+}
+
+func (m *AuthUserListRequest) Reset()                    { *m = AuthUserListRequest{} }
+func (m *AuthUserListRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserListRequest) ProtoMessage()               {}
+func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+
+type AuthRoleListRequest struct {
+}
+
+func (m *AuthRoleListRequest) Reset()                    { *m = AuthRoleListRequest{} }
+func (m *AuthRoleListRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleListRequest) ProtoMessage()               {}
+func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+
+type AuthRoleDeleteRequest struct {
+	Role string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+}
+
+func (m *AuthRoleDeleteRequest) Reset()                    { *m = AuthRoleDeleteRequest{} }
+func (m *AuthRoleDeleteRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleDeleteRequest) ProtoMessage()               {}
+func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+
+func (m *AuthRoleDeleteRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type AuthRoleGrantPermissionRequest struct {
+	// name is the name of the role which will be granted the permission.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// perm is the permission to grant to the role.
+	Perm *Permission `protobuf:"bytes,2,opt,name=perm" json:"perm,omitempty"`
+}
+
+func (m *AuthRoleGrantPermissionRequest) Reset()                    { *m = AuthRoleGrantPermissionRequest{} }
+func (m *AuthRoleGrantPermissionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleGrantPermissionRequest) ProtoMessage()               {}
+func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+
+func (m *AuthRoleGrantPermissionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AuthRoleGrantPermissionRequest) GetPerm() *Permission {
+	if m != nil {
+		return m.Perm
+	}
+	return nil
+}
+
+type AuthRoleRevokePermissionRequest struct {
+	Role     string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+	RangeEnd string `protobuf:"bytes,3,opt,name=range_end,json=rangeEnd" json:"range_end,omitempty"`
+}
+
+func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokePermissionRequest{} }
+func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
+func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{83}
+}
+
+func (m *AuthRoleRevokePermissionRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *AuthRoleRevokePermissionRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AuthRoleRevokePermissionRequest) GetRangeEnd() string {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return ""
+}
+
+type AuthEnableResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthEnableResponse) Reset()                    { *m = AuthEnableResponse{} }
+func (m *AuthEnableResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthEnableResponse) ProtoMessage()               {}
+func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+
+func (m *AuthEnableResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthDisableResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthDisableResponse) Reset()                    { *m = AuthDisableResponse{} }
+func (m *AuthDisableResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthDisableResponse) ProtoMessage()               {}
+func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+
+func (m *AuthDisableResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthenticateResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// token is an authorized token that can be used in succeeding RPCs
+	Token string `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *AuthenticateResponse) Reset()                    { *m = AuthenticateResponse{} }
+func (m *AuthenticateResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthenticateResponse) ProtoMessage()               {}
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+
+func (m *AuthenticateResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AuthenticateResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type AuthUserAddResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthUserAddResponse) Reset()                    { *m = AuthUserAddResponse{} }
+func (m *AuthUserAddResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserAddResponse) ProtoMessage()               {}
+func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+
+func (m *AuthUserAddResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthUserGetResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Roles  []string        `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (m *AuthUserGetResponse) Reset()                    { *m = AuthUserGetResponse{} }
+func (m *AuthUserGetResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserGetResponse) ProtoMessage()               {}
+func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+
+func (m *AuthUserGetResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AuthUserGetResponse) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type AuthUserDeleteResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthUserDeleteResponse) Reset()                    { *m = AuthUserDeleteResponse{} }
+func (m *AuthUserDeleteResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserDeleteResponse) ProtoMessage()               {}
+func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+
+func (m *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthUserChangePasswordResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthUserChangePasswordResponse) Reset()                    { *m = AuthUserChangePasswordResponse{} }
+func (m *AuthUserChangePasswordResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserChangePasswordResponse) ProtoMessage()               {}
+func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+
+func (m *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthUserGrantRoleResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthUserGrantRoleResponse) Reset()                    { *m = AuthUserGrantRoleResponse{} }
+func (m *AuthUserGrantRoleResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserGrantRoleResponse) ProtoMessage()               {}
+func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+
+func (m *AuthUserGrantRoleResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthUserRevokeRoleResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthUserRevokeRoleResponse) Reset()                    { *m = AuthUserRevokeRoleResponse{} }
+func (m *AuthUserRevokeRoleResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserRevokeRoleResponse) ProtoMessage()               {}
+func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+
+func (m *AuthUserRevokeRoleResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthRoleAddResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthRoleAddResponse) Reset()                    { *m = AuthRoleAddResponse{} }
+func (m *AuthRoleAddResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleAddResponse) ProtoMessage()               {}
+func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *AuthRoleAddResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthRoleGetResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Perm   []*Permission   `protobuf:"bytes,2,rep,name=perm" json:"perm,omitempty"`
+}
+
+func (m *AuthRoleGetResponse) Reset()                    { *m = AuthRoleGetResponse{} }
+func (m *AuthRoleGetResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleGetResponse) ProtoMessage()               {}
+func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+func (m *AuthRoleGetResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AuthRoleGetResponse) GetPerm() []*Permission {
+	if m != nil {
+		return m.Perm
+	}
+	return nil
+}
+
+type AuthRoleListResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Roles  []string        `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (m *AuthRoleListResponse) Reset()                    { *m = AuthRoleListResponse{} }
+func (m *AuthRoleListResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleListResponse) ProtoMessage()               {}
+func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *AuthRoleListResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AuthRoleListResponse) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type AuthUserListResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Users  []string        `protobuf:"bytes,2,rep,name=users" json:"users,omitempty"`
+}
+
+func (m *AuthUserListResponse) Reset()                    { *m = AuthUserListResponse{} }
+func (m *AuthUserListResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthUserListResponse) ProtoMessage()               {}
+func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *AuthUserListResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AuthUserListResponse) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type AuthRoleDeleteResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthRoleDeleteResponse) Reset()                    { *m = AuthRoleDeleteResponse{} }
+func (m *AuthRoleDeleteResponse) String() string            { return proto.CompactTextString(m) }
+func (*AuthRoleDeleteResponse) ProtoMessage()               {}
+func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+
+func (m *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthRoleGrantPermissionResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthRoleGrantPermissionResponse) Reset()         { *m = AuthRoleGrantPermissionResponse{} }
+func (m *AuthRoleGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleGrantPermissionResponse) ProtoMessage()    {}
+func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{98}
+}
+
+func (m *AuthRoleGrantPermissionResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type AuthRoleRevokePermissionResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+}
+
+func (m *AuthRoleRevokePermissionResponse) Reset()         { *m = AuthRoleRevokePermissionResponse{} }
+func (m *AuthRoleRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthRoleRevokePermissionResponse) ProtoMessage()    {}
+func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{99}
+}
+
+func (m *AuthRoleRevokePermissionResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func init() {
 	proto.RegisterType((*Null)(nil), "pb.Null")
 	proto.RegisterType((*Key)(nil), "pb.Key")
 	proto.RegisterType((*Bool)(nil), "pb.Bool")
 	proto.RegisterType((*Expiration)(nil), "pb.Expiration")
+	proto.RegisterType((*ExpiringValue)(nil), "pb.ExpiringValue")
+	proto.RegisterType((*LeaseInfo)(nil), "pb.LeaseInfo")
+	proto.RegisterType((*KeysExpiration)(nil), "pb.KeysExpiration")
+	proto.RegisterType((*LockHolder)(nil), "pb.LockHolder")
+	proto.RegisterType((*LockInfo)(nil), "pb.LockInfo")
+	proto.RegisterType((*LockInfos)(nil), "pb.LockInfos")
+	proto.RegisterType((*PubSubMessage)(nil), "pb.PubSubMessage")
+	proto.RegisterType((*SubscribeRequest)(nil), "pb.SubscribeRequest")
+	proto.RegisterType((*PubSubChannel)(nil), "pb.PubSubChannel")
+	proto.RegisterType((*PubSubChannelList)(nil), "pb.PubSubChannelList")
 	proto.RegisterType((*ByteValue)(nil), "pb.ByteValue")
 	proto.RegisterType((*IntValue)(nil), "pb.IntValue")
 	proto.RegisterType((*FloatValue)(nil), "pb.FloatValue")
@@ -1327,8 +3408,47 @@ func init() {
 	proto.RegisterType((*Hash)(nil), "pb.Hash")
 	proto.RegisterType((*HashField)(nil), "pb.HashField")
 	proto.RegisterType((*HashFieldSet)(nil), "pb.HashFieldSet")
+	proto.RegisterType((*Sample)(nil), "pb.Sample")
+	proto.RegisterType((*DownsampleRule)(nil), "pb.DownsampleRule")
+	proto.RegisterType((*TimeSeries)(nil), "pb.TimeSeries")
+	proto.RegisterType((*TimeSeriesSample)(nil), "pb.TimeSeriesSample")
+	proto.RegisterType((*TimeSeriesQuery)(nil), "pb.TimeSeriesQuery")
+	proto.RegisterType((*JSONPath)(nil), "pb.JSONPath")
+	proto.RegisterType((*JSONValue)(nil), "pb.JSONValue")
+	proto.RegisterType((*JSONNumber)(nil), "pb.JSONNumber")
+	proto.RegisterType((*Vector)(nil), "pb.Vector")
+	proto.RegisterType((*VectorEntry)(nil), "pb.VectorEntry")
+	proto.RegisterType((*VectorIndex)(nil), "pb.VectorIndex")
+	proto.RegisterType((*VectorItem)(nil), "pb.VectorItem")
+	proto.RegisterType((*VectorQuery)(nil), "pb.VectorQuery")
+	proto.RegisterType((*VectorMatch)(nil), "pb.VectorMatch")
+	proto.RegisterType((*VectorMatches)(nil), "pb.VectorMatches")
+	proto.RegisterType((*SearchPosting)(nil), "pb.SearchPosting")
+	proto.RegisterType((*SearchPostings)(nil), "pb.SearchPostings")
+	proto.RegisterType((*SearchDocument)(nil), "pb.SearchDocument")
+	proto.RegisterType((*SearchIndex)(nil), "pb.SearchIndex")
+	proto.RegisterType((*SearchQuery)(nil), "pb.SearchQuery")
+	proto.RegisterType((*SearchResult)(nil), "pb.SearchResult")
+	proto.RegisterType((*SearchResults)(nil), "pb.SearchResults")
+	proto.RegisterType((*FieldIndex)(nil), "pb.FieldIndex")
+	proto.RegisterType((*FieldIndexes)(nil), "pb.FieldIndexes")
+	proto.RegisterType((*FieldQuery)(nil), "pb.FieldQuery")
+	proto.RegisterType((*RevisionValue)(nil), "pb.RevisionValue")
+	proto.RegisterType((*RevisionValues)(nil), "pb.RevisionValues")
+	proto.RegisterType((*CompareAndSwapRequest)(nil), "pb.CompareAndSwapRequest")
+	proto.RegisterType((*SwapResult)(nil), "pb.SwapResult")
+	proto.RegisterType((*TxnCompare)(nil), "pb.TxnCompare")
+	proto.RegisterType((*TxnOp)(nil), "pb.TxnOp")
+	proto.RegisterType((*TxnRequest)(nil), "pb.TxnRequest")
+	proto.RegisterType((*TxnResponse)(nil), "pb.TxnResponse")
+	proto.RegisterType((*Script)(nil), "pb.Script")
+	proto.RegisterType((*EvalRequest)(nil), "pb.EvalRequest")
+	proto.RegisterType((*EvalResponse)(nil), "pb.EvalResponse")
+	proto.RegisterType((*CommandRequest)(nil), "pb.CommandRequest")
 	proto.RegisterType((*WatchRequest)(nil), "pb.WatchRequest")
+	proto.RegisterType((*WatchFilter)(nil), "pb.WatchFilter")
 	proto.RegisterType((*Event)(nil), "pb.Event")
+	proto.RegisterType((*Delta)(nil), "pb.Delta")
 	proto.RegisterType((*Permission)(nil), "pb.Permission")
 	proto.RegisterType((*ResponseHeader)(nil), "pb.ResponseHeader")
 	proto.RegisterType((*AuthEnableRequest)(nil), "pb.AuthEnableRequest")
@@ -1363,1843 +3483,3415 @@ func init() {
 	proto.RegisterType((*AuthRoleDeleteResponse)(nil), "pb.AuthRoleDeleteResponse")
 	proto.RegisterType((*AuthRoleGrantPermissionResponse)(nil), "pb.AuthRoleGrantPermissionResponse")
 	proto.RegisterType((*AuthRoleRevokePermissionResponse)(nil), "pb.AuthRoleRevokePermissionResponse")
+	proto.RegisterEnum("pb.Aggregation", Aggregation_name, Aggregation_value)
+	proto.RegisterEnum("pb.VectorMetric", VectorMetric_name, VectorMetric_value)
+	proto.RegisterEnum("pb.TxnCompare_Type", TxnCompare_Type_name, TxnCompare_Type_value)
+	proto.RegisterEnum("pb.TxnOp_Type", TxnOp_Type_name, TxnOp_Type_value)
+	proto.RegisterEnum("pb.WatchRequest_Deltas", WatchRequest_Deltas_name, WatchRequest_Deltas_value)
+	proto.RegisterEnum("pb.WatchFilter_Type", WatchFilter_Type_name, WatchFilter_Type_value)
 	proto.RegisterEnum("pb.Event_EventType", Event_EventType_name, Event_EventType_value)
+	proto.RegisterEnum("pb.Event_Reason", Event_Reason_name, Event_Reason_value)
+	proto.RegisterEnum("pb.Delta_Type", Delta_Type_name, Delta_Type_value)
 	proto.RegisterEnum("pb.Permission_Type", Permission_Type_name, Permission_Type_value)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Mydis service
+
+type MydisClient interface {
+	// -- core functions
+	// Keys returns a list of valid keys.
+	Keys(ctx context.Context, in *Null, opts ...grpc.CallOption) (*KeysList, error)
+	// KeysWithPrefix returns a list of keys with the given prefix.
+	KeysWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error)
+	// Has checks if the cache has the given key and that it is not expired.
+	Has(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error)
+	// SetExpire sets the expiration on a key.
+	SetExpire(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// SetExpireMillis sets the expiration on a key in milliseconds.
+	SetExpireMillis(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// ExpireAt sets the expiration on a key to the given Unix time in seconds.
+	ExpireAt(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// ExpireAtMillis sets the expiration on a key to the given Unix time in milliseconds.
+	ExpireAtMillis(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// SetWithExpire sets a value and its expiration in seconds at the same time.
+	SetWithExpire(ctx context.Context, in *ExpiringValue, opts ...grpc.CallOption) (*Null, error)
+	// SetWithExpireMillis sets a value and its expiration in milliseconds at the same time.
+	SetWithExpireMillis(ctx context.Context, in *ExpiringValue, opts ...grpc.CallOption) (*Null, error)
+	// TTL gets the number of seconds until a key expires, or -1 if it doesn't expire.
+	TTL(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// TTLMillis gets the number of milliseconds until a key expires, or -1 if it doesn't expire.
+	TTLMillis(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// Persist removes the expiration from a key, returns true if it had one.
+	Persist(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error)
+	// GrantLease creates a named lease that expires after the given number of seconds unless it's kept alive.
+	GrantLease(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// RevokeLease revokes a named lease, deleting every key attached to it.
+	RevokeLease(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// GetLease gets the time left on a named lease and the keys attached to it.
+	GetLease(ctx context.Context, in *Key, opts ...grpc.CallOption) (*LeaseInfo, error)
+	// KeepAlive refreshes the named leases it receives, sending back the time left on each one.
+	KeepAlive(ctx context.Context, opts ...grpc.CallOption) (Mydis_KeepAliveClient, error)
+	// Lock a key from being modified.
+	Lock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// LockWithTimeout locks a key, waiting for the given number of seconds if already locked before returning an error.
+	LockWithTimeout(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
+	// Unlock a key for modifications.
+	Unlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// LockMany locks all of the keys at once, waiting for the given number of seconds if any are already locked before returning an error.
+	LockMany(ctx context.Context, in *KeysExpiration, opts ...grpc.CallOption) (*Null, error)
+	// UnlockMany unlocks all of the keys at once.
+	UnlockMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Null, error)
+	// ListLocks gets the locks held on the keys with the given prefix.
+	ListLocks(ctx context.Context, in *Key, opts ...grpc.CallOption) (*LockInfos, error)
+	// ForceUnlock releases a lock no matter who holds it, requiring the root role if authentication is enabled.
+	ForceUnlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// UnlockThenSet unlocks a key, then immediately sets its byte array value.
+	UnlockThenSet(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error)
+	// UnlockThenSetList unlocks a key, then immediately sets its list value.
+	UnlockThenSetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error)
+	// Delete removes a key from the cache.
+	Delete(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// Clear the cache.
+	Clear(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Null, error)
+	// -- bytes functions
+	// Get gets the value for the given key as a byte slice.
+	Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
+	// GetMany gets a list of values from the cache.
+	GetMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Hash, error)
+	// GetWithPrefix returns the keys with the given prefix.
+	GetWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error)
+	// GetWithRevision gets the value for the given key along with the revision it was last modified at.
+	GetWithRevision(ctx context.Context, in *Key, opts ...grpc.CallOption) (*RevisionValue, error)
+	// GetManyWithRevision gets a list of values along with the revisions they were last modified at.
+	GetManyWithRevision(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*RevisionValues, error)
+	// Set sets the byte value.
+	Set(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error)
+	// SetNX sets a value only if the key doesn't exist, returns true if changed.
+	SetNX(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Bool, error)
+	// SetMany values, returning a map[key]errorText for any errors.
+	SetMany(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ErrorHash, error)
+	// CompareAndSwap sets a value only if the current value equals the expected value.
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*SwapResult, error)
+	// SetIfModRevision sets a value only if the key was last modified at the given revision, zero meaning the key doesn't exist.
+	SetIfModRevision(ctx context.Context, in *RevisionValue, opts ...grpc.CallOption) (*SwapResult, error)
+	// Length returns the length of the value for the given key.
+	Length(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// -- number functions
+	// GetInt gets an integer value for the given key.
+	GetInt(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// GetFloat gets a float value for the given key.
+	GetFloat(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FloatValue, error)
+	// SetInt sets an integer.
+	SetInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*Null, error)
+	// SetFloat sets a float.
+	SetFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*Null, error)
+	// IncrementInt increment an integer stored at the given key by the given number and returns new value.
+	IncrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error)
+	// DecrementInt decrements an integer stored at the given key by the given number and returns new value.
+	DecrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error)
+	// IncrementFloat increment a float stored at the given key by the given number and returns new value.
+	IncrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error)
+	// DecrementFloat decrements a float stored at the given key by the given number and returns new value.
+	DecrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error)
+	// -- list functions
+	// GetList gets a list from the cache.
+	GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
+	// GetListItem gets a single item from a list by index, supports negative indexing.
+	GetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*ByteValue, error)
+	// SetList sets a list in the cache.
+	SetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error)
+	// SetListItem sets a single item in a list by index.
+	SetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
+	// ListLength returns the number of items in a list.
+	ListLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// ListLimit sets the maximum length of a list, removing items from the top once limit is reached.
+	ListLimit(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
+	// ListInsert inserts a new item at the given index in the list.
+	ListInsert(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
+	// ListAppend inserts a new item at the end of the list.
+	ListAppend(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
+	// ListPopLeft returns and removes the first item in a list.
+	ListPopLeft(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
+	// ListPopRight returns and removes the last item in a list.
+	ListPopRight(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
+	// ListHas determines if a list contains an item, returns index or -1 if not found.
+	ListHas(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error)
+	// ListDelete removes an item from a list by index.
+	ListDelete(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
+	// ListDeleteItem removes the first occurrence of value from a list, returns index of removed item or -1 for not found.
+	ListDeleteItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error)
+	// -- hash functions
+	// GetHash gets a hash from the cache.
+	GetHash(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error)
+	// GetHashField gets a single value in a hash.
+	GetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*ByteValue, error)
+	// GetHashFields gets multiple hash values.
+	GetHashFields(ctx context.Context, in *HashFieldSet, opts ...grpc.CallOption) (*Hash, error)
+	// HashHas determines if a hash has a given field.
+	HashHas(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Bool, error)
+	// HashLength returns the number of fields in a hash.
+	HashLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
+	// HashFields gets a list of the fields in a hash.
+	HashFields(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error)
+	// HashValues gets a list of the values in a hash.
+	HashValues(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
+	// SetHash sets the value of a hash.
+	SetHash(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error)
+	// SetHashField sets a single value in a hash.
+	SetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error)
+	// SetHashFields sets multiple values in a hash.
+	SetHashFields(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error)
+	// DelHashField deletes a field from a hash.
+	DelHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error)
+	// -- time series functions
+	// GetTimeSeries gets a time series from the cache.
+	GetTimeSeries(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TimeSeries, error)
+	// TimeSeriesCreate creates a time series, or updates the retention and downsampling rules of an existing one.
+	TimeSeriesCreate(ctx context.Context, in *TimeSeries, opts ...grpc.CallOption) (*Null, error)
+	// TimeSeriesAdd adds a sample to a time series, creates new time series if doesn't exist.
+	TimeSeriesAdd(ctx context.Context, in *TimeSeriesSample, opts ...grpc.CallOption) (*Null, error)
+	// TimeSeriesRange gets the samples in a time range, optionally aggregated into buckets.
+	TimeSeriesRange(ctx context.Context, in *TimeSeriesQuery, opts ...grpc.CallOption) (*TimeSeries, error)
+	// -- json functions
+	// JSONGet gets the JSON value at the given path in a document.
+	JSONGet(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*ByteValue, error)
+	// JSONSet sets the JSON value at the given path in a document, creates new document if setting the root path.
+	JSONSet(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*Null, error)
+	// JSONDel removes the value at the given path from a document.
+	JSONDel(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*Null, error)
+	// JSONArrAppend appends a JSON value to the array at the given path, returns the new length of the array.
+	JSONArrAppend(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*IntValue, error)
+	// JSONNumIncrBy increments the number at the given path and returns the new value.
+	JSONNumIncrBy(ctx context.Context, in *JSONNumber, opts ...grpc.CallOption) (*FloatValue, error)
+	// -- vector functions
+	// GetVectorIndex gets a vector index, including its vectors.
+	GetVectorIndex(ctx context.Context, in *Key, opts ...grpc.CallOption) (*VectorIndex, error)
+	// VectorIndexCreate creates a vector index, or updates the settings of an existing one.
+	VectorIndexCreate(ctx context.Context, in *VectorIndex, opts ...grpc.CallOption) (*Null, error)
+	// GetVector gets a single vector from an index.
+	GetVector(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*VectorItem, error)
+	// VectorAdd adds or replaces a vector in an index, creates new index if doesn't exist.
+	VectorAdd(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*Null, error)
+	// VectorDelete deletes a vector from an index.
+	VectorDelete(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*Null, error)
+	// VectorSearch gets the k nearest neighbours of a vector.
+	VectorSearch(ctx context.Context, in *VectorQuery, opts ...grpc.CallOption) (*VectorMatches, error)
+	// -- search functions
+	// SearchIndexCreate creates a full-text search index over the given fields of the hashes with the given prefix.
+	SearchIndexCreate(ctx context.Context, in *SearchIndex, opts ...grpc.CallOption) (*Null, error)
+	// SearchIndexDrop deletes a full-text search index.
+	SearchIndexDrop(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
+	// Search gets the keys of the hashes matching a query, best match first.
+	Search(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResults, error)
+	// -- field index functions
+	// FieldIndexCreate creates an index on a field of the hashes with the given prefix.
+	FieldIndexCreate(ctx context.Context, in *FieldIndex, opts ...grpc.CallOption) (*Null, error)
+	// FieldIndexDrop deletes an index on a field of the hashes with the given prefix.
+	FieldIndexDrop(ctx context.Context, in *FieldIndex, opts ...grpc.CallOption) (*Null, error)
+	// FindByField gets the keys of the hashes with the given prefix where the field has the given value.
+	FindByField(ctx context.Context, in *FieldQuery, opts ...grpc.CallOption) (*KeysList, error)
+	// FindByFieldRange gets the keys of the hashes with the given prefix where the numeric field is within the given range.
+	FindByFieldRange(ctx context.Context, in *FieldQuery, opts ...grpc.CallOption) (*KeysList, error)
+	// -- transaction functions
+	// Txn evaluates the comparisons and applies the success operations if they all pass, otherwise the failure operations, atomically.
+	Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error)
+	// -- scripting functions
+	// Eval runs a script, committing every change it makes atomically.
+	Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error)
+	// EvalSHA runs a script loaded with ScriptLoad, by its SHA1 hash.
+	EvalSHA(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error)
+	// ScriptLoad stores a script without running it, returning its SHA1 hash.
+	ScriptLoad(ctx context.Context, in *Script, opts ...grpc.CallOption) (*Script, error)
+	// -- custom commands
+	// Command runs a command registered on the server by the program embedding it.
+	Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*ByteValue, error)
+	// -- push functions
+	// Watch for changes to a key.
+	Watch(ctx context.Context, opts ...grpc.CallOption) (Mydis_WatchClient, error)
+	// Publish sends a message to the subscribers of a channel, returning the number of subscribers it was sent to.
+	Publish(ctx context.Context, in *PubSubMessage, opts ...grpc.CallOption) (*IntValue, error)
+	// Subscribe to channels and channel patterns.
+	Subscribe(ctx context.Context, opts ...grpc.CallOption) (Mydis_SubscribeClient, error)
+	// PubSubChannels lists the channels with subscribers, matching an optional pattern.
+	PubSubChannels(ctx context.Context, in *Key, opts ...grpc.CallOption) (*PubSubChannelList, error)
+	// -- auth passthrough functions
+	// AuthEnable enables authentication.
+	AuthEnable(ctx context.Context, in *AuthEnableRequest, opts ...grpc.CallOption) (*AuthEnableResponse, error)
+	// AuthDisable disables authentication.
+	AuthDisable(ctx context.Context, in *AuthDisableRequest, opts ...grpc.CallOption) (*AuthDisableResponse, error)
+	// Authenticate processes an authenticate request.
+	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
+	// UserAdd adds a new user.
+	UserAdd(ctx context.Context, in *AuthUserAddRequest, opts ...grpc.CallOption) (*AuthUserAddResponse, error)
+	// UserGet gets detailed user information.
+	UserGet(ctx context.Context, in *AuthUserGetRequest, opts ...grpc.CallOption) (*AuthUserGetResponse, error)
+	// UserList gets a list of all users.
+	UserList(ctx context.Context, in *AuthUserListRequest, opts ...grpc.CallOption) (*AuthUserListResponse, error)
+	// UserDelete deletes a specified user.
+	UserDelete(ctx context.Context, in *AuthUserDeleteRequest, opts ...grpc.CallOption) (*AuthUserDeleteResponse, error)
+	// UserChangePassword changes the password of a specified user.
+	UserChangePassword(ctx context.Context, in *AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*AuthUserChangePasswordResponse, error)
+	// UserGrantRole grants a role to a specified user.
+	UserGrantRole(ctx context.Context, in *AuthUserGrantRoleRequest, opts ...grpc.CallOption) (*AuthUserGrantRoleResponse, error)
+	// UserRevokeRole revokes a role of specified user.
+	UserRevokeRole(ctx context.Context, in *AuthUserRevokeRoleRequest, opts ...grpc.CallOption) (*AuthUserRevokeRoleResponse, error)
+	// RoleAdd adds a new role.
+	RoleAdd(ctx context.Context, in *AuthRoleAddRequest, opts ...grpc.CallOption) (*AuthRoleAddResponse, error)
+	// RoleGet gets detailed role information.
+	RoleGet(ctx context.Context, in *AuthRoleGetRequest, opts ...grpc.CallOption) (*AuthRoleGetResponse, error)
+	// RoleList gets lists of all roles.
+	RoleList(ctx context.Context, in *AuthRoleListRequest, opts ...grpc.CallOption) (*AuthRoleListResponse, error)
+	// RoleDelete deletes a specified role.
+	RoleDelete(ctx context.Context, in *AuthRoleDeleteRequest, opts ...grpc.CallOption) (*AuthRoleDeleteResponse, error)
+	// RoleGrantPermission grants a permission of a specified key or range to a specified role.
+	RoleGrantPermission(ctx context.Context, in *AuthRoleGrantPermissionRequest, opts ...grpc.CallOption) (*AuthRoleGrantPermissionResponse, error)
+	// RoleRevokePermission revokes a key or range permission of a specified role.
+	RoleRevokePermission(ctx context.Context, in *AuthRoleRevokePermissionRequest, opts ...grpc.CallOption) (*AuthRoleRevokePermissionResponse, error)
+}
+
+type mydisClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMydisClient(cc *grpc.ClientConn) MydisClient {
+	return &mydisClient{cc}
+}
+
+func (c *mydisClient) Keys(ctx context.Context, in *Null, opts ...grpc.CallOption) (*KeysList, error) {
+	out := new(KeysList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Keys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) KeysWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error) {
+	out := new(KeysList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/KeysWithPrefix", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Has(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error) {
+	out := new(Bool)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Has", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetExpire(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetExpire", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetExpireMillis(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetExpireMillis", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ExpireAt(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ExpireAt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ExpireAtMillis(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ExpireAtMillis", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetWithExpire(ctx context.Context, in *ExpiringValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetWithExpire", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetWithExpireMillis(ctx context.Context, in *ExpiringValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetWithExpireMillis", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) TTL(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/TTL", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) TTLMillis(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/TTLMillis", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Persist(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error) {
+	out := new(Bool)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Persist", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GrantLease(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GrantLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) RevokeLease(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RevokeLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetLease(ctx context.Context, in *Key, opts ...grpc.CallOption) (*LeaseInfo, error) {
+	out := new(LeaseInfo)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetLease", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) KeepAlive(ctx context.Context, opts ...grpc.CallOption) (Mydis_KeepAliveClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Mydis_serviceDesc.Streams[0], c.cc, "/pb.Mydis/KeepAlive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mydisKeepAliveClient{stream}
+	return x, nil
+}
+
+type Mydis_KeepAliveClient interface {
+	Send(*Key) error
+	Recv() (*LeaseInfo, error)
+	grpc.ClientStream
+}
+
+type mydisKeepAliveClient struct {
+	grpc.ClientStream
+}
+
+func (x *mydisKeepAliveClient) Send(m *Key) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mydisKeepAliveClient) Recv() (*LeaseInfo, error) {
+	m := new(LeaseInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mydisClient) Lock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Lock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) LockWithTimeout(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/LockWithTimeout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Unlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Unlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) LockMany(ctx context.Context, in *KeysExpiration, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/LockMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) UnlockMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UnlockMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListLocks(ctx context.Context, in *Key, opts ...grpc.CallOption) (*LockInfos, error) {
+	out := new(LockInfos)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListLocks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ForceUnlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ForceUnlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) UnlockThenSet(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UnlockThenSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) UnlockThenSetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UnlockThenSetList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Delete(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Clear(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Clear", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Hash, error) {
+	out := new(Hash)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error) {
+	out := new(Hash)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetWithPrefix", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetWithRevision(ctx context.Context, in *Key, opts ...grpc.CallOption) (*RevisionValue, error) {
+	out := new(RevisionValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetWithRevision", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetManyWithRevision(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*RevisionValues, error) {
+	out := new(RevisionValues)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetManyWithRevision", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Set(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Set", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetNX(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Bool, error) {
+	out := new(Bool)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetNX", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetMany(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ErrorHash, error) {
+	out := new(ErrorHash)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetMany", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*SwapResult, error) {
+	out := new(SwapResult)
+	err := grpc.Invoke(ctx, "/pb.Mydis/CompareAndSwap", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetIfModRevision(ctx context.Context, in *RevisionValue, opts ...grpc.CallOption) (*SwapResult, error) {
+	out := new(SwapResult)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetIfModRevision", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Length(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Length", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetInt(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetInt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetFloat(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FloatValue, error) {
+	out := new(FloatValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetFloat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetInt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetFloat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) IncrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/IncrementInt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) DecrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/DecrementInt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) IncrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error) {
+	out := new(FloatValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/IncrementFloat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) DecrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error) {
+	out := new(FloatValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/DecrementFloat", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetListItem", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetListItem", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListLength", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListLimit(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListInsert(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListInsert", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListAppend(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListAppend", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListPopLeft(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListPopLeft", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListPopRight(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListPopRight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListHas(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListHas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListDelete(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ListDeleteItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ListDeleteItem", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetHash(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error) {
+	out := new(Hash)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetHashField", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetHashFields(ctx context.Context, in *HashFieldSet, opts ...grpc.CallOption) (*Hash, error) {
+	out := new(Hash)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetHashFields", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) HashHas(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Bool, error) {
+	out := new(Bool)
+	err := grpc.Invoke(ctx, "/pb.Mydis/HashHas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) HashLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/HashLength", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) HashFields(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error) {
+	out := new(KeysList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/HashFields", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) HashValues(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := grpc.Invoke(ctx, "/pb.Mydis/HashValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetHash(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetHashField", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SetHashFields(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SetHashFields", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) DelHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/DelHashField", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetTimeSeries(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TimeSeries, error) {
+	out := new(TimeSeries)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetTimeSeries", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) TimeSeriesCreate(ctx context.Context, in *TimeSeries, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/TimeSeriesCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) TimeSeriesAdd(ctx context.Context, in *TimeSeriesSample, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/TimeSeriesAdd", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) TimeSeriesRange(ctx context.Context, in *TimeSeriesQuery, opts ...grpc.CallOption) (*TimeSeries, error) {
+	out := new(TimeSeries)
+	err := grpc.Invoke(ctx, "/pb.Mydis/TimeSeriesRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) JSONGet(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/JSONGet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) JSONSet(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/JSONSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) JSONDel(ctx context.Context, in *JSONPath, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/JSONDel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) JSONArrAppend(ctx context.Context, in *JSONValue, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/JSONArrAppend", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) JSONNumIncrBy(ctx context.Context, in *JSONNumber, opts ...grpc.CallOption) (*FloatValue, error) {
+	out := new(FloatValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/JSONNumIncrBy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetVectorIndex(ctx context.Context, in *Key, opts ...grpc.CallOption) (*VectorIndex, error) {
+	out := new(VectorIndex)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetVectorIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) VectorIndexCreate(ctx context.Context, in *VectorIndex, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/VectorIndexCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) GetVector(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*VectorItem, error) {
+	out := new(VectorItem)
+	err := grpc.Invoke(ctx, "/pb.Mydis/GetVector", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) VectorAdd(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/VectorAdd", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) VectorDelete(ctx context.Context, in *VectorItem, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/VectorDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) VectorSearch(ctx context.Context, in *VectorQuery, opts ...grpc.CallOption) (*VectorMatches, error) {
+	out := new(VectorMatches)
+	err := grpc.Invoke(ctx, "/pb.Mydis/VectorSearch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SearchIndexCreate(ctx context.Context, in *SearchIndex, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SearchIndexCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) SearchIndexDrop(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/SearchIndexDrop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Search(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) FieldIndexCreate(ctx context.Context, in *FieldIndex, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/FieldIndexCreate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) FieldIndexDrop(ctx context.Context, in *FieldIndex, opts ...grpc.CallOption) (*Null, error) {
+	out := new(Null)
+	err := grpc.Invoke(ctx, "/pb.Mydis/FieldIndexDrop", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *mydisClient) FindByField(ctx context.Context, in *FieldQuery, opts ...grpc.CallOption) (*KeysList, error) {
+	out := new(KeysList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/FindByField", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// Client API for Mydis service
+func (c *mydisClient) FindByFieldRange(ctx context.Context, in *FieldQuery, opts ...grpc.CallOption) (*KeysList, error) {
+	out := new(KeysList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/FindByFieldRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type MydisClient interface {
-	// -- core functions
-	// Keys returns a list of valid keys.
-	Keys(ctx context.Context, in *Null, opts ...grpc.CallOption) (*KeysList, error)
-	// KeysWithPrefix returns a list of keys with the given prefix.
-	KeysWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error)
-	// Has checks if the cache has the given key and that it is not expired.
-	Has(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error)
-	// SetExpire sets the expiration on a key.
-	SetExpire(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
-	// Lock a key from being modified.
-	Lock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
-	// LockWithTimeout locks a key, waiting for the given number of seconds if already locked before returning an error.
-	LockWithTimeout(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error)
-	// Unlock a key for modifications.
-	Unlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
-	// UnlockThenSet unlocks a key, then immediately sets its byte array value.
-	UnlockThenSet(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error)
-	// UnlockThenSetList unlocks a key, then immediately sets its list value.
-	UnlockThenSetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error)
-	// Delete removes a key from the cache.
-	Delete(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error)
-	// Clear the cache.
-	Clear(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Null, error)
-	// -- bytes functions
-	// Get gets the value for the given key as a byte slice.
-	Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
-	// GetMany gets a list of values from the cache.
-	GetMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Hash, error)
-	// GetWithPrefix returns the keys with the given prefix.
-	GetWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error)
-	// Set sets the byte value.
-	Set(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error)
-	// SetNX sets a value only if the key doesn't exist, returns true if changed.
-	SetNX(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Bool, error)
-	// SetMany values, returning a map[key]errorText for any errors.
-	SetMany(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ErrorHash, error)
-	// Length returns the length of the value for the given key.
-	Length(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
-	// -- number functions
-	// GetInt gets an integer value for the given key.
-	GetInt(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
-	// GetFloat gets a float value for the given key.
-	GetFloat(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FloatValue, error)
-	// SetInt sets an integer.
-	SetInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*Null, error)
-	// SetFloat sets a float.
-	SetFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*Null, error)
-	// IncrementInt increment an integer stored at the given key by the given number and returns new value.
-	IncrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error)
-	// DecrementInt decrements an integer stored at the given key by the given number and returns new value.
-	DecrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error)
-	// IncrementFloat increment a float stored at the given key by the given number and returns new value.
-	IncrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error)
-	// DecrementFloat decrements a float stored at the given key by the given number and returns new value.
-	DecrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error)
-	// -- list functions
-	// GetList gets a list from the cache.
-	GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
-	// GetListItem gets a single item from a list by index, supports negative indexing.
-	GetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*ByteValue, error)
-	// SetList sets a list in the cache.
-	SetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error)
-	// SetListItem sets a single item in a list by index.
-	SetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
-	// ListLength returns the number of items in a list.
-	ListLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
-	// ListLimit sets the maximum length of a list, removing items from the top once limit is reached.
-	ListLimit(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
-	// ListInsert inserts a new item at the given index in the list.
-	ListInsert(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
-	// ListAppend inserts a new item at the end of the list.
-	ListAppend(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
-	// ListPopLeft returns and removes the first item in a list.
-	ListPopLeft(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
-	// ListPopRight returns and removes the last item in a list.
-	ListPopRight(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error)
-	// ListHas determines if a list contains an item, returns index or -1 if not found.
-	ListHas(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error)
-	// ListDelete removes an item from a list by index.
-	ListDelete(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error)
-	// ListDeleteItem removes the first occurrence of value from a list, returns index of removed item or -1 for not found.
-	ListDeleteItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error)
-	// -- hash functions
-	// GetHash gets a hash from the cache.
-	GetHash(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error)
-	// GetHashField gets a single value in a hash.
-	GetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*ByteValue, error)
-	// GetHashFields gets multiple hash values.
-	GetHashFields(ctx context.Context, in *HashFieldSet, opts ...grpc.CallOption) (*Hash, error)
-	// HashHas determines if a hash has a given field.
-	HashHas(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Bool, error)
-	// HashLength returns the number of fields in a hash.
-	HashLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error)
-	// HashFields gets a list of the fields in a hash.
-	HashFields(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error)
-	// HashValues gets a list of the values in a hash.
-	HashValues(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
-	// SetHash sets the value of a hash.
-	SetHash(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error)
-	// SetHashField sets a single value in a hash.
-	SetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error)
-	// SetHashFields sets multiple values in a hash.
-	SetHashFields(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error)
-	// DelHashField deletes a field from a hash.
-	DelHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error)
-	// -- push functions
-	// Watch for changes to a key.
-	Watch(ctx context.Context, opts ...grpc.CallOption) (Mydis_WatchClient, error)
-	// -- auth passthrough functions
-	// AuthEnable enables authentication.
-	AuthEnable(ctx context.Context, in *AuthEnableRequest, opts ...grpc.CallOption) (*AuthEnableResponse, error)
-	// AuthDisable disables authentication.
-	AuthDisable(ctx context.Context, in *AuthDisableRequest, opts ...grpc.CallOption) (*AuthDisableResponse, error)
-	// Authenticate processes an authenticate request.
-	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
-	// UserAdd adds a new user.
-	UserAdd(ctx context.Context, in *AuthUserAddRequest, opts ...grpc.CallOption) (*AuthUserAddResponse, error)
-	// UserGet gets detailed user information.
-	UserGet(ctx context.Context, in *AuthUserGetRequest, opts ...grpc.CallOption) (*AuthUserGetResponse, error)
-	// UserList gets a list of all users.
-	UserList(ctx context.Context, in *AuthUserListRequest, opts ...grpc.CallOption) (*AuthUserListResponse, error)
-	// UserDelete deletes a specified user.
-	UserDelete(ctx context.Context, in *AuthUserDeleteRequest, opts ...grpc.CallOption) (*AuthUserDeleteResponse, error)
-	// UserChangePassword changes the password of a specified user.
-	UserChangePassword(ctx context.Context, in *AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*AuthUserChangePasswordResponse, error)
-	// UserGrantRole grants a role to a specified user.
-	UserGrantRole(ctx context.Context, in *AuthUserGrantRoleRequest, opts ...grpc.CallOption) (*AuthUserGrantRoleResponse, error)
-	// UserRevokeRole revokes a role of specified user.
-	UserRevokeRole(ctx context.Context, in *AuthUserRevokeRoleRequest, opts ...grpc.CallOption) (*AuthUserRevokeRoleResponse, error)
-	// RoleAdd adds a new role.
-	RoleAdd(ctx context.Context, in *AuthRoleAddRequest, opts ...grpc.CallOption) (*AuthRoleAddResponse, error)
-	// RoleGet gets detailed role information.
-	RoleGet(ctx context.Context, in *AuthRoleGetRequest, opts ...grpc.CallOption) (*AuthRoleGetResponse, error)
-	// RoleList gets lists of all roles.
-	RoleList(ctx context.Context, in *AuthRoleListRequest, opts ...grpc.CallOption) (*AuthRoleListResponse, error)
-	// RoleDelete deletes a specified role.
-	RoleDelete(ctx context.Context, in *AuthRoleDeleteRequest, opts ...grpc.CallOption) (*AuthRoleDeleteResponse, error)
-	// RoleGrantPermission grants a permission of a specified key or range to a specified role.
-	RoleGrantPermission(ctx context.Context, in *AuthRoleGrantPermissionRequest, opts ...grpc.CallOption) (*AuthRoleGrantPermissionResponse, error)
-	// RoleRevokePermission revokes a key or range permission of a specified role.
-	RoleRevokePermission(ctx context.Context, in *AuthRoleRevokePermissionRequest, opts ...grpc.CallOption) (*AuthRoleRevokePermissionResponse, error)
+func (c *mydisClient) Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error) {
+	out := new(TxnResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Txn", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error) {
+	out := new(EvalResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Eval", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) EvalSHA(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error) {
+	out := new(EvalResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/EvalSHA", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) ScriptLoad(ctx context.Context, in *Script, opts ...grpc.CallOption) (*Script, error) {
+	out := new(Script)
+	err := grpc.Invoke(ctx, "/pb.Mydis/ScriptLoad", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Command(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*ByteValue, error) {
+	out := new(ByteValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Command", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Watch(ctx context.Context, opts ...grpc.CallOption) (Mydis_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Mydis_serviceDesc.Streams[1], c.cc, "/pb.Mydis/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mydisWatchClient{stream}
+	return x, nil
+}
+
+type Mydis_WatchClient interface {
+	Send(*WatchRequest) error
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type mydisWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *mydisWatchClient) Send(m *WatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mydisWatchClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mydisClient) Publish(ctx context.Context, in *PubSubMessage, opts ...grpc.CallOption) (*IntValue, error) {
+	out := new(IntValue)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Publish", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (Mydis_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Mydis_serviceDesc.Streams[2], c.cc, "/pb.Mydis/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mydisSubscribeClient{stream}
+	return x, nil
+}
+
+type Mydis_SubscribeClient interface {
+	Send(*SubscribeRequest) error
+	Recv() (*PubSubMessage, error)
+	grpc.ClientStream
+}
+
+type mydisSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *mydisSubscribeClient) Send(m *SubscribeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mydisSubscribeClient) Recv() (*PubSubMessage, error) {
+	m := new(PubSubMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-type mydisClient struct {
-	cc *grpc.ClientConn
+func (c *mydisClient) PubSubChannels(ctx context.Context, in *Key, opts ...grpc.CallOption) (*PubSubChannelList, error) {
+	out := new(PubSubChannelList)
+	err := grpc.Invoke(ctx, "/pb.Mydis/PubSubChannels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewMydisClient(cc *grpc.ClientConn) MydisClient {
-	return &mydisClient{cc}
+func (c *mydisClient) AuthEnable(ctx context.Context, in *AuthEnableRequest, opts ...grpc.CallOption) (*AuthEnableResponse, error) {
+	out := new(AuthEnableResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/AuthEnable", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *mydisClient) Keys(ctx context.Context, in *Null, opts ...grpc.CallOption) (*KeysList, error) {
-	out := new(KeysList)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Keys", in, out, c.cc, opts...)
+func (c *mydisClient) AuthDisable(ctx context.Context, in *AuthDisableRequest, opts ...grpc.CallOption) (*AuthDisableResponse, error) {
+	out := new(AuthDisableResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/AuthDisable", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) KeysWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error) {
-	out := new(KeysList)
-	err := grpc.Invoke(ctx, "/pb.Mydis/KeysWithPrefix", in, out, c.cc, opts...)
+func (c *mydisClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
+	out := new(AuthenticateResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/Authenticate", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Has(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Bool, error) {
-	out := new(Bool)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Has", in, out, c.cc, opts...)
+func (c *mydisClient) UserAdd(ctx context.Context, in *AuthUserAddRequest, opts ...grpc.CallOption) (*AuthUserAddResponse, error) {
+	out := new(AuthUserAddResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserAdd", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) SetExpire(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetExpire", in, out, c.cc, opts...)
+func (c *mydisClient) UserGet(ctx context.Context, in *AuthUserGetRequest, opts ...grpc.CallOption) (*AuthUserGetResponse, error) {
+	out := new(AuthUserGetResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserGet", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Lock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Lock", in, out, c.cc, opts...)
+func (c *mydisClient) UserList(ctx context.Context, in *AuthUserListRequest, opts ...grpc.CallOption) (*AuthUserListResponse, error) {
+	out := new(AuthUserListResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserList", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) LockWithTimeout(ctx context.Context, in *Expiration, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/LockWithTimeout", in, out, c.cc, opts...)
+func (c *mydisClient) UserDelete(ctx context.Context, in *AuthUserDeleteRequest, opts ...grpc.CallOption) (*AuthUserDeleteResponse, error) {
+	out := new(AuthUserDeleteResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserDelete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Unlock(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Unlock", in, out, c.cc, opts...)
+func (c *mydisClient) UserChangePassword(ctx context.Context, in *AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*AuthUserChangePasswordResponse, error) {
+	out := new(AuthUserChangePasswordResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserChangePassword", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) UnlockThenSet(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UnlockThenSet", in, out, c.cc, opts...)
+func (c *mydisClient) UserGrantRole(ctx context.Context, in *AuthUserGrantRoleRequest, opts ...grpc.CallOption) (*AuthUserGrantRoleResponse, error) {
+	out := new(AuthUserGrantRoleResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserGrantRole", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) UnlockThenSetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UnlockThenSetList", in, out, c.cc, opts...)
+func (c *mydisClient) UserRevokeRole(ctx context.Context, in *AuthUserRevokeRoleRequest, opts ...grpc.CallOption) (*AuthUserRevokeRoleResponse, error) {
+	out := new(AuthUserRevokeRoleResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/UserRevokeRole", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Delete(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Delete", in, out, c.cc, opts...)
+func (c *mydisClient) RoleAdd(ctx context.Context, in *AuthRoleAddRequest, opts ...grpc.CallOption) (*AuthRoleAddResponse, error) {
+	out := new(AuthRoleAddResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleAdd", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Clear(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Clear", in, out, c.cc, opts...)
+func (c *mydisClient) RoleGet(ctx context.Context, in *AuthRoleGetRequest, opts ...grpc.CallOption) (*AuthRoleGetResponse, error) {
+	out := new(AuthRoleGetResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleGet", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
-	out := new(ByteValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Get", in, out, c.cc, opts...)
+func (c *mydisClient) RoleList(ctx context.Context, in *AuthRoleListRequest, opts ...grpc.CallOption) (*AuthRoleListResponse, error) {
+	out := new(AuthRoleListResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleList", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) GetMany(ctx context.Context, in *KeysList, opts ...grpc.CallOption) (*Hash, error) {
-	out := new(Hash)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetMany", in, out, c.cc, opts...)
+func (c *mydisClient) RoleDelete(ctx context.Context, in *AuthRoleDeleteRequest, opts ...grpc.CallOption) (*AuthRoleDeleteResponse, error) {
+	out := new(AuthRoleDeleteResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleDelete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) RoleGrantPermission(ctx context.Context, in *AuthRoleGrantPermissionRequest, opts ...grpc.CallOption) (*AuthRoleGrantPermissionResponse, error) {
+	out := new(AuthRoleGrantPermissionResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleGrantPermission", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mydisClient) RoleRevokePermission(ctx context.Context, in *AuthRoleRevokePermissionRequest, opts ...grpc.CallOption) (*AuthRoleRevokePermissionResponse, error) {
+	out := new(AuthRoleRevokePermissionResponse)
+	err := grpc.Invoke(ctx, "/pb.Mydis/RoleRevokePermission", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *mydisClient) GetWithPrefix(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error) {
-	out := new(Hash)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetWithPrefix", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Server API for Mydis service
+
+type MydisServer interface {
+	// -- core functions
+	// Keys returns a list of valid keys.
+	Keys(context.Context, *Null) (*KeysList, error)
+	// KeysWithPrefix returns a list of keys with the given prefix.
+	KeysWithPrefix(context.Context, *Key) (*KeysList, error)
+	// Has checks if the cache has the given key and that it is not expired.
+	Has(context.Context, *Key) (*Bool, error)
+	// SetExpire sets the expiration on a key.
+	SetExpire(context.Context, *Expiration) (*Null, error)
+	// SetExpireMillis sets the expiration on a key in milliseconds.
+	SetExpireMillis(context.Context, *Expiration) (*Null, error)
+	// ExpireAt sets the expiration on a key to the given Unix time in seconds.
+	ExpireAt(context.Context, *Expiration) (*Null, error)
+	// ExpireAtMillis sets the expiration on a key to the given Unix time in milliseconds.
+	ExpireAtMillis(context.Context, *Expiration) (*Null, error)
+	// SetWithExpire sets a value and its expiration in seconds at the same time.
+	SetWithExpire(context.Context, *ExpiringValue) (*Null, error)
+	// SetWithExpireMillis sets a value and its expiration in milliseconds at the same time.
+	SetWithExpireMillis(context.Context, *ExpiringValue) (*Null, error)
+	// TTL gets the number of seconds until a key expires, or -1 if it doesn't expire.
+	TTL(context.Context, *Key) (*IntValue, error)
+	// TTLMillis gets the number of milliseconds until a key expires, or -1 if it doesn't expire.
+	TTLMillis(context.Context, *Key) (*IntValue, error)
+	// Persist removes the expiration from a key, returns true if it had one.
+	Persist(context.Context, *Key) (*Bool, error)
+	// GrantLease creates a named lease that expires after the given number of seconds unless it's kept alive.
+	GrantLease(context.Context, *Expiration) (*Null, error)
+	// RevokeLease revokes a named lease, deleting every key attached to it.
+	RevokeLease(context.Context, *Key) (*Null, error)
+	// GetLease gets the time left on a named lease and the keys attached to it.
+	GetLease(context.Context, *Key) (*LeaseInfo, error)
+	// KeepAlive refreshes the named leases it receives, sending back the time left on each one.
+	KeepAlive(Mydis_KeepAliveServer) error
+	// Lock a key from being modified.
+	Lock(context.Context, *Key) (*Null, error)
+	// LockWithTimeout locks a key, waiting for the given number of seconds if already locked before returning an error.
+	LockWithTimeout(context.Context, *Expiration) (*Null, error)
+	// Unlock a key for modifications.
+	Unlock(context.Context, *Key) (*Null, error)
+	// LockMany locks all of the keys at once, waiting for the given number of seconds if any are already locked before returning an error.
+	LockMany(context.Context, *KeysExpiration) (*Null, error)
+	// UnlockMany unlocks all of the keys at once.
+	UnlockMany(context.Context, *KeysList) (*Null, error)
+	// ListLocks gets the locks held on the keys with the given prefix.
+	ListLocks(context.Context, *Key) (*LockInfos, error)
+	// ForceUnlock releases a lock no matter who holds it, requiring the root role if authentication is enabled.
+	ForceUnlock(context.Context, *Key) (*Null, error)
+	// UnlockThenSet unlocks a key, then immediately sets its byte array value.
+	UnlockThenSet(context.Context, *ByteValue) (*Null, error)
+	// UnlockThenSetList unlocks a key, then immediately sets its list value.
+	UnlockThenSetList(context.Context, *List) (*Null, error)
+	// Delete removes a key from the cache.
+	Delete(context.Context, *Key) (*Null, error)
+	// Clear the cache.
+	Clear(context.Context, *Null) (*Null, error)
+	// -- bytes functions
+	// Get gets the value for the given key as a byte slice.
+	Get(context.Context, *Key) (*ByteValue, error)
+	// GetMany gets a list of values from the cache.
+	GetMany(context.Context, *KeysList) (*Hash, error)
+	// GetWithPrefix returns the keys with the given prefix.
+	GetWithPrefix(context.Context, *Key) (*Hash, error)
+	// GetWithRevision gets the value for the given key along with the revision it was last modified at.
+	GetWithRevision(context.Context, *Key) (*RevisionValue, error)
+	// GetManyWithRevision gets a list of values along with the revisions they were last modified at.
+	GetManyWithRevision(context.Context, *KeysList) (*RevisionValues, error)
+	// Set sets the byte value.
+	Set(context.Context, *ByteValue) (*Null, error)
+	// SetNX sets a value only if the key doesn't exist, returns true if changed.
+	SetNX(context.Context, *ByteValue) (*Bool, error)
+	// SetMany values, returning a map[key]errorText for any errors.
+	SetMany(context.Context, *Hash) (*ErrorHash, error)
+	// CompareAndSwap sets a value only if the current value equals the expected value.
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*SwapResult, error)
+	// SetIfModRevision sets a value only if the key was last modified at the given revision, zero meaning the key doesn't exist.
+	SetIfModRevision(context.Context, *RevisionValue) (*SwapResult, error)
+	// Length returns the length of the value for the given key.
+	Length(context.Context, *Key) (*IntValue, error)
+	// -- number functions
+	// GetInt gets an integer value for the given key.
+	GetInt(context.Context, *Key) (*IntValue, error)
+	// GetFloat gets a float value for the given key.
+	GetFloat(context.Context, *Key) (*FloatValue, error)
+	// SetInt sets an integer.
+	SetInt(context.Context, *IntValue) (*Null, error)
+	// SetFloat sets a float.
+	SetFloat(context.Context, *FloatValue) (*Null, error)
+	// IncrementInt increment an integer stored at the given key by the given number and returns new value.
+	IncrementInt(context.Context, *IntValue) (*IntValue, error)
+	// DecrementInt decrements an integer stored at the given key by the given number and returns new value.
+	DecrementInt(context.Context, *IntValue) (*IntValue, error)
+	// IncrementFloat increment a float stored at the given key by the given number and returns new value.
+	IncrementFloat(context.Context, *FloatValue) (*FloatValue, error)
+	// DecrementFloat decrements a float stored at the given key by the given number and returns new value.
+	DecrementFloat(context.Context, *FloatValue) (*FloatValue, error)
+	// -- list functions
+	// GetList gets a list from the cache.
+	GetList(context.Context, *Key) (*List, error)
+	// GetListItem gets a single item from a list by index, supports negative indexing.
+	GetListItem(context.Context, *ListItem) (*ByteValue, error)
+	// SetList sets a list in the cache.
+	SetList(context.Context, *List) (*Null, error)
+	// SetListItem sets a single item in a list by index.
+	SetListItem(context.Context, *ListItem) (*Null, error)
+	// ListLength returns the number of items in a list.
+	ListLength(context.Context, *Key) (*IntValue, error)
+	// ListLimit sets the maximum length of a list, removing items from the top once limit is reached.
+	ListLimit(context.Context, *ListItem) (*Null, error)
+	// ListInsert inserts a new item at the given index in the list.
+	ListInsert(context.Context, *ListItem) (*Null, error)
+	// ListAppend inserts a new item at the end of the list.
+	ListAppend(context.Context, *ListItem) (*Null, error)
+	// ListPopLeft returns and removes the first item in a list.
+	ListPopLeft(context.Context, *Key) (*ByteValue, error)
+	// ListPopRight returns and removes the last item in a list.
+	ListPopRight(context.Context, *Key) (*ByteValue, error)
+	// ListHas determines if a list contains an item, returns index or -1 if not found.
+	ListHas(context.Context, *ListItem) (*IntValue, error)
+	// ListDelete removes an item from a list by index.
+	ListDelete(context.Context, *ListItem) (*Null, error)
+	// ListDeleteItem removes the first occurrence of value from a list, returns index of removed item or -1 for not found.
+	ListDeleteItem(context.Context, *ListItem) (*IntValue, error)
+	// -- hash functions
+	// GetHash gets a hash from the cache.
+	GetHash(context.Context, *Key) (*Hash, error)
+	// GetHashField gets a single value in a hash.
+	GetHashField(context.Context, *HashField) (*ByteValue, error)
+	// GetHashFields gets multiple hash values.
+	GetHashFields(context.Context, *HashFieldSet) (*Hash, error)
+	// HashHas determines if a hash has a given field.
+	HashHas(context.Context, *HashField) (*Bool, error)
+	// HashLength returns the number of fields in a hash.
+	HashLength(context.Context, *Key) (*IntValue, error)
+	// HashFields gets a list of the fields in a hash.
+	HashFields(context.Context, *Key) (*KeysList, error)
+	// HashValues gets a list of the values in a hash.
+	HashValues(context.Context, *Key) (*List, error)
+	// SetHash sets the value of a hash.
+	SetHash(context.Context, *Hash) (*Null, error)
+	// SetHashField sets a single value in a hash.
+	SetHashField(context.Context, *HashField) (*Null, error)
+	// SetHashFields sets multiple values in a hash.
+	SetHashFields(context.Context, *Hash) (*Null, error)
+	// DelHashField deletes a field from a hash.
+	DelHashField(context.Context, *HashField) (*Null, error)
+	// -- time series functions
+	// GetTimeSeries gets a time series from the cache.
+	GetTimeSeries(context.Context, *Key) (*TimeSeries, error)
+	// TimeSeriesCreate creates a time series, or updates the retention and downsampling rules of an existing one.
+	TimeSeriesCreate(context.Context, *TimeSeries) (*Null, error)
+	// TimeSeriesAdd adds a sample to a time series, creates new time series if doesn't exist.
+	TimeSeriesAdd(context.Context, *TimeSeriesSample) (*Null, error)
+	// TimeSeriesRange gets the samples in a time range, optionally aggregated into buckets.
+	TimeSeriesRange(context.Context, *TimeSeriesQuery) (*TimeSeries, error)
+	// -- json functions
+	// JSONGet gets the JSON value at the given path in a document.
+	JSONGet(context.Context, *JSONPath) (*ByteValue, error)
+	// JSONSet sets the JSON value at the given path in a document, creates new document if setting the root path.
+	JSONSet(context.Context, *JSONValue) (*Null, error)
+	// JSONDel removes the value at the given path from a document.
+	JSONDel(context.Context, *JSONPath) (*Null, error)
+	// JSONArrAppend appends a JSON value to the array at the given path, returns the new length of the array.
+	JSONArrAppend(context.Context, *JSONValue) (*IntValue, error)
+	// JSONNumIncrBy increments the number at the given path and returns the new value.
+	JSONNumIncrBy(context.Context, *JSONNumber) (*FloatValue, error)
+	// -- vector functions
+	// GetVectorIndex gets a vector index, including its vectors.
+	GetVectorIndex(context.Context, *Key) (*VectorIndex, error)
+	// VectorIndexCreate creates a vector index, or updates the settings of an existing one.
+	VectorIndexCreate(context.Context, *VectorIndex) (*Null, error)
+	// GetVector gets a single vector from an index.
+	GetVector(context.Context, *VectorItem) (*VectorItem, error)
+	// VectorAdd adds or replaces a vector in an index, creates new index if doesn't exist.
+	VectorAdd(context.Context, *VectorItem) (*Null, error)
+	// VectorDelete deletes a vector from an index.
+	VectorDelete(context.Context, *VectorItem) (*Null, error)
+	// VectorSearch gets the k nearest neighbours of a vector.
+	VectorSearch(context.Context, *VectorQuery) (*VectorMatches, error)
+	// -- search functions
+	// SearchIndexCreate creates a full-text search index over the given fields of the hashes with the given prefix.
+	SearchIndexCreate(context.Context, *SearchIndex) (*Null, error)
+	// SearchIndexDrop deletes a full-text search index.
+	SearchIndexDrop(context.Context, *Key) (*Null, error)
+	// Search gets the keys of the hashes matching a query, best match first.
+	Search(context.Context, *SearchQuery) (*SearchResults, error)
+	// -- field index functions
+	// FieldIndexCreate creates an index on a field of the hashes with the given prefix.
+	FieldIndexCreate(context.Context, *FieldIndex) (*Null, error)
+	// FieldIndexDrop deletes an index on a field of the hashes with the given prefix.
+	FieldIndexDrop(context.Context, *FieldIndex) (*Null, error)
+	// FindByField gets the keys of the hashes with the given prefix where the field has the given value.
+	FindByField(context.Context, *FieldQuery) (*KeysList, error)
+	// FindByFieldRange gets the keys of the hashes with the given prefix where the numeric field is within the given range.
+	FindByFieldRange(context.Context, *FieldQuery) (*KeysList, error)
+	// -- transaction functions
+	// Txn evaluates the comparisons and applies the success operations if they all pass, otherwise the failure operations, atomically.
+	Txn(context.Context, *TxnRequest) (*TxnResponse, error)
+	// -- scripting functions
+	// Eval runs a script, committing every change it makes atomically.
+	Eval(context.Context, *EvalRequest) (*EvalResponse, error)
+	// EvalSHA runs a script loaded with ScriptLoad, by its SHA1 hash.
+	EvalSHA(context.Context, *EvalRequest) (*EvalResponse, error)
+	// ScriptLoad stores a script without running it, returning its SHA1 hash.
+	ScriptLoad(context.Context, *Script) (*Script, error)
+	// -- custom commands
+	// Command runs a command registered on the server by the program embedding it.
+	Command(context.Context, *CommandRequest) (*ByteValue, error)
+	// -- push functions
+	// Watch for changes to a key.
+	Watch(Mydis_WatchServer) error
+	// Publish sends a message to the subscribers of a channel, returning the number of subscribers it was sent to.
+	Publish(context.Context, *PubSubMessage) (*IntValue, error)
+	// Subscribe to channels and channel patterns.
+	Subscribe(Mydis_SubscribeServer) error
+	// PubSubChannels lists the channels with subscribers, matching an optional pattern.
+	PubSubChannels(context.Context, *Key) (*PubSubChannelList, error)
+	// -- auth passthrough functions
+	// AuthEnable enables authentication.
+	AuthEnable(context.Context, *AuthEnableRequest) (*AuthEnableResponse, error)
+	// AuthDisable disables authentication.
+	AuthDisable(context.Context, *AuthDisableRequest) (*AuthDisableResponse, error)
+	// Authenticate processes an authenticate request.
+	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
+	// UserAdd adds a new user.
+	UserAdd(context.Context, *AuthUserAddRequest) (*AuthUserAddResponse, error)
+	// UserGet gets detailed user information.
+	UserGet(context.Context, *AuthUserGetRequest) (*AuthUserGetResponse, error)
+	// UserList gets a list of all users.
+	UserList(context.Context, *AuthUserListRequest) (*AuthUserListResponse, error)
+	// UserDelete deletes a specified user.
+	UserDelete(context.Context, *AuthUserDeleteRequest) (*AuthUserDeleteResponse, error)
+	// UserChangePassword changes the password of a specified user.
+	UserChangePassword(context.Context, *AuthUserChangePasswordRequest) (*AuthUserChangePasswordResponse, error)
+	// UserGrantRole grants a role to a specified user.
+	UserGrantRole(context.Context, *AuthUserGrantRoleRequest) (*AuthUserGrantRoleResponse, error)
+	// UserRevokeRole revokes a role of specified user.
+	UserRevokeRole(context.Context, *AuthUserRevokeRoleRequest) (*AuthUserRevokeRoleResponse, error)
+	// RoleAdd adds a new role.
+	RoleAdd(context.Context, *AuthRoleAddRequest) (*AuthRoleAddResponse, error)
+	// RoleGet gets detailed role information.
+	RoleGet(context.Context, *AuthRoleGetRequest) (*AuthRoleGetResponse, error)
+	// RoleList gets lists of all roles.
+	RoleList(context.Context, *AuthRoleListRequest) (*AuthRoleListResponse, error)
+	// RoleDelete deletes a specified role.
+	RoleDelete(context.Context, *AuthRoleDeleteRequest) (*AuthRoleDeleteResponse, error)
+	// RoleGrantPermission grants a permission of a specified key or range to a specified role.
+	RoleGrantPermission(context.Context, *AuthRoleGrantPermissionRequest) (*AuthRoleGrantPermissionResponse, error)
+	// RoleRevokePermission revokes a key or range permission of a specified role.
+	RoleRevokePermission(context.Context, *AuthRoleRevokePermissionRequest) (*AuthRoleRevokePermissionResponse, error)
+}
+
+func RegisterMydisServer(s *grpc.Server, srv MydisServer) {
+	s.RegisterService(&_Mydis_serviceDesc, srv)
 }
 
-func (c *mydisClient) Set(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Set", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *mydisClient) SetNX(ctx context.Context, in *ByteValue, opts ...grpc.CallOption) (*Bool, error) {
-	out := new(Bool)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetNX", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(MydisServer).Keys(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *mydisClient) SetMany(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*ErrorHash, error) {
-	out := new(ErrorHash)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetMany", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Keys",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Keys(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) Length(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Length", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_KeysWithPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).KeysWithPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/KeysWithPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).KeysWithPrefix(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetInt(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetInt", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Has_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Has(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Has",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Has(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetFloat(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FloatValue, error) {
-	out := new(FloatValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetFloat", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetExpire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetExpire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetExpire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetExpire(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetInt", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetExpireMillis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetExpireMillis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetExpireMillis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetExpireMillis(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetFloat", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_ExpireAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).ExpireAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/ExpireAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).ExpireAt(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) IncrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/IncrementInt", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_ExpireAtMillis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).ExpireAtMillis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/ExpireAtMillis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).ExpireAtMillis(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) DecrementInt(ctx context.Context, in *IntValue, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/DecrementInt", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetWithExpire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetWithExpire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetWithExpire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetWithExpire(ctx, req.(*ExpiringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) IncrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error) {
-	out := new(FloatValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/IncrementFloat", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetWithExpireMillis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetWithExpireMillis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetWithExpireMillis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetWithExpireMillis(ctx, req.(*ExpiringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) DecrementFloat(ctx context.Context, in *FloatValue, opts ...grpc.CallOption) (*FloatValue, error) {
-	out := new(FloatValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/DecrementFloat", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_TTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).TTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/TTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).TTL(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
-	out := new(List)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetList", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_TTLMillis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).TTLMillis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/TTLMillis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).TTLMillis(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*ByteValue, error) {
-	out := new(ByteValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetListItem", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Persist(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetList(ctx context.Context, in *List, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetList", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GrantLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GrantLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GrantLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GrantLease(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetListItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetListItem", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_RevokeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).RevokeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/RevokeLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).RevokeLease(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) ListLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListLength", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GetLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetLease(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) ListLimit(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListLimit", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func _Mydis_KeepAlive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MydisServer).KeepAlive(&mydisKeepAliveServer{stream})
 }
 
-func (c *mydisClient) ListInsert(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListInsert", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Mydis_KeepAliveServer interface {
+	Send(*LeaseInfo) error
+	Recv() (*Key, error)
+	grpc.ServerStream
 }
 
-func (c *mydisClient) ListAppend(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListAppend", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type mydisKeepAliveServer struct {
+	grpc.ServerStream
 }
 
-func (c *mydisClient) ListPopLeft(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
-	out := new(ByteValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListPopLeft", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (x *mydisKeepAliveServer) Send(m *LeaseInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (c *mydisClient) ListPopRight(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ByteValue, error) {
-	out := new(ByteValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListPopRight", in, out, c.cc, opts...)
-	if err != nil {
+func (x *mydisKeepAliveServer) Recv() (*Key, error) {
+	m := new(Key)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-func (c *mydisClient) ListHas(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListHas", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Lock(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) ListDelete(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListDelete", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_LockWithTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Expiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).LockWithTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/LockWithTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).LockWithTimeout(ctx, req.(*Expiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) ListDeleteItem(ctx context.Context, in *ListItem, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/ListDeleteItem", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Unlock(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetHash(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Hash, error) {
-	out := new(Hash)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetHash", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_LockMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysExpiration)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).LockMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/LockMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).LockMany(ctx, req.(*KeysExpiration))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*ByteValue, error) {
-	out := new(ByteValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetHashField", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_UnlockMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).UnlockMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/UnlockMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).UnlockMany(ctx, req.(*KeysList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) GetHashFields(ctx context.Context, in *HashFieldSet, opts ...grpc.CallOption) (*Hash, error) {
-	out := new(Hash)
-	err := grpc.Invoke(ctx, "/pb.Mydis/GetHashFields", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).ListLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/ListLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).ListLocks(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) HashHas(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Bool, error) {
-	out := new(Bool)
-	err := grpc.Invoke(ctx, "/pb.Mydis/HashHas", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).ForceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/ForceUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).ForceUnlock(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) HashLength(ctx context.Context, in *Key, opts ...grpc.CallOption) (*IntValue, error) {
-	out := new(IntValue)
-	err := grpc.Invoke(ctx, "/pb.Mydis/HashLength", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_UnlockThenSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByteValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).UnlockThenSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/UnlockThenSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).UnlockThenSet(ctx, req.(*ByteValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) HashFields(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeysList, error) {
-	out := new(KeysList)
-	err := grpc.Invoke(ctx, "/pb.Mydis/HashFields", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_UnlockThenSetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(List)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).UnlockThenSetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/UnlockThenSetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).UnlockThenSetList(ctx, req.(*List))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) HashValues(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
-	out := new(List)
-	err := grpc.Invoke(ctx, "/pb.Mydis/HashValues", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Delete(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetHash(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetHash", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Clear(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetHashField", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Get(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) SetHashFields(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/SetHashFields", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *mydisClient) DelHashField(ctx context.Context, in *HashField, opts ...grpc.CallOption) (*Null, error) {
-	out := new(Null)
-	err := grpc.Invoke(ctx, "/pb.Mydis/DelHashField", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(MydisServer).GetMany(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetMany(ctx, req.(*KeysList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) Watch(ctx context.Context, opts ...grpc.CallOption) (Mydis_WatchClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Mydis_serviceDesc.Streams[0], c.cc, "/pb.Mydis/Watch", opts...)
-	if err != nil {
+func _Mydis_GetWithPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &mydisWatchClient{stream}
-	return x, nil
-}
-
-type Mydis_WatchClient interface {
-	Send(*WatchRequest) error
-	Recv() (*Event, error)
-	grpc.ClientStream
-}
-
-type mydisWatchClient struct {
-	grpc.ClientStream
-}
-
-func (x *mydisWatchClient) Send(m *WatchRequest) error {
-	return x.ClientStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(MydisServer).GetWithPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetWithPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetWithPrefix(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *mydisWatchClient) Recv() (*Event, error) {
-	m := new(Event)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _Mydis_GetWithRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GetWithRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetWithRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetWithRevision(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) AuthEnable(ctx context.Context, in *AuthEnableRequest, opts ...grpc.CallOption) (*AuthEnableResponse, error) {
-	out := new(AuthEnableResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/AuthEnable", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetManyWithRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GetManyWithRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetManyWithRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetManyWithRevision(ctx, req.(*KeysList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) AuthDisable(ctx context.Context, in *AuthDisableRequest, opts ...grpc.CallOption) (*AuthDisableResponse, error) {
-	out := new(AuthDisableResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/AuthDisable", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByteValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Set(ctx, req.(*ByteValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error) {
-	out := new(AuthenticateResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/Authenticate", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetNX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByteValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetNX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetNX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetNX(ctx, req.(*ByteValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserAdd(ctx context.Context, in *AuthUserAddRequest, opts ...grpc.CallOption) (*AuthUserAddResponse, error) {
-	out := new(AuthUserAddResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserAdd", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetMany(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserGet(ctx context.Context, in *AuthUserGetRequest, opts ...grpc.CallOption) (*AuthUserGetResponse, error) {
-	out := new(AuthUserGetResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserGet", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/CompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserList(ctx context.Context, in *AuthUserListRequest, opts ...grpc.CallOption) (*AuthUserListResponse, error) {
-	out := new(AuthUserListResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserList", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetIfModRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetIfModRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetIfModRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetIfModRevision(ctx, req.(*RevisionValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserDelete(ctx context.Context, in *AuthUserDeleteRequest, opts ...grpc.CallOption) (*AuthUserDeleteResponse, error) {
-	out := new(AuthUserDeleteResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserDelete", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_Length_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).Length(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Length",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Length(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserChangePassword(ctx context.Context, in *AuthUserChangePasswordRequest, opts ...grpc.CallOption) (*AuthUserChangePasswordResponse, error) {
-	out := new(AuthUserChangePasswordResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserChangePassword", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GetInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetInt(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserGrantRole(ctx context.Context, in *AuthUserGrantRoleRequest, opts ...grpc.CallOption) (*AuthUserGrantRoleResponse, error) {
-	out := new(AuthUserGrantRoleResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserGrantRole", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).GetFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetFloat(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) UserRevokeRole(ctx context.Context, in *AuthUserRevokeRoleRequest, opts ...grpc.CallOption) (*AuthUserRevokeRoleResponse, error) {
-	out := new(AuthUserRevokeRoleResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/UserRevokeRole", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetInt(ctx, req.(*IntValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleAdd(ctx context.Context, in *AuthRoleAddRequest, opts ...grpc.CallOption) (*AuthRoleAddResponse, error) {
-	out := new(AuthRoleAddResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleAdd", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_SetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).SetFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/SetFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).SetFloat(ctx, req.(*FloatValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleGet(ctx context.Context, in *AuthRoleGetRequest, opts ...grpc.CallOption) (*AuthRoleGetResponse, error) {
-	out := new(AuthRoleGetResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleGet", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_IncrementInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).IncrementInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/IncrementInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).IncrementInt(ctx, req.(*IntValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleList(ctx context.Context, in *AuthRoleListRequest, opts ...grpc.CallOption) (*AuthRoleListResponse, error) {
-	out := new(AuthRoleListResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleList", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_DecrementInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).DecrementInt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/DecrementInt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).DecrementInt(ctx, req.(*IntValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleDelete(ctx context.Context, in *AuthRoleDeleteRequest, opts ...grpc.CallOption) (*AuthRoleDeleteResponse, error) {
-	out := new(AuthRoleDeleteResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleDelete", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_IncrementFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).IncrementFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/IncrementFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).IncrementFloat(ctx, req.(*FloatValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleGrantPermission(ctx context.Context, in *AuthRoleGrantPermissionRequest, opts ...grpc.CallOption) (*AuthRoleGrantPermissionResponse, error) {
-	out := new(AuthRoleGrantPermissionResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleGrantPermission", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_DecrementFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FloatValue)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MydisServer).DecrementFloat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/DecrementFloat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).DecrementFloat(ctx, req.(*FloatValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mydisClient) RoleRevokePermission(ctx context.Context, in *AuthRoleRevokePermissionRequest, opts ...grpc.CallOption) (*AuthRoleRevokePermissionResponse, error) {
-	out := new(AuthRoleRevokePermissionResponse)
-	err := grpc.Invoke(ctx, "/pb.Mydis/RoleRevokePermission", in, out, c.cc, opts...)
-	if err != nil {
+func _Mydis_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for Mydis service
-
-type MydisServer interface {
-	// -- core functions
-	// Keys returns a list of valid keys.
-	Keys(context.Context, *Null) (*KeysList, error)
-	// KeysWithPrefix returns a list of keys with the given prefix.
-	KeysWithPrefix(context.Context, *Key) (*KeysList, error)
-	// Has checks if the cache has the given key and that it is not expired.
-	Has(context.Context, *Key) (*Bool, error)
-	// SetExpire sets the expiration on a key.
-	SetExpire(context.Context, *Expiration) (*Null, error)
-	// Lock a key from being modified.
-	Lock(context.Context, *Key) (*Null, error)
-	// LockWithTimeout locks a key, waiting for the given number of seconds if already locked before returning an error.
-	LockWithTimeout(context.Context, *Expiration) (*Null, error)
-	// Unlock a key for modifications.
-	Unlock(context.Context, *Key) (*Null, error)
-	// UnlockThenSet unlocks a key, then immediately sets its byte array value.
-	UnlockThenSet(context.Context, *ByteValue) (*Null, error)
-	// UnlockThenSetList unlocks a key, then immediately sets its list value.
-	UnlockThenSetList(context.Context, *List) (*Null, error)
-	// Delete removes a key from the cache.
-	Delete(context.Context, *Key) (*Null, error)
-	// Clear the cache.
-	Clear(context.Context, *Null) (*Null, error)
-	// -- bytes functions
-	// Get gets the value for the given key as a byte slice.
-	Get(context.Context, *Key) (*ByteValue, error)
-	// GetMany gets a list of values from the cache.
-	GetMany(context.Context, *KeysList) (*Hash, error)
-	// GetWithPrefix returns the keys with the given prefix.
-	GetWithPrefix(context.Context, *Key) (*Hash, error)
-	// Set sets the byte value.
-	Set(context.Context, *ByteValue) (*Null, error)
-	// SetNX sets a value only if the key doesn't exist, returns true if changed.
-	SetNX(context.Context, *ByteValue) (*Bool, error)
-	// SetMany values, returning a map[key]errorText for any errors.
-	SetMany(context.Context, *Hash) (*ErrorHash, error)
-	// Length returns the length of the value for the given key.
-	Length(context.Context, *Key) (*IntValue, error)
-	// -- number functions
-	// GetInt gets an integer value for the given key.
-	GetInt(context.Context, *Key) (*IntValue, error)
-	// GetFloat gets a float value for the given key.
-	GetFloat(context.Context, *Key) (*FloatValue, error)
-	// SetInt sets an integer.
-	SetInt(context.Context, *IntValue) (*Null, error)
-	// SetFloat sets a float.
-	SetFloat(context.Context, *FloatValue) (*Null, error)
-	// IncrementInt increment an integer stored at the given key by the given number and returns new value.
-	IncrementInt(context.Context, *IntValue) (*IntValue, error)
-	// DecrementInt decrements an integer stored at the given key by the given number and returns new value.
-	DecrementInt(context.Context, *IntValue) (*IntValue, error)
-	// IncrementFloat increment a float stored at the given key by the given number and returns new value.
-	IncrementFloat(context.Context, *FloatValue) (*FloatValue, error)
-	// DecrementFloat decrements a float stored at the given key by the given number and returns new value.
-	DecrementFloat(context.Context, *FloatValue) (*FloatValue, error)
-	// -- list functions
-	// GetList gets a list from the cache.
-	GetList(context.Context, *Key) (*List, error)
-	// GetListItem gets a single item from a list by index, supports negative indexing.
-	GetListItem(context.Context, *ListItem) (*ByteValue, error)
-	// SetList sets a list in the cache.
-	SetList(context.Context, *List) (*Null, error)
-	// SetListItem sets a single item in a list by index.
-	SetListItem(context.Context, *ListItem) (*Null, error)
-	// ListLength returns the number of items in a list.
-	ListLength(context.Context, *Key) (*IntValue, error)
-	// ListLimit sets the maximum length of a list, removing items from the top once limit is reached.
-	ListLimit(context.Context, *ListItem) (*Null, error)
-	// ListInsert inserts a new item at the given index in the list.
-	ListInsert(context.Context, *ListItem) (*Null, error)
-	// ListAppend inserts a new item at the end of the list.
-	ListAppend(context.Context, *ListItem) (*Null, error)
-	// ListPopLeft returns and removes the first item in a list.
-	ListPopLeft(context.Context, *Key) (*ByteValue, error)
-	// ListPopRight returns and removes the last item in a list.
-	ListPopRight(context.Context, *Key) (*ByteValue, error)
-	// ListHas determines if a list contains an item, returns index or -1 if not found.
-	ListHas(context.Context, *ListItem) (*IntValue, error)
-	// ListDelete removes an item from a list by index.
-	ListDelete(context.Context, *ListItem) (*Null, error)
-	// ListDeleteItem removes the first occurrence of value from a list, returns index of removed item or -1 for not found.
-	ListDeleteItem(context.Context, *ListItem) (*IntValue, error)
-	// -- hash functions
-	// GetHash gets a hash from the cache.
-	GetHash(context.Context, *Key) (*Hash, error)
-	// GetHashField gets a single value in a hash.
-	GetHashField(context.Context, *HashField) (*ByteValue, error)
-	// GetHashFields gets multiple hash values.
-	GetHashFields(context.Context, *HashFieldSet) (*Hash, error)
-	// HashHas determines if a hash has a given field.
-	HashHas(context.Context, *HashField) (*Bool, error)
-	// HashLength returns the number of fields in a hash.
-	HashLength(context.Context, *Key) (*IntValue, error)
-	// HashFields gets a list of the fields in a hash.
-	HashFields(context.Context, *Key) (*KeysList, error)
-	// HashValues gets a list of the values in a hash.
-	HashValues(context.Context, *Key) (*List, error)
-	// SetHash sets the value of a hash.
-	SetHash(context.Context, *Hash) (*Null, error)
-	// SetHashField sets a single value in a hash.
-	SetHashField(context.Context, *HashField) (*Null, error)
-	// SetHashFields sets multiple values in a hash.
-	SetHashFields(context.Context, *Hash) (*Null, error)
-	// DelHashField deletes a field from a hash.
-	DelHashField(context.Context, *HashField) (*Null, error)
-	// -- push functions
-	// Watch for changes to a key.
-	Watch(Mydis_WatchServer) error
-	// -- auth passthrough functions
-	// AuthEnable enables authentication.
-	AuthEnable(context.Context, *AuthEnableRequest) (*AuthEnableResponse, error)
-	// AuthDisable disables authentication.
-	AuthDisable(context.Context, *AuthDisableRequest) (*AuthDisableResponse, error)
-	// Authenticate processes an authenticate request.
-	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
-	// UserAdd adds a new user.
-	UserAdd(context.Context, *AuthUserAddRequest) (*AuthUserAddResponse, error)
-	// UserGet gets detailed user information.
-	UserGet(context.Context, *AuthUserGetRequest) (*AuthUserGetResponse, error)
-	// UserList gets a list of all users.
-	UserList(context.Context, *AuthUserListRequest) (*AuthUserListResponse, error)
-	// UserDelete deletes a specified user.
-	UserDelete(context.Context, *AuthUserDeleteRequest) (*AuthUserDeleteResponse, error)
-	// UserChangePassword changes the password of a specified user.
-	UserChangePassword(context.Context, *AuthUserChangePasswordRequest) (*AuthUserChangePasswordResponse, error)
-	// UserGrantRole grants a role to a specified user.
-	UserGrantRole(context.Context, *AuthUserGrantRoleRequest) (*AuthUserGrantRoleResponse, error)
-	// UserRevokeRole revokes a role of specified user.
-	UserRevokeRole(context.Context, *AuthUserRevokeRoleRequest) (*AuthUserRevokeRoleResponse, error)
-	// RoleAdd adds a new role.
-	RoleAdd(context.Context, *AuthRoleAddRequest) (*AuthRoleAddResponse, error)
-	// RoleGet gets detailed role information.
-	RoleGet(context.Context, *AuthRoleGetRequest) (*AuthRoleGetResponse, error)
-	// RoleList gets lists of all roles.
-	RoleList(context.Context, *AuthRoleListRequest) (*AuthRoleListResponse, error)
-	// RoleDelete deletes a specified role.
-	RoleDelete(context.Context, *AuthRoleDeleteRequest) (*AuthRoleDeleteResponse, error)
-	// RoleGrantPermission grants a permission of a specified key or range to a specified role.
-	RoleGrantPermission(context.Context, *AuthRoleGrantPermissionRequest) (*AuthRoleGrantPermissionResponse, error)
-	// RoleRevokePermission revokes a key or range permission of a specified role.
-	RoleRevokePermission(context.Context, *AuthRoleRevokePermissionRequest) (*AuthRoleRevokePermissionResponse, error)
-}
-
-func RegisterMydisServer(s *grpc.Server, srv MydisServer) {
-	s.RegisterService(&_Mydis_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(MydisServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).GetList(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Mydis_GetListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Keys(ctx, in)
+		return srv.(MydisServer).GetListItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Keys",
+		FullMethod: "/pb.Mydis/GetListItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Keys(ctx, req.(*Null))
+		return srv.(MydisServer).GetListItem(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_KeysWithPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_SetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(List)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).KeysWithPrefix(ctx, in)
+		return srv.(MydisServer).SetList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/KeysWithPrefix",
+		FullMethod: "/pb.Mydis/SetList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).KeysWithPrefix(ctx, req.(*Key))
+		return srv.(MydisServer).SetList(ctx, req.(*List))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Has_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_SetListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Has(ctx, in)
+		return srv.(MydisServer).SetListItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Has",
+		FullMethod: "/pb.Mydis/SetListItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Has(ctx, req.(*Key))
+		return srv.(MydisServer).SetListItem(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetExpire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Expiration)
+func _Mydis_ListLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetExpire(ctx, in)
+		return srv.(MydisServer).ListLength(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetExpire",
+		FullMethod: "/pb.Mydis/ListLength",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetExpire(ctx, req.(*Expiration))
+		return srv.(MydisServer).ListLength(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_ListLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Lock(ctx, in)
+		return srv.(MydisServer).ListLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Lock",
+		FullMethod: "/pb.Mydis/ListLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Lock(ctx, req.(*Key))
+		return srv.(MydisServer).ListLimit(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_LockWithTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Expiration)
+func _Mydis_ListInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).LockWithTimeout(ctx, in)
+		return srv.(MydisServer).ListInsert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/LockWithTimeout",
+		FullMethod: "/pb.Mydis/ListInsert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).LockWithTimeout(ctx, req.(*Expiration))
+		return srv.(MydisServer).ListInsert(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_ListAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Unlock(ctx, in)
+		return srv.(MydisServer).ListAppend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Unlock",
+		FullMethod: "/pb.Mydis/ListAppend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Unlock(ctx, req.(*Key))
+		return srv.(MydisServer).ListAppend(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_UnlockThenSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByteValue)
+func _Mydis_ListPopLeft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).UnlockThenSet(ctx, in)
+		return srv.(MydisServer).ListPopLeft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/UnlockThenSet",
+		FullMethod: "/pb.Mydis/ListPopLeft",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).UnlockThenSet(ctx, req.(*ByteValue))
+		return srv.(MydisServer).ListPopLeft(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_UnlockThenSetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(List)
+func _Mydis_ListPopRight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).UnlockThenSetList(ctx, in)
+		return srv.(MydisServer).ListPopRight(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/UnlockThenSetList",
+		FullMethod: "/pb.Mydis/ListPopRight",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).UnlockThenSetList(ctx, req.(*List))
+		return srv.(MydisServer).ListPopRight(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_ListHas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Delete(ctx, in)
+		return srv.(MydisServer).ListHas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Delete",
+		FullMethod: "/pb.Mydis/ListHas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Delete(ctx, req.(*Key))
+		return srv.(MydisServer).ListHas(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Mydis_ListDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Clear(ctx, in)
+		return srv.(MydisServer).ListDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Clear",
+		FullMethod: "/pb.Mydis/ListDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Clear(ctx, req.(*Null))
+		return srv.(MydisServer).ListDelete(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_ListDeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Get(ctx, in)
+		return srv.(MydisServer).ListDeleteItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Get",
+		FullMethod: "/pb.Mydis/ListDeleteItem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Get(ctx, req.(*Key))
+		return srv.(MydisServer).ListDeleteItem(ctx, req.(*ListItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeysList)
+func _Mydis_GetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetMany(ctx, in)
+		return srv.(MydisServer).GetHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetMany",
+		FullMethod: "/pb.Mydis/GetHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetMany(ctx, req.(*KeysList))
+		return srv.(MydisServer).GetHash(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetWithPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_GetHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashField)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetWithPrefix(ctx, in)
+		return srv.(MydisServer).GetHashField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetWithPrefix",
+		FullMethod: "/pb.Mydis/GetHashField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetWithPrefix(ctx, req.(*Key))
+		return srv.(MydisServer).GetHashField(ctx, req.(*HashField))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByteValue)
+func _Mydis_GetHashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashFieldSet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Set(ctx, in)
+		return srv.(MydisServer).GetHashFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Set",
+		FullMethod: "/pb.Mydis/GetHashFields",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Set(ctx, req.(*ByteValue))
+		return srv.(MydisServer).GetHashFields(ctx, req.(*HashFieldSet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetNX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByteValue)
+func _Mydis_HashHas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashField)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetNX(ctx, in)
+		return srv.(MydisServer).HashHas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetNX",
+		FullMethod: "/pb.Mydis/HashHas",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetNX(ctx, req.(*ByteValue))
+		return srv.(MydisServer).HashHas(ctx, req.(*HashField))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hash)
+func _Mydis_HashLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetMany(ctx, in)
+		return srv.(MydisServer).HashLength(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetMany",
+		FullMethod: "/pb.Mydis/HashLength",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetMany(ctx, req.(*Hash))
+		return srv.(MydisServer).HashLength(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_Length_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Mydis_HashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).Length(ctx, in)
+		return srv.(MydisServer).HashFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/Length",
+		FullMethod: "/pb.Mydis/HashFields",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).Length(ctx, req.(*Key))
+		return srv.(MydisServer).HashFields(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Mydis_HashValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetInt(ctx, in)
+		return srv.(MydisServer).HashValues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetInt",
+		FullMethod: "/pb.Mydis/HashValues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetInt(ctx, req.(*Key))
+		return srv.(MydisServer).HashValues(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_SetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetFloat(ctx, in)
+		return srv.(MydisServer).SetHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetFloat",
+		FullMethod: "/pb.Mydis/SetHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetFloat(ctx, req.(*Key))
+		return srv.(MydisServer).SetHash(ctx, req.(*Hash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntValue)
+func _Mydis_SetHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashField)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetInt(ctx, in)
+		return srv.(MydisServer).SetHashField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetInt",
+		FullMethod: "/pb.Mydis/SetHashField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetInt(ctx, req.(*IntValue))
+		return srv.(MydisServer).SetHashField(ctx, req.(*HashField))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FloatValue)
+func _Mydis_SetHashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetFloat(ctx, in)
+		return srv.(MydisServer).SetHashFields(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetFloat",
+		FullMethod: "/pb.Mydis/SetHashFields",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetFloat(ctx, req.(*FloatValue))
+		return srv.(MydisServer).SetHashFields(ctx, req.(*Hash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_IncrementInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntValue)
+func _Mydis_DelHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashField)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).IncrementInt(ctx, in)
+		return srv.(MydisServer).DelHashField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/IncrementInt",
+		FullMethod: "/pb.Mydis/DelHashField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).IncrementInt(ctx, req.(*IntValue))
+		return srv.(MydisServer).DelHashField(ctx, req.(*HashField))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_DecrementInt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntValue)
+func _Mydis_GetTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).DecrementInt(ctx, in)
+		return srv.(MydisServer).GetTimeSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/DecrementInt",
+		FullMethod: "/pb.Mydis/GetTimeSeries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).DecrementInt(ctx, req.(*IntValue))
+		return srv.(MydisServer).GetTimeSeries(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_IncrementFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FloatValue)
+func _Mydis_TimeSeriesCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeries)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).IncrementFloat(ctx, in)
+		return srv.(MydisServer).TimeSeriesCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/IncrementFloat",
+		FullMethod: "/pb.Mydis/TimeSeriesCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).IncrementFloat(ctx, req.(*FloatValue))
+		return srv.(MydisServer).TimeSeriesCreate(ctx, req.(*TimeSeries))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_DecrementFloat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FloatValue)
+func _Mydis_TimeSeriesAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeriesSample)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).DecrementFloat(ctx, in)
+		return srv.(MydisServer).TimeSeriesAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/DecrementFloat",
+		FullMethod: "/pb.Mydis/TimeSeriesAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).DecrementFloat(ctx, req.(*FloatValue))
+		return srv.(MydisServer).TimeSeriesAdd(ctx, req.(*TimeSeriesSample))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_TimeSeriesRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSeriesQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetList(ctx, in)
+		return srv.(MydisServer).TimeSeriesRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetList",
+		FullMethod: "/pb.Mydis/TimeSeriesRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetList(ctx, req.(*Key))
+		return srv.(MydisServer).TimeSeriesRange(ctx, req.(*TimeSeriesQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_JSONGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPath)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetListItem(ctx, in)
+		return srv.(MydisServer).JSONGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetListItem",
+		FullMethod: "/pb.Mydis/JSONGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetListItem(ctx, req.(*ListItem))
+		return srv.(MydisServer).JSONGet(ctx, req.(*JSONPath))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(List)
+func _Mydis_JSONSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetList(ctx, in)
+		return srv.(MydisServer).JSONSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetList",
+		FullMethod: "/pb.Mydis/JSONSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetList(ctx, req.(*List))
+		return srv.(MydisServer).JSONSet(ctx, req.(*JSONValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_JSONDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPath)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetListItem(ctx, in)
+		return srv.(MydisServer).JSONDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetListItem",
+		FullMethod: "/pb.Mydis/JSONDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetListItem(ctx, req.(*ListItem))
+		return srv.(MydisServer).JSONDel(ctx, req.(*JSONPath))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_JSONArrAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListLength(ctx, in)
+		return srv.(MydisServer).JSONArrAppend(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListLength",
+		FullMethod: "/pb.Mydis/JSONArrAppend",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListLength(ctx, req.(*Key))
+		return srv.(MydisServer).JSONArrAppend(ctx, req.(*JSONValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_JSONNumIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONNumber)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListLimit(ctx, in)
+		return srv.(MydisServer).JSONNumIncrBy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListLimit",
+		FullMethod: "/pb.Mydis/JSONNumIncrBy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListLimit(ctx, req.(*ListItem))
+		return srv.(MydisServer).JSONNumIncrBy(ctx, req.(*JSONNumber))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_GetVectorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListInsert(ctx, in)
+		return srv.(MydisServer).GetVectorIndex(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListInsert",
+		FullMethod: "/pb.Mydis/GetVectorIndex",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListInsert(ctx, req.(*ListItem))
+		return srv.(MydisServer).GetVectorIndex(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_VectorIndexCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorIndex)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListAppend(ctx, in)
+		return srv.(MydisServer).VectorIndexCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListAppend",
+		FullMethod: "/pb.Mydis/VectorIndexCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListAppend(ctx, req.(*ListItem))
+		return srv.(MydisServer).VectorIndexCreate(ctx, req.(*VectorIndex))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListPopLeft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_GetVector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListPopLeft(ctx, in)
+		return srv.(MydisServer).GetVector(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListPopLeft",
+		FullMethod: "/pb.Mydis/GetVector",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListPopLeft(ctx, req.(*Key))
+		return srv.(MydisServer).GetVector(ctx, req.(*VectorItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListPopRight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_VectorAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListPopRight(ctx, in)
+		return srv.(MydisServer).VectorAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListPopRight",
+		FullMethod: "/pb.Mydis/VectorAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListPopRight(ctx, req.(*Key))
+		return srv.(MydisServer).VectorAdd(ctx, req.(*VectorItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListHas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_VectorDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListHas(ctx, in)
+		return srv.(MydisServer).VectorDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListHas",
+		FullMethod: "/pb.Mydis/VectorDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListHas(ctx, req.(*ListItem))
+		return srv.(MydisServer).VectorDelete(ctx, req.(*VectorItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_VectorSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListDelete(ctx, in)
+		return srv.(MydisServer).VectorSearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListDelete",
+		FullMethod: "/pb.Mydis/VectorSearch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListDelete(ctx, req.(*ListItem))
+		return srv.(MydisServer).VectorSearch(ctx, req.(*VectorQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_ListDeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListItem)
+func _Mydis_SearchIndexCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIndex)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).ListDeleteItem(ctx, in)
+		return srv.(MydisServer).SearchIndexCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/ListDeleteItem",
+		FullMethod: "/pb.Mydis/SearchIndexCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).ListDeleteItem(ctx, req.(*ListItem))
+		return srv.(MydisServer).SearchIndexCreate(ctx, req.(*SearchIndex))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Mydis_SearchIndexDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetHash(ctx, in)
+		return srv.(MydisServer).SearchIndexDrop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetHash",
+		FullMethod: "/pb.Mydis/SearchIndexDrop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetHash(ctx, req.(*Key))
+		return srv.(MydisServer).SearchIndexDrop(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashField)
+func _Mydis_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetHashField(ctx, in)
+		return srv.(MydisServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetHashField",
+		FullMethod: "/pb.Mydis/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetHashField(ctx, req.(*HashField))
+		return srv.(MydisServer).Search(ctx, req.(*SearchQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_GetHashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashFieldSet)
+func _Mydis_FieldIndexCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldIndex)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).GetHashFields(ctx, in)
+		return srv.(MydisServer).FieldIndexCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/GetHashFields",
+		FullMethod: "/pb.Mydis/FieldIndexCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).GetHashFields(ctx, req.(*HashFieldSet))
+		return srv.(MydisServer).FieldIndexCreate(ctx, req.(*FieldIndex))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_HashHas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashField)
+func _Mydis_FieldIndexDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldIndex)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).HashHas(ctx, in)
+		return srv.(MydisServer).FieldIndexDrop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/HashHas",
+		FullMethod: "/pb.Mydis/FieldIndexDrop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).HashHas(ctx, req.(*HashField))
+		return srv.(MydisServer).FieldIndexDrop(ctx, req.(*FieldIndex))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_HashLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_FindByField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).HashLength(ctx, in)
+		return srv.(MydisServer).FindByField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/HashLength",
+		FullMethod: "/pb.Mydis/FindByField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).HashLength(ctx, req.(*Key))
+		return srv.(MydisServer).FindByField(ctx, req.(*FieldQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_HashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_FindByFieldRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FieldQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).HashFields(ctx, in)
+		return srv.(MydisServer).FindByFieldRange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/HashFields",
+		FullMethod: "/pb.Mydis/FindByFieldRange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).HashFields(ctx, req.(*Key))
+		return srv.(MydisServer).FindByFieldRange(ctx, req.(*FieldQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_HashValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _Mydis_Txn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).HashValues(ctx, in)
+		return srv.(MydisServer).Txn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/HashValues",
+		FullMethod: "/pb.Mydis/Txn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).HashValues(ctx, req.(*Key))
+		return srv.(MydisServer).Txn(ctx, req.(*TxnRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hash)
+func _Mydis_Eval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetHash(ctx, in)
+		return srv.(MydisServer).Eval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetHash",
+		FullMethod: "/pb.Mydis/Eval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetHash(ctx, req.(*Hash))
+		return srv.(MydisServer).Eval(ctx, req.(*EvalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashField)
+func _Mydis_EvalSHA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetHashField(ctx, in)
+		return srv.(MydisServer).EvalSHA(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetHashField",
+		FullMethod: "/pb.Mydis/EvalSHA",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetHashField(ctx, req.(*HashField))
+		return srv.(MydisServer).EvalSHA(ctx, req.(*EvalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_SetHashFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hash)
+func _Mydis_ScriptLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Script)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).SetHashFields(ctx, in)
+		return srv.(MydisServer).ScriptLoad(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/SetHashFields",
+		FullMethod: "/pb.Mydis/ScriptLoad",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).SetHashFields(ctx, req.(*Hash))
+		return srv.(MydisServer).ScriptLoad(ctx, req.(*Script))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Mydis_DelHashField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashField)
+func _Mydis_Command_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MydisServer).DelHashField(ctx, in)
+		return srv.(MydisServer).Command(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Mydis/DelHashField",
+		FullMethod: "/pb.Mydis/Command",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MydisServer).DelHashField(ctx, req.(*HashField))
+		return srv.(MydisServer).Command(ctx, req.(*CommandRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3230,6 +6922,68 @@ func (x *mydisWatchServer) Recv() (*WatchRequest, error) {
 	return m, nil
 }
 
+func _Mydis_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubSubMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MydisServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).Publish(ctx, req.(*PubSubMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mydis_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MydisServer).Subscribe(&mydisSubscribeServer{stream})
+}
+
+type Mydis_SubscribeServer interface {
+	Send(*PubSubMessage) error
+	Recv() (*SubscribeRequest, error)
+	grpc.ServerStream
+}
+
+type mydisSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *mydisSubscribeServer) Send(m *PubSubMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mydisSubscribeServer) Recv() (*SubscribeRequest, error) {
+	m := new(SubscribeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Mydis_PubSubChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MydisServer).PubSubChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Mydis/PubSubChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MydisServer).PubSubChannels(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mydis_AuthEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthEnableRequest)
 	if err := dec(in); err != nil {
@@ -3538,6 +7292,50 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetExpire",
 			Handler:    _Mydis_SetExpire_Handler,
 		},
+		{
+			MethodName: "SetExpireMillis",
+			Handler:    _Mydis_SetExpireMillis_Handler,
+		},
+		{
+			MethodName: "ExpireAt",
+			Handler:    _Mydis_ExpireAt_Handler,
+		},
+		{
+			MethodName: "ExpireAtMillis",
+			Handler:    _Mydis_ExpireAtMillis_Handler,
+		},
+		{
+			MethodName: "SetWithExpire",
+			Handler:    _Mydis_SetWithExpire_Handler,
+		},
+		{
+			MethodName: "SetWithExpireMillis",
+			Handler:    _Mydis_SetWithExpireMillis_Handler,
+		},
+		{
+			MethodName: "TTL",
+			Handler:    _Mydis_TTL_Handler,
+		},
+		{
+			MethodName: "TTLMillis",
+			Handler:    _Mydis_TTLMillis_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _Mydis_Persist_Handler,
+		},
+		{
+			MethodName: "GrantLease",
+			Handler:    _Mydis_GrantLease_Handler,
+		},
+		{
+			MethodName: "RevokeLease",
+			Handler:    _Mydis_RevokeLease_Handler,
+		},
+		{
+			MethodName: "GetLease",
+			Handler:    _Mydis_GetLease_Handler,
+		},
 		{
 			MethodName: "Lock",
 			Handler:    _Mydis_Lock_Handler,
@@ -3550,6 +7348,22 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unlock",
 			Handler:    _Mydis_Unlock_Handler,
 		},
+		{
+			MethodName: "LockMany",
+			Handler:    _Mydis_LockMany_Handler,
+		},
+		{
+			MethodName: "UnlockMany",
+			Handler:    _Mydis_UnlockMany_Handler,
+		},
+		{
+			MethodName: "ListLocks",
+			Handler:    _Mydis_ListLocks_Handler,
+		},
+		{
+			MethodName: "ForceUnlock",
+			Handler:    _Mydis_ForceUnlock_Handler,
+		},
 		{
 			MethodName: "UnlockThenSet",
 			Handler:    _Mydis_UnlockThenSet_Handler,
@@ -3578,6 +7392,14 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWithPrefix",
 			Handler:    _Mydis_GetWithPrefix_Handler,
 		},
+		{
+			MethodName: "GetWithRevision",
+			Handler:    _Mydis_GetWithRevision_Handler,
+		},
+		{
+			MethodName: "GetManyWithRevision",
+			Handler:    _Mydis_GetManyWithRevision_Handler,
+		},
 		{
 			MethodName: "Set",
 			Handler:    _Mydis_Set_Handler,
@@ -3590,6 +7412,14 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetMany",
 			Handler:    _Mydis_SetMany_Handler,
 		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _Mydis_CompareAndSwap_Handler,
+		},
+		{
+			MethodName: "SetIfModRevision",
+			Handler:    _Mydis_SetIfModRevision_Handler,
+		},
 		{
 			MethodName: "Length",
 			Handler:    _Mydis_Length_Handler,
@@ -3722,6 +7552,122 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelHashField",
 			Handler:    _Mydis_DelHashField_Handler,
 		},
+		{
+			MethodName: "GetTimeSeries",
+			Handler:    _Mydis_GetTimeSeries_Handler,
+		},
+		{
+			MethodName: "TimeSeriesCreate",
+			Handler:    _Mydis_TimeSeriesCreate_Handler,
+		},
+		{
+			MethodName: "TimeSeriesAdd",
+			Handler:    _Mydis_TimeSeriesAdd_Handler,
+		},
+		{
+			MethodName: "TimeSeriesRange",
+			Handler:    _Mydis_TimeSeriesRange_Handler,
+		},
+		{
+			MethodName: "JSONGet",
+			Handler:    _Mydis_JSONGet_Handler,
+		},
+		{
+			MethodName: "JSONSet",
+			Handler:    _Mydis_JSONSet_Handler,
+		},
+		{
+			MethodName: "JSONDel",
+			Handler:    _Mydis_JSONDel_Handler,
+		},
+		{
+			MethodName: "JSONArrAppend",
+			Handler:    _Mydis_JSONArrAppend_Handler,
+		},
+		{
+			MethodName: "JSONNumIncrBy",
+			Handler:    _Mydis_JSONNumIncrBy_Handler,
+		},
+		{
+			MethodName: "GetVectorIndex",
+			Handler:    _Mydis_GetVectorIndex_Handler,
+		},
+		{
+			MethodName: "VectorIndexCreate",
+			Handler:    _Mydis_VectorIndexCreate_Handler,
+		},
+		{
+			MethodName: "GetVector",
+			Handler:    _Mydis_GetVector_Handler,
+		},
+		{
+			MethodName: "VectorAdd",
+			Handler:    _Mydis_VectorAdd_Handler,
+		},
+		{
+			MethodName: "VectorDelete",
+			Handler:    _Mydis_VectorDelete_Handler,
+		},
+		{
+			MethodName: "VectorSearch",
+			Handler:    _Mydis_VectorSearch_Handler,
+		},
+		{
+			MethodName: "SearchIndexCreate",
+			Handler:    _Mydis_SearchIndexCreate_Handler,
+		},
+		{
+			MethodName: "SearchIndexDrop",
+			Handler:    _Mydis_SearchIndexDrop_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Mydis_Search_Handler,
+		},
+		{
+			MethodName: "FieldIndexCreate",
+			Handler:    _Mydis_FieldIndexCreate_Handler,
+		},
+		{
+			MethodName: "FieldIndexDrop",
+			Handler:    _Mydis_FieldIndexDrop_Handler,
+		},
+		{
+			MethodName: "FindByField",
+			Handler:    _Mydis_FindByField_Handler,
+		},
+		{
+			MethodName: "FindByFieldRange",
+			Handler:    _Mydis_FindByFieldRange_Handler,
+		},
+		{
+			MethodName: "Txn",
+			Handler:    _Mydis_Txn_Handler,
+		},
+		{
+			MethodName: "Eval",
+			Handler:    _Mydis_Eval_Handler,
+		},
+		{
+			MethodName: "EvalSHA",
+			Handler:    _Mydis_EvalSHA_Handler,
+		},
+		{
+			MethodName: "ScriptLoad",
+			Handler:    _Mydis_ScriptLoad_Handler,
+		},
+		{
+			MethodName: "Command",
+			Handler:    _Mydis_Command_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _Mydis_Publish_Handler,
+		},
+		{
+			MethodName: "PubSubChannels",
+			Handler:    _Mydis_PubSubChannels_Handler,
+		},
 		{
 			MethodName: "AuthEnable",
 			Handler:    _Mydis_AuthEnable_Handler,
@@ -3788,12 +7734,24 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "KeepAlive",
+			Handler:       _Mydis_KeepAlive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Watch",
 			Handler:       _Mydis_Watch_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Mydis_Subscribe_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "mydis.proto",
 }
@@ -3801,149 +7759,330 @@ var _Mydis_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("mydis.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x72, 0xdb, 0xc8,
-	0x11, 0x16, 0x45, 0x4a, 0x22, 0x9b, 0x3f, 0x96, 0x47, 0x92, 0x4d, 0x73, 0x6d, 0x47, 0x99, 0xa4,
-	0xb2, 0x5a, 0x67, 0x4b, 0x5a, 0xcb, 0x9b, 0xcd, 0xae, 0x6b, 0x37, 0x6b, 0x59, 0xa4, 0x25, 0x95,
-	0x65, 0xaf, 0x03, 0xca, 0xb1, 0x2f, 0x29, 0x07, 0x12, 0xc6, 0x22, 0xca, 0x20, 0xc0, 0x00, 0x90,
-	0xd6, 0x3c, 0xe4, 0x92, 0xaa, 0x1c, 0x72, 0xce, 0x25, 0x4f, 0x90, 0x6b, 0xde, 0x20, 0x2f, 0x91,
-	0x57, 0xc8, 0x83, 0xa4, 0xba, 0x67, 0x80, 0xc1, 0x80, 0x20, 0x6d, 0xb1, 0xf6, 0xa2, 0xc2, 0xcc,
-	0xf4, 0xf7, 0x75, 0x4f, 0x4f, 0x77, 0x0f, 0xa7, 0x4b, 0x50, 0x1f, 0x8e, 0x1d, 0x37, 0xda, 0x1e,
-	0x85, 0x41, 0x1c, 0xb0, 0xc5, 0xd1, 0x69, 0xe7, 0xf6, 0x79, 0x10, 0x9c, 0x7b, 0x62, 0xc7, 0x1e,
-	0xb9, 0x3b, 0xb6, 0xef, 0x07, 0xb1, 0x1d, 0xbb, 0x81, 0xaf, 0x24, 0xf8, 0x32, 0x54, 0x9e, 0x5f,
-	0x78, 0x1e, 0xff, 0xf7, 0x22, 0x94, 0x9f, 0x8a, 0x31, 0x5b, 0x85, 0xf2, 0x3b, 0x31, 0x6e, 0x97,
-	0x36, 0x4b, 0x5b, 0x35, 0x0b, 0x3f, 0xd9, 0x3a, 0x2c, 0x79, 0xee, 0xd0, 0x8d, 0xdb, 0xe5, 0xcd,
-	0xd2, 0x56, 0xd9, 0x92, 0x03, 0xd6, 0x81, 0x6a, 0x28, 0x2e, 0xdd, 0xc8, 0x0d, 0xfc, 0x76, 0x85,
-	0x16, 0xd2, 0x31, 0xfb, 0x15, 0xb4, 0x86, 0xae, 0xff, 0x2c, 0x70, 0xac, 0x44, 0x02, 0x48, 0x22,
-	0x37, 0x4b, 0x72, 0xf6, 0xfb, 0xac, 0x5c, 0x5d, 0xc9, 0x19, 0xb3, 0xec, 0x73, 0xb8, 0x3e, 0x74,
-	0xfd, 0xfd, 0x50, 0xd8, 0xb1, 0x48, 0x45, 0x1b, 0x24, 0x3a, 0xb9, 0x40, 0xd2, 0xf6, 0xfb, 0x9c,
-	0x74, 0x53, 0x49, 0xe7, 0x17, 0x70, 0x77, 0xa7, 0x5e, 0x70, 0xf6, 0xae, 0xdd, 0xda, 0x2c, 0x6d,
-	0x55, 0x2d, 0x39, 0x60, 0x1c, 0x1a, 0xf4, 0x71, 0xe2, 0x0e, 0x45, 0x70, 0x11, 0xb7, 0xaf, 0x11,
-	0xdc, 0x98, 0xe3, 0xb7, 0xa1, 0xf2, 0x38, 0x08, 0x3c, 0x64, 0xb8, 0xb4, 0xbd, 0x0b, 0x41, 0x3e,
-	0xab, 0x5a, 0x72, 0xc0, 0xbf, 0x00, 0xe8, 0xbd, 0x1f, 0xb9, 0x21, 0x39, 0xbb, 0xc0, 0xab, 0xab,
-	0x50, 0x16, 0xef, 0x47, 0xed, 0xc5, 0xcd, 0xd2, 0x16, 0xb3, 0xf0, 0x93, 0x3f, 0x80, 0xda, 0xe3,
-	0x71, 0x2c, 0xfe, 0x80, 0xf0, 0xe2, 0x63, 0x90, 0x6a, 0x10, 0xd2, 0x48, 0xd4, 0xec, 0x42, 0xf5,
-	0xc8, 0x8f, 0x3f, 0x0a, 0xc3, 0x12, 0xcc, 0x97, 0x00, 0x4f, 0xbc, 0xc0, 0xfe, 0x38, 0x54, 0x29,
-	0x41, 0xdd, 0x85, 0xea, 0x53, 0x31, 0x8e, 0x8e, 0xdd, 0x28, 0x66, 0x0c, 0x2a, 0xef, 0xc4, 0x38,
-	0x6a, 0x97, 0x36, 0xcb, 0x5b, 0x35, 0x8b, 0xbe, 0x79, 0x17, 0x2a, 0xb4, 0x36, 0x93, 0xaf, 0x9c,
-	0x5a, 0x5e, 0x1c, 0x56, 0xfc, 0x10, 0xaa, 0xc8, 0x72, 0x14, 0x8b, 0x61, 0x31, 0x93, 0xeb, 0x3b,
-	0xe2, 0x3d, 0x59, 0x56, 0xb6, 0xe4, 0x40, 0xf3, 0x97, 0xb3, 0x9e, 0x19, 0x43, 0xad, 0x17, 0x86,
-	0x41, 0x78, 0x68, 0x47, 0x03, 0x76, 0x1f, 0x96, 0x05, 0x0e, 0xa4, 0xc9, 0xf5, 0xdd, 0x5b, 0xdb,
-	0xa3, 0xd3, 0xed, 0x74, 0x59, 0x7e, 0x45, 0x3d, 0x3f, 0x0e, 0xc7, 0x96, 0x12, 0xec, 0x7c, 0x03,
-	0xf5, 0xcc, 0xf4, 0x87, 0xdc, 0x54, 0x53, 0x6a, 0x1f, 0x2e, 0x7e, 0x5d, 0xe2, 0x7f, 0x2f, 0x01,
-	0xf4, 0xe3, 0xd0, 0xf5, 0xcf, 0x49, 0xf9, 0x24, 0x74, 0x27, 0xeb, 0x11, 0x65, 0x8d, 0x06, 0x6c,
-	0xd3, 0xc1, 0x48, 0x6b, 0xa4, 0x5c, 0xe7, 0x6b, 0x00, 0x3d, 0x79, 0x25, 0x5b, 0xfe, 0x02, 0x95,
-	0x29, 0x46, 0x7c, 0x66, 0x1a, 0xb1, 0x86, 0x46, 0xfc, 0x14, 0xea, 0x1b, 0x59, 0xf5, 0x47, 0x50,
-	0x43, 0xce, 0x27, 0xae, 0xf0, 0x9c, 0x62, 0xe0, 0x5b, 0x5c, 0x4a, 0xec, 0xa6, 0xc1, 0x94, 0x03,
-	0x3d, 0x86, 0x46, 0x4a, 0xd5, 0x17, 0xf1, 0x6c, 0xb6, 0x72, 0x21, 0x9b, 0x0e, 0x3f, 0x1e, 0x42,
-	0xe3, 0x95, 0x1d, 0x9f, 0x0d, 0x2c, 0xf1, 0xe7, 0x0b, 0x51, 0x18, 0xb6, 0x37, 0x60, 0x79, 0x14,
-	0x8a, 0xb7, 0xae, 0x8c, 0xb6, 0xaa, 0xa5, 0x46, 0x28, 0x19, 0x8a, 0x4b, 0x15, 0xb6, 0xf8, 0xc9,
-	0x5a, 0xb0, 0xe8, 0x3a, 0xaa, 0x0a, 0x2e, 0xba, 0x0e, 0x22, 0xcf, 0x6c, 0xff, 0x4c, 0x78, 0xed,
-	0x25, 0x89, 0x94, 0x23, 0xfe, 0xaf, 0x12, 0x2c, 0xf5, 0x2e, 0x85, 0x1f, 0xb3, 0x4f, 0xa1, 0x12,
-	0x8f, 0x47, 0xb2, 0x64, 0xb4, 0xa4, 0xeb, 0x69, 0x41, 0xfe, 0x3d, 0x19, 0x8f, 0x84, 0x45, 0x02,
-	0xec, 0x53, 0x58, 0x39, 0xbb, 0x08, 0x43, 0xe1, 0xcb, 0x3c, 0xa9, 0xef, 0x36, 0x51, 0x36, 0xad,
-	0x13, 0x56, 0xb2, 0xca, 0x3e, 0x83, 0xea, 0x08, 0x0b, 0x70, 0x70, 0x11, 0x91, 0x25, 0x13, 0x92,
-	0xe9, 0x32, 0xdf, 0x84, 0x5a, 0xaa, 0x86, 0xad, 0x40, 0xf9, 0xc5, 0xcb, 0x93, 0xd5, 0x05, 0x06,
-	0xb0, 0xdc, 0xed, 0x1d, 0xf7, 0x4e, 0x7a, 0xab, 0x25, 0xfe, 0xcf, 0x12, 0xc0, 0x0b, 0x11, 0x0e,
-	0xdd, 0x88, 0x6a, 0xe4, 0x0e, 0x54, 0x47, 0x22, 0x1c, 0x9e, 0xe4, 0x2c, 0xd6, 0x12, 0xdb, 0x64,
-	0x71, 0x2a, 0x94, 0x38, 0x53, 0x46, 0x03, 0x39, 0xf3, 0x13, 0xa8, 0x85, 0xb6, 0x7f, 0x2e, 0xde,
-	0x08, 0xdf, 0x51, 0xc7, 0x5a, 0xa5, 0x89, 0x9e, 0xef, 0xf0, 0x7b, 0x50, 0x21, 0x58, 0x15, 0x2a,
-	0x56, 0x6f, 0xaf, 0xbb, 0xba, 0xc0, 0x6a, 0xb0, 0xf4, 0xca, 0x3a, 0x42, 0x5b, 0x58, 0x13, 0x6a,
-	0x38, 0x29, 0x87, 0x8b, 0xfc, 0x6f, 0x25, 0x68, 0x59, 0x22, 0x1a, 0x05, 0x7e, 0x24, 0x0e, 0x85,
-	0xed, 0x88, 0x90, 0xdd, 0x01, 0x38, 0xf3, 0x2e, 0xa2, 0x58, 0x84, 0x6f, 0x5c, 0x87, 0x0c, 0xac,
-	0x58, 0x35, 0x35, 0x73, 0xe4, 0xa0, 0xea, 0xa1, 0x18, 0x9e, 0xca, 0xd5, 0x45, 0x5a, 0xad, 0xca,
-	0x89, 0x23, 0xc7, 0xb8, 0xc6, 0xca, 0xb9, 0x6b, 0x8c, 0x6c, 0x7e, 0x1b, 0xbf, 0x89, 0x45, 0x38,
-	0x24, 0x9f, 0x56, 0xd0, 0xe6, 0xb7, 0xf1, 0x89, 0x08, 0x87, 0x7c, 0x0d, 0xae, 0xef, 0x5d, 0xc4,
-	0x83, 0x9e, 0x6f, 0x9f, 0x7a, 0x42, 0x05, 0x11, 0x5f, 0x07, 0x86, 0x93, 0x5d, 0x37, 0xca, 0xce,
-	0xf6, 0x60, 0x0d, 0x67, 0x85, 0x1f, 0xbb, 0x67, 0x74, 0xf5, 0xc8, 0x88, 0x63, 0x50, 0xf1, 0xed,
-	0xa1, 0x50, 0x21, 0x47, 0xdf, 0x68, 0xce, 0xc8, 0x8e, 0xa2, 0x1f, 0x83, 0x30, 0x49, 0x89, 0x74,
-	0xcc, 0xbb, 0x92, 0xfc, 0x65, 0x24, 0xc2, 0x3d, 0xc7, 0x99, 0x97, 0x65, 0x4b, 0xb3, 0x1c, 0x88,
-	0x78, 0x06, 0x0b, 0xff, 0x35, 0x6c, 0x24, 0x92, 0x5d, 0xe1, 0x89, 0x99, 0x86, 0xf3, 0x1f, 0xe0,
-	0x4e, 0x22, 0xbc, 0x3f, 0xc0, 0x73, 0x7d, 0xa1, 0x14, 0xce, 0x6b, 0xe7, 0x63, 0x68, 0xa7, 0x76,
-	0x86, 0xb6, 0x1f, 0x5b, 0x81, 0x97, 0x35, 0xe0, 0x22, 0x12, 0x61, 0xc2, 0x85, 0xdf, 0x38, 0x17,
-	0x06, 0x5e, 0x52, 0x00, 0xe9, 0x9b, 0xef, 0xc3, 0xad, 0x84, 0xc3, 0x12, 0x97, 0xc1, 0x3b, 0x91,
-	0x23, 0x99, 0x30, 0xa8, 0x88, 0x44, 0x39, 0x0c, 0xa1, 0xb3, 0xdd, 0x9e, 0x95, 0x34, 0x5d, 0x4b,
-	0x9c, 0xa5, 0x0c, 0xe7, 0x86, 0x8c, 0x08, 0x34, 0x0c, 0x6f, 0xbb, 0x24, 0x50, 0xd4, 0x34, 0x12,
-	0x64, 0xa7, 0xd5, 0x41, 0xe0, 0xf4, 0xc4, 0x41, 0x4c, 0x50, 0xbf, 0x86, 0xbb, 0xa9, 0x11, 0xe8,
-	0x37, 0x9d, 0xa4, 0xb3, 0x36, 0xce, 0xa1, 0x82, 0xc9, 0x4b, 0x1b, 0xaf, 0xef, 0xb6, 0xcc, 0xec,
-	0xb6, 0x68, 0x8d, 0x3b, 0xf0, 0xb3, 0x84, 0x59, 0x7a, 0xb3, 0x90, 0x3a, 0x6f, 0x50, 0xb6, 0x16,
-	0xd4, 0xa6, 0xd4, 0x82, 0x5a, 0xa6, 0x16, 0x3c, 0x92, 0x4e, 0x4c, 0xf2, 0x4a, 0x26, 0x3a, 0xbb,
-	0x07, 0xcb, 0x03, 0x4a, 0x76, 0xa2, 0xae, 0xef, 0x32, 0xb4, 0xd0, 0x2c, 0x03, 0x96, 0x92, 0xe0,
-	0x7b, 0xd2, 0x8b, 0x69, 0x12, 0xce, 0x41, 0xf1, 0x1a, 0xd6, 0xcd, 0x8c, 0xbd, 0x3a, 0x07, 0x5e,
-	0x3b, 0x71, 0xf0, 0x4e, 0xf8, 0xc9, 0xd5, 0x46, 0x83, 0xc4, 0xb8, 0x34, 0x89, 0xe7, 0x30, 0xee,
-	0x95, 0xa6, 0xa0, 0x30, 0x9b, 0xcf, 0x36, 0x3c, 0x9b, 0x28, 0xb9, 0x28, 0x69, 0xc0, 0xbb, 0x70,
-	0x23, 0x9f, 0xf0, 0x73, 0x98, 0x77, 0x2c, 0x03, 0xb0, 0xa8, 0x12, 0xcc, 0xc1, 0x76, 0xa0, 0x53,
-	0x38, 0x53, 0x06, 0xe6, 0x20, 0x3a, 0x84, 0x4e, 0x51, 0x2d, 0x98, 0x3f, 0xbe, 0xd2, 0x82, 0x30,
-	0x07, 0x85, 0xd0, 0x14, 0xf3, 0x1e, 0xa1, 0xce, 0xd8, 0xf2, 0xd4, 0x8c, 0x55, 0x61, 0xac, 0xeb,
-	0xc9, 0x4f, 0x16, 0x2a, 0x8a, 0x59, 0x17, 0xb0, 0xf9, 0x98, 0xb1, 0x72, 0xa7, 0xcc, 0x34, 0x48,
-	0x82, 0x30, 0x5b, 0xec, 0xe6, 0x70, 0xf0, 0x33, 0x5d, 0xab, 0x26, 0xaa, 0xe0, 0x1c, 0x74, 0xcf,
-	0x61, 0x73, 0x7a, 0xe9, 0xbb, 0x3a, 0xdf, 0xee, 0x7f, 0x38, 0x2c, 0x3d, 0xc3, 0x67, 0x3a, 0x7b,
-	0x00, 0x15, 0x7c, 0x55, 0xb1, 0x2a, 0x4a, 0xe3, 0x43, 0xbc, 0xd3, 0xc0, 0xaf, 0xe4, 0xa5, 0xc5,
-	0xd7, 0xfe, 0xfa, 0xdf, 0xff, 0xfd, 0x63, 0xb1, 0xc9, 0xab, 0x3b, 0x97, 0xf7, 0x77, 0xf0, 0x9d,
-	0xf5, 0xb0, 0x74, 0x8f, 0x3d, 0x81, 0x16, 0x0a, 0xbc, 0x72, 0xe3, 0xc1, 0x0b, 0xf9, 0x9b, 0x74,
-	0x45, 0x81, 0x72, 0xe8, 0x3b, 0x84, 0xbe, 0xc9, 0x59, 0x82, 0xd6, 0x10, 0xe4, 0xf9, 0x1c, 0xca,
-	0x87, 0x76, 0xa4, 0xc1, 0x64, 0x04, 0xbe, 0x69, 0x39, 0x23, 0x60, 0x83, 0xaf, 0x20, 0x70, 0x60,
-	0x93, 0xd6, 0xef, 0xa1, 0xd6, 0x17, 0x31, 0x3d, 0x6a, 0x05, 0xa3, 0x80, 0xd3, 0x0f, 0xdc, 0x4e,
-	0x6a, 0x3f, 0x6f, 0x13, 0x94, 0xf1, 0x26, 0x42, 0xa3, 0x04, 0x80, 0x04, 0xdb, 0x50, 0x39, 0xc6,
-	0xc7, 0xb5, 0xa9, 0x8f, 0x40, 0xc6, 0x36, 0xf1, 0x91, 0x8d, 0xf2, 0x4f, 0xe1, 0x1a, 0xca, 0xa3,
-	0xcd, 0xea, 0xcd, 0x3d, 0x43, 0xed, 0x5d, 0x62, 0x68, 0xf3, 0xb5, 0x84, 0x21, 0x03, 0x43, 0xb2,
-	0x5d, 0x58, 0x7e, 0xe9, 0x7b, 0x53, 0xd4, 0x6f, 0x10, 0xf8, 0x1a, 0x07, 0x04, 0x5f, 0xf8, 0x89,
-	0x01, 0x4f, 0xa0, 0x29, 0x31, 0x27, 0x03, 0xe1, 0xe3, 0x93, 0xc3, 0xfc, 0x49, 0x9d, 0x21, 0xb8,
-	0x4d, 0x04, 0x37, 0xf8, 0x75, 0x4d, 0xa0, 0x30, 0xc8, 0x73, 0x04, 0xd7, 0x0d, 0x1e, 0x7a, 0x27,
-	0x13, 0x18, 0xbf, 0x32, 0x34, 0x9b, 0x44, 0xd3, 0xe1, 0x1b, 0x13, 0x34, 0x28, 0xa8, 0xb6, 0x21,
-	0xd3, 0xe2, 0x83, 0xdb, 0x70, 0x48, 0x0c, 0x31, 0xf7, 0x61, 0x69, 0xdf, 0x13, 0x76, 0x98, 0x09,
-	0x32, 0x8d, 0x59, 0x27, 0x4c, 0x8b, 0xd7, 0x10, 0x73, 0x86, 0x62, 0x12, 0x52, 0x3e, 0x10, 0xb1,
-	0xd6, 0x61, 0x6e, 0xdc, 0x0c, 0x8f, 0x73, 0xb9, 0xc9, 0x6f, 0x60, 0xe5, 0x40, 0xc4, 0xcf, 0x6c,
-	0x7f, 0xcc, 0x8c, 0x20, 0x94, 0xba, 0xf0, 0xe5, 0xc6, 0x6f, 0x10, 0x6c, 0x95, 0xd7, 0x15, 0x0c,
-	0x85, 0x11, 0xfa, 0x08, 0x9a, 0x07, 0x22, 0x2e, 0x0a, 0x67, 0x8d, 0x35, 0x3c, 0x7c, 0x9e, 0x95,
-	0x96, 0x6e, 0x29, 0xcf, 0x3c, 0x1f, 0xc3, 0xe0, 0x48, 0x1a, 0xfc, 0x15, 0x2c, 0xf5, 0x45, 0xfc,
-	0xfc, 0x75, 0x21, 0x8a, 0xb2, 0xc0, 0xf0, 0x4d, 0x84, 0xb2, 0x88, 0x7b, 0x08, 0x2b, 0x7d, 0xb5,
-	0xd1, 0xd4, 0x3c, 0xe9, 0xa0, 0xb4, 0xa1, 0x60, 0xee, 0x34, 0xd2, 0x3b, 0xfd, 0x0a, 0x96, 0x8f,
-	0x85, 0x7f, 0x1e, 0x0f, 0x72, 0x19, 0x9b, 0xf4, 0x70, 0xcc, 0x23, 0xf4, 0x48, 0x54, 0xe1, 0x0e,
-	0x44, 0x7c, 0xe4, 0xc7, 0x1f, 0x85, 0x3b, 0x27, 0x51, 0xc4, 0x7d, 0x0b, 0xd5, 0x03, 0x11, 0x53,
-	0xb7, 0x47, 0x23, 0x29, 0x89, 0x74, 0x07, 0x88, 0xdf, 0x24, 0xec, 0x75, 0xde, 0x50, 0x58, 0x5a,
-	0x42, 0xf4, 0x6f, 0x61, 0xb9, 0x2f, 0xb5, 0x1a, 0xca, 0xa6, 0x45, 0x5c, 0x94, 0xaa, 0xfd, 0x0e,
-	0xaa, 0xfd, 0x44, 0x6d, 0x4e, 0x5b, 0x06, 0x6c, 0xe8, 0x8d, 0x32, 0x7a, 0x0f, 0xa0, 0x71, 0xe4,
-	0x9f, 0x85, 0x62, 0x28, 0xfc, 0x02, 0xed, 0xe6, 0xc6, 0x3f, 0x21, 0x92, 0x0d, 0xbe, 0x8a, 0x24,
-	0x6e, 0x06, 0xa5, 0x88, 0xba, 0x62, 0x1e, 0x22, 0x47, 0x98, 0x44, 0x3f, 0x40, 0x2b, 0xb5, 0xa8,
-	0x78, 0x5b, 0x79, 0xa7, 0x1a, 0xa5, 0xd7, 0x35, 0xb0, 0x8a, 0x30, 0xb5, 0x6c, 0x0e, 0x42, 0x47,
-	0xe4, 0x09, 0xbf, 0xa4, 0xf4, 0xa3, 0xca, 0x62, 0x66, 0x0f, 0x5d, 0x04, 0xf9, 0xcc, 0x4b, 0xca,
-	0xc9, 0x13, 0xa8, 0x2b, 0x14, 0x75, 0xdc, 0x1a, 0x09, 0x00, 0x47, 0xf9, 0xa4, 0xef, 0x10, 0xc7,
-	0x3a, 0xbf, 0x96, 0xe1, 0x40, 0x39, 0xe4, 0xf9, 0x0d, 0xe5, 0xc4, 0xd4, 0xba, 0x96, 0x4f, 0x87,
-	0x44, 0xfd, 0x1e, 0xd4, 0xfb, 0x53, 0xd5, 0x6b, 0xb8, 0xa1, 0x39, 0x32, 0x35, 0xff, 0x0e, 0x00,
-	0x87, 0xb3, 0xb3, 0xea, 0x16, 0x11, 0xac, 0xf1, 0x16, 0x65, 0x55, 0x2a, 0x2e, 0x43, 0xb5, 0x46,
-	0x78, 0x6a, 0x6a, 0x4f, 0x33, 0xc0, 0xb8, 0xd3, 0xbc, 0x44, 0x5c, 0x5e, 0x8a, 0xa4, 0xfe, 0xc8,
-	0x8f, 0x44, 0x38, 0x1d, 0x3f, 0xa1, 0x5f, 0xca, 0x67, 0x08, 0xf6, 0x46, 0x23, 0xe1, 0x3b, 0x1f,
-	0x4f, 0x20, 0xe5, 0x95, 0x0f, 0x11, 0xf0, 0x22, 0x18, 0x1d, 0x8b, 0xb7, 0xd3, 0x4b, 0xb6, 0xe1,
-	0x43, 0x4f, 0x03, 0x90, 0x62, 0x1f, 0x1a, 0x8a, 0xc2, 0x72, 0xcf, 0x07, 0xd3, 0x39, 0x8c, 0x14,
-	0xf1, 0x32, 0x08, 0xe9, 0xc8, 0x15, 0x24, 0xc1, 0x1f, 0x14, 0xe6, 0x2e, 0xcc, 0xa3, 0x30, 0x42,
-	0xc1, 0x93, 0x80, 0x8c, 0x1f, 0xd4, 0xe5, 0xf6, 0xd1, 0x7e, 0xe8, 0xa6, 0xb7, 0xdc, 0x53, 0x68,
-	0x69, 0x82, 0x82, 0x70, 0x32, 0xcd, 0x30, 0xb2, 0xc9, 0x33, 0x70, 0x3a, 0x9b, 0xa8, 0x71, 0x5a,
-	0x70, 0x17, 0xe5, 0xb3, 0x09, 0x27, 0x11, 0x75, 0x08, 0x0d, 0x85, 0x92, 0xfd, 0xce, 0x66, 0x82,
-	0xa0, 0xe1, 0x87, 0xf2, 0xe9, 0xd0, 0x8e, 0x48, 0x4e, 0xfe, 0x62, 0x68, 0x66, 0x99, 0x22, 0xb6,
-	0x6a, 0x50, 0xf5, 0x45, 0x3c, 0xe3, 0x6a, 0xd4, 0x30, 0x75, 0x5d, 0xe1, 0x04, 0x9e, 0x4b, 0xce,
-	0x1e, 0x7d, 0xd1, 0x19, 0x1b, 0x1a, 0x48, 0x69, 0x95, 0x5c, 0x28, 0x7e, 0x85, 0xe4, 0x1a, 0xa4,
-	0xe2, 0x19, 0xbc, 0xda, 0xc3, 0x94, 0x1f, 0xa9, 0x13, 0xf8, 0xac, 0xed, 0x84, 0x27, 0x3d, 0x51,
-	0x51, 0x5d, 0x9b, 0xc0, 0x4a, 0x51, 0x5d, 0x92, 0xe8, 0x08, 0xf5, 0x35, 0x3d, 0xbd, 0x24, 0x25,
-	0x67, 0xd8, 0x85, 0x46, 0x7f, 0xc6, 0x19, 0x6a, 0x02, 0x23, 0x19, 0xa2, 0x0c, 0x44, 0x26, 0x65,
-	0xb3, 0x6f, 0x9c, 0x5f, 0x91, 0x09, 0xc6, 0xb9, 0x45, 0xf9, 0x73, 0xeb, 0xe2, 0xdd, 0xe5, 0x5d,
-	0xd5, 0x10, 0x27, 0x03, 0x91, 0x27, 0xb0, 0x44, 0x6d, 0x6e, 0x19, 0x40, 0xd9, 0x8e, 0x77, 0xa7,
-	0x96, 0x76, 0x9d, 0xcd, 0x1f, 0x3a, 0x3f, 0xa2, 0xd0, 0xc3, 0xd2, 0xbd, 0xad, 0xd2, 0x17, 0x25,
-	0xf6, 0x1d, 0x80, 0x6e, 0xc7, 0xb0, 0x0d, 0x84, 0x4c, 0xb4, 0x3d, 0x3b, 0x37, 0xf2, 0xd3, 0xf2,
-	0xd1, 0xc3, 0x17, 0xd8, 0x23, 0xa8, 0x67, 0x7a, 0x31, 0x2c, 0x15, 0x34, 0x3b, 0xa4, 0x9d, 0x9b,
-	0x13, 0xf3, 0x29, 0xc3, 0x3e, 0x34, 0xb2, 0xad, 0x18, 0x96, 0x8a, 0xe6, 0xda, 0xa9, 0x9d, 0xf6,
-	0xe4, 0x42, 0x4a, 0xf2, 0x2d, 0xac, 0xa8, 0x8e, 0x8b, 0x36, 0xc1, 0xec, 0xa3, 0x6a, 0x13, 0x72,
-	0xad, 0x19, 0x8d, 0xc6, 0x1f, 0xc4, 0x06, 0x5a, 0x37, 0xf9, 0x4c, 0x74, 0xe6, 0x49, 0xcf, 0x17,
-	0xd8, 0xf7, 0x50, 0x4d, 0x9e, 0xc9, 0xcc, 0x10, 0xcb, 0xb4, 0xf8, 0xb4, 0xf1, 0xf9, 0x17, 0x35,
-	0x5f, 0x60, 0x3d, 0x00, 0xdd, 0x92, 0x61, 0xb7, 0xb2, 0x92, 0x46, 0x3b, 0xb0, 0xd3, 0x29, 0x5a,
-	0x4a, 0x69, 0xfe, 0x08, 0x6c, 0xb2, 0x27, 0xc3, 0x7e, 0x9e, 0xc5, 0x14, 0x76, 0x6e, 0x3b, 0x7c,
-	0x96, 0x48, 0x4a, 0xff, 0x1c, 0x9a, 0x46, 0x93, 0x86, 0xdd, 0x36, 0x5c, 0x92, 0x6b, 0xe1, 0x76,
-	0xee, 0x4c, 0x59, 0x4d, 0xf9, 0x7e, 0x0f, 0x2d, 0xb3, 0x57, 0xc3, 0x0c, 0xc8, 0x44, 0x3f, 0xb7,
-	0x73, 0x77, 0xda, 0x72, 0xf6, 0x1c, 0x55, 0xd3, 0x46, 0x9f, 0xa3, 0xd9, 0xd6, 0xd5, 0xe7, 0x98,
-	0xeb, 0xee, 0x68, 0xb4, 0x11, 0x05, 0x66, 0xab, 0xd7, 0x44, 0x4f, 0x44, 0x41, 0xd2, 0x86, 0x61,
-	0x86, 0x58, 0x61, 0x14, 0xe4, 0x3b, 0x36, 0x32, 0x0a, 0x74, 0x4f, 0x44, 0x47, 0xc1, 0x44, 0x53,
-	0x58, 0x47, 0xc1, 0x64, 0x0b, 0x85, 0x2f, 0xb0, 0x3f, 0xc1, 0x5a, 0x41, 0x53, 0x84, 0x71, 0xc3,
-	0xf2, 0xc2, 0xbe, 0x71, 0xe7, 0x17, 0x33, 0x65, 0x52, 0x0d, 0x67, 0xb0, 0x5e, 0xd4, 0x27, 0x61,
-	0x06, 0x7c, 0x4a, 0x03, 0xb9, 0xf3, 0xcb, 0xd9, 0x42, 0x89, 0x92, 0xd3, 0x65, 0xfa, 0xe7, 0x85,
-	0x07, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x07, 0xb6, 0x0e, 0xed, 0x20, 0x00, 0x00,
+	// 5194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5c, 0xcd, 0x73, 0x1b, 0xc9,
+	0x75, 0x17, 0xbe, 0x81, 0x07, 0x12, 0x02, 0x87, 0x94, 0x84, 0x85, 0xb5, 0x32, 0xdd, 0x76, 0xd9,
+	0xb4, 0xec, 0x48, 0xbb, 0x5c, 0xc7, 0x59, 0xcb, 0xbb, 0xde, 0x85, 0x08, 0x88, 0x84, 0xc5, 0x2f,
+	0x0d, 0x20, 0xad, 0x12, 0x97, 0xc3, 0x1d, 0x02, 0x4d, 0x70, 0xa2, 0xc1, 0x0c, 0x76, 0x66, 0x40,
+	0x91, 0xae, 0x4a, 0x55, 0x2a, 0x29, 0x1f, 0x92, 0xca, 0x29, 0xb9, 0xe4, 0x92, 0xbf, 0x21, 0xb7,
+	0xfc, 0x23, 0xae, 0x1c, 0x73, 0x48, 0x55, 0xee, 0xf9, 0x17, 0x52, 0xaf, 0x3f, 0x66, 0xba, 0xe7,
+	0x83, 0x2b, 0xb1, 0xf6, 0xc2, 0x9a, 0xee, 0x7e, 0xbf, 0x5f, 0xbf, 0x7e, 0xfd, 0xfa, 0x75, 0x4f,
+	0xcf, 0x03, 0xa1, 0x39, 0xbf, 0x9a, 0xda, 0xc1, 0xa3, 0x85, 0xef, 0x85, 0x9e, 0x51, 0x5c, 0x9c,
+	0x76, 0xef, 0xcf, 0x3c, 0x6f, 0xe6, 0xd0, 0xc7, 0xd6, 0xc2, 0x7e, 0x6c, 0xb9, 0xae, 0x17, 0x5a,
+	0xa1, 0xed, 0xb9, 0x42, 0x82, 0x54, 0xa1, 0x7c, 0xb8, 0x74, 0x1c, 0xf2, 0x1f, 0x45, 0x28, 0x3d,
+	0xa7, 0x57, 0x46, 0x1b, 0x4a, 0x6f, 0xe8, 0x55, 0xa7, 0xb0, 0x59, 0xd8, 0x6a, 0x98, 0xf8, 0x68,
+	0x6c, 0x40, 0xc5, 0xb1, 0xe7, 0x76, 0xd8, 0x29, 0x6d, 0x16, 0xb6, 0x4a, 0x26, 0x2f, 0x18, 0x5d,
+	0xa8, 0xfb, 0xf4, 0xc2, 0x0e, 0x6c, 0xcf, 0xed, 0x94, 0x59, 0x43, 0x54, 0x36, 0x7e, 0x0c, 0xad,
+	0xb9, 0xed, 0x1e, 0x78, 0x53, 0x53, 0x4a, 0x00, 0x93, 0x48, 0xd4, 0x32, 0x39, 0xeb, 0x52, 0x95,
+	0x6b, 0x0a, 0x39, 0xad, 0xd6, 0xf8, 0x39, 0xac, 0xcd, 0x6d, 0x77, 0xc7, 0xa7, 0x56, 0x48, 0x23,
+	0xd1, 0x15, 0x26, 0x9a, 0x6e, 0x60, 0xd2, 0xd6, 0x65, 0x42, 0x7a, 0x55, 0x48, 0x27, 0x1b, 0x70,
+	0x74, 0xa7, 0x8e, 0x37, 0x79, 0xd3, 0x69, 0x6d, 0x16, 0xb6, 0xea, 0x26, 0x2f, 0x18, 0x04, 0x56,
+	0xd8, 0xc3, 0xd8, 0x9e, 0x53, 0x6f, 0x19, 0x76, 0x6e, 0x33, 0xb8, 0x56, 0x47, 0xee, 0x43, 0xf9,
+	0xa9, 0xe7, 0x39, 0xc8, 0x70, 0x61, 0x39, 0x4b, 0xca, 0x6c, 0x56, 0x37, 0x79, 0x81, 0x7c, 0x04,
+	0x30, 0xb8, 0x5c, 0xd8, 0x3e, 0x33, 0x76, 0x86, 0x55, 0xdb, 0x50, 0xa2, 0x97, 0x8b, 0x4e, 0x71,
+	0xb3, 0xb0, 0x65, 0x98, 0xf8, 0x48, 0x86, 0xb0, 0xca, 0x10, 0xb6, 0x3b, 0x7b, 0x85, 0x14, 0xd9,
+	0x53, 0xc1, 0xbb, 0x42, 0xd8, 0x8a, 0xe8, 0x4a, 0x52, 0x95, 0x62, 0x2a, 0x0a, 0x8d, 0x7d, 0x6a,
+	0x05, 0x74, 0xe8, 0x9e, 0x79, 0x86, 0x01, 0x65, 0xd7, 0x9a, 0x53, 0xc1, 0xc3, 0x9e, 0x11, 0x12,
+	0x86, 0x0e, 0xa3, 0x29, 0x99, 0xf8, 0x68, 0x3c, 0x00, 0x98, 0xf9, 0x96, 0x1b, 0xd2, 0xe9, 0x78,
+	0xbc, 0x2f, 0xa6, 0x5a, 0xa9, 0x41, 0x96, 0x37, 0xf4, 0x2a, 0xe8, 0x94, 0x37, 0x4b, 0xc8, 0x82,
+	0xcf, 0xe4, 0x97, 0xd0, 0x7a, 0x4e, 0xaf, 0x02, 0x65, 0x9c, 0x52, 0xaa, 0x10, 0x4b, 0x65, 0x8c,
+	0x74, 0x0c, 0xb0, 0xef, 0x4d, 0xde, 0xec, 0x79, 0xce, 0x94, 0xfa, 0x38, 0x28, 0xef, 0xad, 0x4b,
+	0x7d, 0xa1, 0x20, 0x2f, 0x60, 0xed, 0xc4, 0x5b, 0xba, 0xa1, 0xd0, 0x91, 0x17, 0xd0, 0xeb, 0xac,
+	0xc9, 0x37, 0x4b, 0xdb, 0xa7, 0x53, 0xa1, 0x63, 0x54, 0x26, 0x17, 0x50, 0x47, 0x56, 0x36, 0xe6,
+	0x4c, 0xd3, 0xf1, 0x5e, 0x8a, 0x99, 0xbd, 0x94, 0xf2, 0x7a, 0x29, 0xeb, 0xbd, 0x48, 0xcb, 0x55,
+	0x22, 0xcb, 0x91, 0xc7, 0xd0, 0x90, 0xfd, 0x06, 0x06, 0x81, 0x0a, 0xfa, 0x08, 0xb7, 0x40, 0x73,
+	0x7b, 0xe5, 0xd1, 0xe2, 0xf4, 0x91, 0x6c, 0x35, 0x79, 0x13, 0xf9, 0x3d, 0xac, 0x1e, 0x2f, 0x4f,
+	0x47, 0xcb, 0xd3, 0x03, 0x1a, 0x04, 0xd6, 0x8c, 0x1a, 0x1d, 0xa8, 0x4d, 0xce, 0x2d, 0xd7, 0xa5,
+	0x8e, 0xd0, 0x58, 0x16, 0xb1, 0x65, 0xce, 0x85, 0xc4, 0x94, 0xcb, 0x22, 0xb6, 0x2c, 0xac, 0x30,
+	0xa4, 0xbe, 0xcb, 0x74, 0x6f, 0x98, 0xb2, 0x48, 0x1c, 0x68, 0x8f, 0x96, 0xa7, 0xc1, 0xc4, 0xb7,
+	0x4f, 0xa9, 0x49, 0xbf, 0x59, 0xd2, 0x80, 0x8d, 0x48, 0x50, 0xca, 0xb9, 0x89, 0xca, 0xd8, 0x26,
+	0xa0, 0x41, 0xa7, 0xc8, 0xdb, 0x64, 0xd9, 0xd8, 0x84, 0xe6, 0xd2, 0x0d, 0x24, 0x1b, 0xeb, 0xa9,
+	0x6e, 0xaa, 0x55, 0xe4, 0xb9, 0x1c, 0xcc, 0x4e, 0xac, 0x72, 0xce, 0x60, 0x36, 0xa1, 0x19, 0xe1,
+	0xfc, 0x40, 0x4c, 0xac, 0x5a, 0x45, 0xfe, 0x1a, 0xd6, 0x34, 0xb2, 0x7d, 0x3b, 0x08, 0x8d, 0x3f,
+	0x4b, 0xe8, 0xde, 0xdc, 0x5e, 0x43, 0xab, 0x6a, 0x82, 0xb9, 0xc3, 0x61, 0x93, 0x27, 0xcb, 0xe4,
+	0x13, 0x68, 0x3c, 0xbd, 0x0a, 0xe9, 0x7b, 0x2d, 0x2f, 0xb2, 0x0d, 0xf5, 0xa1, 0x1b, 0xbe, 0x13,
+	0xc6, 0x90, 0x98, 0x5f, 0x00, 0x3c, 0x73, 0x3c, 0xeb, 0xdd, 0x50, 0x05, 0x89, 0x7a, 0x00, 0x75,
+	0x5c, 0x4f, 0x6c, 0xd4, 0x19, 0x2b, 0x89, 0xf4, 0xa1, 0xcc, 0xda, 0xae, 0xe5, 0x2b, 0xc5, 0x81,
+	0x21, 0x33, 0x72, 0x93, 0x3d, 0xa8, 0x23, 0xcb, 0x30, 0xa4, 0xf3, 0x6c, 0x26, 0xdb, 0x9d, 0xd2,
+	0x4b, 0xb9, 0xee, 0x58, 0x21, 0xe6, 0x2f, 0xa9, 0x96, 0xb9, 0x82, 0xc6, 0xc0, 0xf7, 0x3d, 0x7f,
+	0xcf, 0x0a, 0xce, 0x8d, 0x8f, 0xa1, 0x4a, 0xb1, 0x20, 0x27, 0xe9, 0x03, 0x9c, 0xa4, 0xa8, 0x99,
+	0x3f, 0x05, 0x03, 0x37, 0xf4, 0xaf, 0x4c, 0x21, 0xd8, 0xfd, 0x15, 0x34, 0x95, 0xea, 0x6f, 0x33,
+	0x53, 0x43, 0x74, 0xfb, 0xa4, 0xf8, 0x69, 0x81, 0xfc, 0x63, 0x01, 0x60, 0x14, 0x62, 0xac, 0x64,
+	0x9d, 0xa7, 0xa1, 0x8f, 0x55, 0x8b, 0x08, 0x6d, 0x62, 0xc0, 0x23, 0x36, 0x31, 0x5c, 0x1b, 0x2e,
+	0xd7, 0xfd, 0x14, 0x20, 0xae, 0x7c, 0x2f, 0x5d, 0xfe, 0x16, 0xca, 0x39, 0x4a, 0xfc, 0x54, 0x57,
+	0x62, 0x1d, 0x95, 0xf8, 0x2e, 0xba, 0x5f, 0x51, 0xbb, 0x1f, 0x42, 0x03, 0x39, 0x9f, 0xd9, 0xd4,
+	0x99, 0x66, 0x03, 0xcf, 0xb0, 0x49, 0xea, 0xcd, 0x0a, 0x39, 0x13, 0xba, 0x0f, 0x2b, 0x11, 0xd5,
+	0x88, 0x86, 0xd7, 0xb3, 0x95, 0x32, 0xd9, 0x62, 0xf7, 0x23, 0x9f, 0x41, 0x75, 0x64, 0xcd, 0x17,
+	0x0e, 0x35, 0xee, 0x43, 0x23, 0xb4, 0xe7, 0x34, 0x08, 0xad, 0xf9, 0x82, 0xb1, 0x95, 0xcc, 0xb8,
+	0x22, 0x67, 0x31, 0x2c, 0xa1, 0xd5, 0xf7, 0xde, 0xba, 0x01, 0x63, 0x30, 0x97, 0x0e, 0x0b, 0x79,
+	0x53, 0x1a, 0x84, 0xcf, 0x23, 0x8d, 0x64, 0xd1, 0xf8, 0x18, 0x9a, 0xd6, 0x6c, 0xe6, 0xd3, 0x19,
+	0xdb, 0x85, 0x18, 0x4f, 0x6b, 0xfb, 0x36, 0x5a, 0xbb, 0x17, 0x57, 0x9b, 0xaa, 0x8c, 0x71, 0x17,
+	0xaa, 0xa7, 0xcb, 0xc9, 0x1b, 0x2a, 0x17, 0x87, 0x28, 0x91, 0x7f, 0x2e, 0x00, 0xe0, 0x0e, 0x3f,
+	0xa2, 0xbe, 0x4d, 0x83, 0x0c, 0x0b, 0xfc, 0x08, 0x6a, 0x5c, 0xa7, 0x40, 0xcc, 0x2a, 0x30, 0xd7,
+	0xe2, 0x6a, 0xca, 0x26, 0x1c, 0xb1, 0x4f, 0x43, 0xea, 0x32, 0x7d, 0x78, 0x0f, 0x71, 0x85, 0xb1,
+	0x05, 0x15, 0x7f, 0xe9, 0x50, 0xbe, 0x9b, 0x36, 0xb7, 0x0d, 0x64, 0xd0, 0x07, 0x6b, 0x72, 0x01,
+	0xf2, 0x1a, 0xda, 0xb1, 0x36, 0xc2, 0x9a, 0x69, 0x9d, 0x34, 0xfb, 0x16, 0x73, 0xed, 0x5b, 0x52,
+	0xed, 0xfb, 0x2f, 0x05, 0xb8, 0x1d, 0x53, 0xbf, 0x58, 0xd2, 0x4c, 0xb7, 0x33, 0xa0, 0x7c, 0xe6,
+	0x7b, 0x73, 0x41, 0xca, 0x9e, 0x8d, 0x16, 0x14, 0x43, 0x4f, 0x0c, 0xaa, 0x18, 0x7a, 0x49, 0xeb,
+	0x97, 0xdf, 0xcb, 0xfa, 0x15, 0xcd, 0xfa, 0x1f, 0x41, 0xfd, 0xb7, 0xa3, 0xa3, 0xc3, 0x63, 0x2b,
+	0x3c, 0xcf, 0x56, 0x66, 0x61, 0x85, 0xe7, 0xc2, 0x93, 0xd9, 0x33, 0xd9, 0x85, 0x06, 0x22, 0xf2,
+	0x02, 0x6d, 0x06, 0x24, 0xc7, 0xf7, 0xf7, 0x00, 0x90, 0xe8, 0x70, 0x39, 0x3f, 0xa5, 0xfe, 0x4d,
+	0x98, 0x22, 0xcb, 0x6e, 0x42, 0xf5, 0x15, 0x9d, 0x84, 0x9e, 0x8f, 0xc3, 0x64, 0x55, 0x3c, 0x26,
+	0x16, 0x4d, 0x51, 0x22, 0x13, 0x68, 0x72, 0x09, 0xbe, 0xda, 0x5b, 0x50, 0xb4, 0xa7, 0xa2, 0xaf,
+	0xa2, 0x3d, 0x55, 0x60, 0x45, 0x15, 0x86, 0x0b, 0xe0, 0xdc, 0x0a, 0xce, 0x71, 0x01, 0x88, 0x3d,
+	0x5f, 0x14, 0x51, 0x39, 0xc7, 0x0e, 0x42, 0x66, 0xfb, 0x8a, 0xc9, 0x9e, 0xc9, 0x3f, 0x15, 0x65,
+	0x2f, 0x43, 0x16, 0xc3, 0xd3, 0x43, 0x7a, 0x00, 0x30, 0xb5, 0xe7, 0xd4, 0xc5, 0x83, 0x30, 0xdf,
+	0x2c, 0x2b, 0xa6, 0x52, 0x63, 0x6c, 0x41, 0x75, 0x4e, 0x43, 0xdf, 0x9e, 0xb0, 0xee, 0x5a, 0xdb,
+	0x6d, 0x9c, 0x53, 0x4e, 0x79, 0xc0, 0xea, 0x4d, 0xd1, 0xce, 0x77, 0x9a, 0x20, 0x0c, 0x84, 0x02,
+	0xbc, 0x60, 0xfc, 0x14, 0x6a, 0xd4, 0x0d, 0xd1, 0xbd, 0x3a, 0x15, 0xe6, 0xe8, 0xb7, 0x63, 0x02,
+	0x1e, 0xfc, 0x64, 0xbb, 0xb1, 0x05, 0x8d, 0x09, 0x3e, 0x7b, 0xf6, 0x34, 0xe8, 0x54, 0xe3, 0x75,
+	0xc5, 0x85, 0xcd, 0xb8, 0x11, 0x4f, 0x11, 0xa1, 0x6f, 0xd9, 0x2e, 0x9d, 0x8e, 0xec, 0x3f, 0xd0,
+	0x4e, 0x8d, 0x9f, 0x22, 0x94, 0x2a, 0x34, 0x46, 0x80, 0x4d, 0x75, 0xee, 0xb3, 0xf8, 0x4c, 0xbe,
+	0x06, 0x10, 0xb6, 0xc8, 0xde, 0xf6, 0xf8, 0x14, 0x14, 0x33, 0xa6, 0xa0, 0x94, 0x37, 0x05, 0x65,
+	0x6d, 0x0a, 0xc8, 0xff, 0x15, 0xa4, 0xb9, 0xf3, 0xd6, 0x52, 0xde, 0xb4, 0xae, 0x40, 0xe1, 0x0d,
+	0xb3, 0x70, 0xc5, 0x2c, 0xbc, 0xc1, 0xf1, 0x59, 0x8b, 0x85, 0xef, 0x5d, 0xda, 0x73, 0x2b, 0xa4,
+	0xac, 0x97, 0xba, 0xa9, 0x56, 0x21, 0xcf, 0xc2, 0xf7, 0x4e, 0x99, 0x55, 0x11, 0x24, 0x4a, 0xc6,
+	0x27, 0x50, 0x3d, 0xb3, 0x9d, 0x90, 0xfa, 0xc2, 0x80, 0xdf, 0x8b, 0x0d, 0xc8, 0x54, 0x7a, 0xf4,
+	0x8c, 0xb5, 0x8a, 0x3d, 0x98, 0x8b, 0xe2, 0x1e, 0xac, 0x54, 0xbf, 0xd7, 0xc6, 0x73, 0x20, 0x07,
+	0x7c, 0x60, 0x85, 0x93, 0xf3, 0x94, 0x17, 0x6f, 0x40, 0x25, 0x98, 0x78, 0x7e, 0x14, 0xd6, 0x59,
+	0x21, 0xdf, 0x87, 0xc9, 0x13, 0x58, 0x55, 0xe8, 0x28, 0x73, 0x9f, 0x39, 0x7f, 0xec, 0x14, 0x92,
+	0xee, 0xc3, 0x64, 0x4c, 0xd9, 0x4e, 0x5e, 0xc2, 0xea, 0x88, 0x5a, 0xfe, 0xe4, 0xfc, 0xd8, 0x0b,
+	0x42, 0xdb, 0x9d, 0xbd, 0xf3, 0x3e, 0x78, 0x1f, 0x1a, 0x0b, 0x2f, 0xb0, 0xd9, 0x1b, 0x31, 0x9b,
+	0xea, 0x8a, 0x19, 0x57, 0x90, 0x2f, 0xa0, 0xa5, 0xd1, 0x06, 0x78, 0x18, 0x5d, 0x88, 0x67, 0xf5,
+	0x30, 0xaa, 0x49, 0x99, 0x91, 0x08, 0xd9, 0x92, 0x04, 0x7d, 0x6f, 0xb2, 0x9c, 0x53, 0x37, 0xc4,
+	0xc9, 0x73, 0xa8, 0x3b, 0x0b, 0xcf, 0x99, 0x6e, 0x15, 0x53, 0x94, 0xc8, 0x11, 0x34, 0xb9, 0x64,
+	0xde, 0x62, 0x65, 0xb3, 0x4e, 0xcf, 0xec, 0x4b, 0x31, 0x00, 0x51, 0xc2, 0x7a, 0x36, 0x14, 0xae,
+	0x7e, 0xc3, 0x14, 0x25, 0x32, 0x91, 0x84, 0x79, 0xee, 0xb8, 0x01, 0x95, 0x6f, 0xb0, 0x49, 0x1a,
+	0x84, 0x15, 0x90, 0xce, 0x3b, 0x3b, 0x0b, 0xc4, 0xbe, 0x58, 0x31, 0x45, 0x29, 0x3e, 0x4b, 0x46,
+	0x2b, 0x1c, 0xcf, 0x92, 0xbf, 0x84, 0x15, 0xde, 0x89, 0x49, 0x83, 0xa5, 0x93, 0x73, 0x60, 0x48,
+	0x7b, 0x01, 0x79, 0x01, 0xab, 0x2a, 0x2e, 0x40, 0xb1, 0xd0, 0x0b, 0x2d, 0x47, 0x9c, 0x0e, 0x78,
+	0xc1, 0x78, 0x08, 0x35, 0x9f, 0x0b, 0x88, 0xbd, 0xb6, 0x1d, 0x1b, 0x9b, 0x23, 0x4d, 0x29, 0x80,
+	0x2f, 0x95, 0xec, 0xdc, 0xc2, 0xed, 0x17, 0x5b, 0xab, 0xa0, 0x59, 0x2b, 0xdb, 0x0b, 0x3a, 0x50,
+	0x73, 0x97, 0x73, 0x2a, 0x23, 0x5d, 0xdd, 0x94, 0x45, 0xf2, 0x29, 0xac, 0xc4, 0xac, 0x2c, 0x4e,
+	0xd5, 0x6c, 0xfe, 0x28, 0xa6, 0xbf, 0x85, 0x1a, 0xc5, 0x22, 0xa6, 0x6c, 0x26, 0xff, 0x59, 0x10,
+	0x0a, 0xbd, 0x90, 0x76, 0x7d, 0x0f, 0x85, 0x32, 0xb7, 0x28, 0xb4, 0xee, 0xdc, 0xe6, 0x1b, 0x6c,
+	0xc1, 0xc4, 0x47, 0x56, 0x63, 0x5d, 0x76, 0x2a, 0xa2, 0xc6, 0xba, 0x8c, 0xe7, 0xa9, 0xaa, 0xde,
+	0xd6, 0x6c, 0x40, 0xc5, 0xf5, 0x0e, 0x6c, 0x97, 0x85, 0xcb, 0xba, 0xc9, 0x0b, 0xa2, 0xd6, 0xba,
+	0xec, 0xd4, 0xa3, 0x5a, 0xeb, 0x92, 0xfc, 0x25, 0xac, 0xca, 0xdb, 0x91, 0xf7, 0xbb, 0x87, 0xd8,
+	0x84, 0xe6, 0x5c, 0xb9, 0xcb, 0xe1, 0x07, 0x04, 0xb5, 0x8a, 0xfc, 0x1a, 0x5a, 0x1a, 0x35, 0xae,
+	0x71, 0x75, 0x87, 0x14, 0xab, 0x49, 0x93, 0x89, 0x36, 0xcd, 0xdf, 0xc1, 0x9d, 0x1d, 0x6f, 0xbe,
+	0xb0, 0x7c, 0xda, 0x73, 0xa7, 0xa3, 0xb7, 0xd6, 0x42, 0xbe, 0xdc, 0xa6, 0xf5, 0xeb, 0x42, 0x9d,
+	0x5e, 0x2e, 0xe8, 0x24, 0xa4, 0x53, 0xa1, 0x62, 0x54, 0xce, 0xdf, 0xfd, 0x39, 0x25, 0x73, 0xe3,
+	0x0e, 0xd4, 0x82, 0xb7, 0xd6, 0x62, 0x41, 0xa7, 0xe2, 0x52, 0x47, 0x16, 0x93, 0x63, 0x2c, 0xa6,
+	0xc7, 0xf8, 0xef, 0x45, 0x80, 0xf1, 0xa5, 0x2b, 0x54, 0x35, 0x7e, 0x02, 0xe5, 0xf0, 0x6a, 0xc1,
+	0x6f, 0x5f, 0x5a, 0xfc, 0x0d, 0x20, 0x6e, 0x7d, 0x34, 0xbe, 0x5a, 0x50, 0x93, 0x09, 0xc8, 0x51,
+	0x14, 0x33, 0xac, 0x9c, 0x74, 0x02, 0xdb, 0x0d, 0xc5, 0xbd, 0x04, 0x3e, 0x26, 0x75, 0xaa, 0xa4,
+	0x74, 0x8a, 0x9d, 0xac, 0xaa, 0x3a, 0x59, 0x1b, 0x4a, 0xae, 0x17, 0x0a, 0x97, 0xc0, 0x47, 0x72,
+	0x0a, 0x65, 0xd4, 0xc8, 0x00, 0xa8, 0x0e, 0x5e, 0x0f, 0x47, 0xe3, 0x51, 0xfb, 0x96, 0x71, 0x1b,
+	0x9a, 0xaf, 0x7a, 0xfb, 0x2f, 0x07, 0x27, 0x83, 0x17, 0x2f, 0x7b, 0xfb, 0xed, 0x02, 0x56, 0x0c,
+	0x0f, 0xc7, 0x27, 0xbb, 0xe6, 0xa0, 0x37, 0x1e, 0x98, 0xed, 0xa2, 0x71, 0x17, 0x8c, 0x83, 0xa3,
+	0xfe, 0x89, 0x39, 0x78, 0x35, 0x1c, 0x0d, 0x8f, 0x0e, 0x85, 0x60, 0xc9, 0xd8, 0x80, 0xf6, 0x5e,
+	0x6f, 0xb4, 0x77, 0xf2, 0x6c, 0x38, 0xd8, 0xef, 0x8b, 0xda, 0x32, 0xf9, 0xef, 0x02, 0x54, 0xc6,
+	0x97, 0xee, 0xd1, 0xc2, 0x20, 0x9a, 0x69, 0x5a, 0xc2, 0x34, 0x47, 0x8b, 0xef, 0xc6, 0x2a, 0xd1,
+	0x98, 0x2b, 0xca, 0x98, 0xc9, 0xd7, 0x62, 0x84, 0x35, 0x28, 0x8d, 0x06, 0xe3, 0xf6, 0x2d, 0xa3,
+	0x09, 0xb5, 0xd1, 0x60, 0x7c, 0x32, 0x3c, 0x1c, 0xb7, 0x0b, 0xc6, 0x1a, 0xac, 0x0e, 0x0f, 0x77,
+	0xcc, 0xc1, 0xc1, 0xe0, 0x90, 0x57, 0x15, 0x71, 0xb4, 0xfb, 0xc3, 0xd1, 0xf8, 0xa4, 0x77, 0x7c,
+	0x3c, 0x38, 0xec, 0xb7, 0x4b, 0x86, 0x01, 0x2d, 0x04, 0xc4, 0x23, 0x6b, 0x97, 0xd1, 0x5e, 0xfd,
+	0xc1, 0xfe, 0x60, 0x3c, 0x68, 0x57, 0xc8, 0xdf, 0x15, 0xd8, 0xfc, 0x4b, 0xe7, 0xdc, 0x82, 0xda,
+	0x84, 0x4f, 0xb6, 0x1a, 0x30, 0x62, 0x17, 0x30, 0x65, 0xb3, 0xf1, 0x43, 0xa8, 0x05, 0xcb, 0xc9,
+	0x84, 0x06, 0x32, 0xd8, 0x35, 0x22, 0x8b, 0x98, 0xb2, 0x05, 0x85, 0xce, 0x2c, 0xdb, 0x59, 0xfa,
+	0xfc, 0x5d, 0x4b, 0x17, 0x12, 0x2d, 0x64, 0x01, 0x4d, 0xa6, 0x41, 0xb0, 0xf0, 0xdc, 0x80, 0xbd,
+	0x7d, 0x31, 0x38, 0x9d, 0x46, 0xfe, 0x1c, 0x57, 0x18, 0x3f, 0x49, 0xc6, 0xd8, 0x55, 0x64, 0x8c,
+	0xae, 0x49, 0xa2, 0x00, 0xab, 0xdd, 0xf8, 0x96, 0xf4, 0x1b, 0x5f, 0xb2, 0x0d, 0xd5, 0xd1, 0xc4,
+	0xb7, 0x17, 0x6c, 0x7f, 0x0b, 0xd8, 0x93, 0x8c, 0x73, 0xbc, 0x84, 0x13, 0x14, 0x9c, 0x5b, 0x72,
+	0x22, 0x83, 0x73, 0x8b, 0x9c, 0x40, 0x73, 0x70, 0x61, 0x39, 0xd2, 0x50, 0xef, 0x0c, 0x8c, 0xae,
+	0x46, 0x4a, 0xca, 0x25, 0xa3, 0x01, 0x65, 0xcb, 0x9f, 0x45, 0xd7, 0x93, 0xf8, 0x4c, 0xbe, 0x84,
+	0x15, 0xde, 0x81, 0xb0, 0x83, 0x76, 0x51, 0x1b, 0x79, 0x8e, 0x3a, 0xac, 0x62, 0x62, 0x58, 0x9f,
+	0x42, 0x6b, 0xc7, 0x9b, 0xcf, 0x2d, 0x77, 0x2a, 0xb5, 0xcc, 0xba, 0x4c, 0x95, 0x7d, 0xf3, 0xbb,
+	0x17, 0xde, 0xf7, 0x1f, 0x4b, 0xb0, 0xf2, 0x15, 0x3b, 0xa3, 0xe4, 0x06, 0x29, 0x7d, 0x43, 0xaf,
+	0x47, 0x3b, 0x42, 0x1b, 0x4a, 0x3e, 0xbd, 0x10, 0x26, 0xc6, 0x47, 0x71, 0xb2, 0xe2, 0xbe, 0x2d,
+	0x0e, 0xa7, 0x13, 0xcb, 0x9d, 0x50, 0x7e, 0x0d, 0x59, 0x37, 0x45, 0x89, 0x6f, 0x97, 0x56, 0x80,
+	0x47, 0x19, 0x3c, 0x01, 0x8a, 0x03, 0xfb, 0xe0, 0x82, 0xba, 0xe1, 0x23, 0x93, 0x35, 0x98, 0x52,
+	0x00, 0xaf, 0x26, 0x70, 0x81, 0x05, 0x9d, 0xda, 0x66, 0x49, 0x06, 0x26, 0x2e, 0xc9, 0xfe, 0xb2,
+	0x25, 0xc8, 0x25, 0x70, 0x7c, 0x33, 0xc7, 0x3b, 0x65, 0xbb, 0x44, 0xc3, 0x64, 0xcf, 0x78, 0x36,
+	0xe3, 0x07, 0xc8, 0xa0, 0xd3, 0x88, 0xcf, 0x66, 0x6c, 0xc4, 0xfc, 0x38, 0x69, 0xca, 0x76, 0xbc,
+	0x4b, 0x17, 0x77, 0x7c, 0x8b, 0x30, 0xfe, 0x16, 0xa0, 0xd5, 0x19, 0x8f, 0xa1, 0x3a, 0xa5, 0x4e,
+	0x68, 0x05, 0xec, 0x0b, 0x40, 0x6b, 0xfb, 0x5e, 0xc4, 0x26, 0xec, 0xf7, 0xa8, 0xcf, 0x9a, 0x4d,
+	0x21, 0x46, 0x7e, 0x0c, 0x55, 0x5e, 0x63, 0xd4, 0xa1, 0x7c, 0x78, 0x74, 0x38, 0x68, 0xdf, 0xc2,
+	0x27, 0x5c, 0xaa, 0xed, 0x02, 0x3e, 0xe1, 0xfa, 0x6c, 0x17, 0xc9, 0x3f, 0x14, 0xa1, 0xa9, 0x68,
+	0x65, 0x6c, 0x69, 0x31, 0x67, 0x23, 0xa1, 0xb4, 0x1a, 0x79, 0x72, 0xef, 0xda, 0xed, 0xe8, 0xba,
+	0x58, 0x8f, 0x33, 0xe5, 0x8c, 0xd8, 0x5a, 0x89, 0x63, 0xeb, 0x1f, 0x44, 0xe4, 0x49, 0xc4, 0xd3,
+	0x5b, 0xc9, 0x78, 0x5a, 0x30, 0x56, 0xa0, 0x8e, 0x15, 0xfb, 0x83, 0xd1, 0xa8, 0x5d, 0x34, 0xee,
+	0xc0, 0x1a, 0x96, 0x76, 0xcc, 0xa3, 0xd1, 0x68, 0xd0, 0x3f, 0xe9, 0x3d, 0x3d, 0x7a, 0x35, 0x68,
+	0x97, 0x92, 0xd5, 0x4f, 0x07, 0xfb, 0x47, 0x5f, 0xb5, 0xcb, 0x99, 0x31, 0xb7, 0x42, 0xfe, 0xab,
+	0x04, 0x15, 0x36, 0xad, 0x59, 0xdb, 0x51, 0x72, 0xd6, 0xf9, 0xf0, 0x7f, 0x02, 0xb5, 0xc9, 0xd2,
+	0xf7, 0xa9, 0x18, 0x6c, 0x3a, 0x2c, 0x88, 0x56, 0xe3, 0xa7, 0x50, 0x5f, 0xe0, 0x82, 0xf1, 0x96,
+	0xfc, 0xed, 0x2f, 0x25, 0x19, 0x35, 0xe3, 0xfb, 0x24, 0x77, 0x3f, 0x66, 0x97, 0x2c, 0xf7, 0x14,
+	0xed, 0x72, 0x0d, 0x54, 0xe3, 0x35, 0xd0, 0x85, 0xfa, 0x5b, 0x9c, 0x28, 0x7c, 0x71, 0xa8, 0x31,
+	0x4b, 0x47, 0x65, 0xdc, 0x00, 0xd9, 0xf3, 0x31, 0x5f, 0x4e, 0xfc, 0x34, 0xa3, 0x56, 0xa5, 0x7c,
+	0xb0, 0x91, 0xe1, 0x83, 0x3f, 0x88, 0x7c, 0x10, 0xe2, 0xc8, 0xca, 0x9c, 0x2c, 0xf2, 0xba, 0xcf,
+	0xa1, 0x11, 0xd9, 0x09, 0xb7, 0x90, 0xe3, 0x97, 0xb8, 0x85, 0xc4, 0xd1, 0xbf, 0x60, 0xac, 0x42,
+	0x63, 0xe7, 0xe8, 0xe0, 0xb8, 0xb7, 0x33, 0x1e, 0xf4, 0xdb, 0x45, 0x9c, 0xca, 0x63, 0xf3, 0x68,
+	0xd7, 0xc4, 0xa9, 0x2c, 0x91, 0x23, 0xa8, 0xf2, 0x71, 0xe2, 0xae, 0xf3, 0x95, 0x39, 0x1c, 0x8f,
+	0x07, 0x87, 0x7c, 0x0b, 0xe2, 0xf8, 0x7e, 0xbb, 0x80, 0x85, 0xc1, 0xeb, 0xe3, 0xa1, 0xc9, 0xe0,
+	0x58, 0x78, 0x35, 0x64, 0x5c, 0x25, 0xdc, 0x9c, 0xf6, 0x8f, 0x76, 0x9e, 0x9f, 0x98, 0x83, 0xfd,
+	0x41, 0x6f, 0x34, 0xe8, 0xb7, 0xcb, 0xe4, 0x7f, 0x0a, 0x50, 0x61, 0x1a, 0x66, 0xed, 0xa5, 0xac,
+	0x21, 0xe1, 0xd1, 0xd9, 0x57, 0xbb, 0xdc, 0x7f, 0x4b, 0x99, 0x07, 0xd0, 0xb2, 0x7a, 0x4a, 0xf2,
+	0x85, 0x0f, 0xaf, 0x42, 0x83, 0x6d, 0x8a, 0xc7, 0x2f, 0x47, 0x7b, 0xed, 0x5b, 0x38, 0x4a, 0x5e,
+	0x3c, 0x3a, 0xe6, 0xe7, 0x03, 0x56, 0x1a, 0x1e, 0x8e, 0x06, 0xa6, 0xba, 0x85, 0x0a, 0x23, 0x95,
+	0x22, 0x79, 0xdc, 0x81, 0xcb, 0x58, 0x62, 0x2e, 0x8b, 0xa5, 0x0a, 0x0a, 0xb3, 0x92, 0x10, 0xae,
+	0x92, 0x7f, 0x2b, 0x00, 0x1c, 0x53, 0x7f, 0x6e, 0x07, 0x01, 0x8f, 0x14, 0xf5, 0x05, 0xf5, 0xe7,
+	0xe3, 0x84, 0x13, 0xc7, 0x12, 0x7c, 0xc4, 0x91, 0x90, 0x7a, 0x82, 0x58, 0xe1, 0x81, 0xf7, 0x7b,
+	0xd0, 0xf0, 0x2d, 0x77, 0x46, 0x4f, 0xa8, 0x3b, 0x15, 0xa7, 0x88, 0x3a, 0xab, 0x18, 0xb8, 0x53,
+	0xf2, 0x50, 0x0c, 0xb1, 0x0e, 0x65, 0x73, 0xd0, 0xeb, 0xb7, 0x6f, 0x19, 0x0d, 0xa8, 0xe0, 0x5c,
+	0x89, 0xd9, 0xc5, 0x4a, 0x5e, 0x2c, 0x92, 0x3f, 0x16, 0xa0, 0x25, 0x77, 0x97, 0x3d, 0x6a, 0xe1,
+	0xc7, 0xac, 0x0f, 0x01, 0x26, 0xce, 0x32, 0x08, 0xa9, 0x7f, 0x22, 0x5e, 0x86, 0xcb, 0x66, 0x43,
+	0xd4, 0x0c, 0xa7, 0xd8, 0xf5, 0x9c, 0xce, 0x4f, 0x79, 0x6b, 0x91, 0xb5, 0xd6, 0x79, 0xc5, 0x70,
+	0x7a, 0xdd, 0x06, 0xcb, 0x75, 0x3e, 0x0b, 0x4f, 0x42, 0xea, 0xcf, 0xd9, 0x9c, 0x94, 0x51, 0xe7,
+	0xb3, 0x70, 0x4c, 0xfd, 0x39, 0x59, 0x87, 0xb5, 0xde, 0x32, 0x3c, 0x1f, 0xb8, 0xd6, 0xa9, 0x23,
+	0x3f, 0xf9, 0x90, 0x0d, 0x30, 0xb0, 0xb2, 0x6f, 0x07, 0x6a, 0xed, 0x00, 0xd6, 0xb1, 0x16, 0xef,
+	0x21, 0x27, 0x56, 0x28, 0xab, 0x33, 0xb7, 0x35, 0xf6, 0x21, 0x25, 0x08, 0xde, 0x7a, 0xbe, 0x7c,
+	0x39, 0x89, 0xca, 0xa4, 0xcf, 0xc9, 0x5f, 0x06, 0xd4, 0xef, 0x4d, 0xa7, 0x37, 0x65, 0xd9, 0x8a,
+	0x59, 0x76, 0x69, 0x78, 0x0d, 0x0b, 0xf9, 0x19, 0xdc, 0x91, 0x92, 0x7d, 0xea, 0xd0, 0x6b, 0x15,
+	0x27, 0x47, 0xf0, 0xa1, 0x14, 0xc6, 0xcf, 0x43, 0x33, 0x7a, 0x2c, 0x3a, 0xbc, 0xa9, 0x9e, 0x4f,
+	0xa1, 0x13, 0xe9, 0x89, 0x5f, 0x44, 0x4d, 0xcf, 0x51, 0x15, 0x58, 0x06, 0xd1, 0xc7, 0x4b, 0xf6,
+	0x8c, 0x75, 0xbe, 0xe7, 0xc8, 0x2f, 0x05, 0xec, 0x99, 0xec, 0xc0, 0x07, 0x92, 0xc3, 0xa4, 0x17,
+	0xde, 0x1b, 0x9a, 0x20, 0xc9, 0x3a, 0x55, 0xa4, 0x48, 0x84, 0xc1, 0x10, 0x7a, 0xbd, 0xd9, 0x55,
+	0x49, 0xdd, 0xb4, 0x8c, 0xb3, 0xa0, 0x70, 0xde, 0x81, 0x75, 0xa9, 0x18, 0x7e, 0x16, 0x92, 0x8e,
+	0x22, 0xaa, 0x91, 0x40, 0xad, 0x16, 0x13, 0x81, 0xd5, 0xa9, 0x89, 0x48, 0x51, 0xbf, 0x86, 0x07,
+	0x91, 0x12, 0x68, 0xb7, 0x78, 0x91, 0x5e, 0x37, 0x70, 0x02, 0x65, 0x5c, 0xbc, 0x6c, 0xe0, 0xe2,
+	0xb8, 0xac, 0x00, 0x59, 0x1b, 0x99, 0xc2, 0xf7, 0x25, 0x33, 0xb7, 0x66, 0x26, 0x75, 0x52, 0xa1,
+	0x8c, 0xb7, 0x89, 0x54, 0x2c, 0x68, 0x28, 0xb1, 0xe0, 0x4b, 0x30, 0xd4, 0x75, 0x25, 0x8e, 0x91,
+	0x0f, 0xa1, 0x7a, 0xce, 0x16, 0x3b, 0xa3, 0x16, 0xb7, 0xf7, 0x7a, 0x18, 0x30, 0x85, 0x04, 0xe9,
+	0xc1, 0xba, 0xb6, 0x08, 0x6f, 0x40, 0xf1, 0x1a, 0x36, 0xf4, 0x15, 0xfb, 0xfe, 0x1c, 0xfc, 0x76,
+	0xe5, 0x0d, 0x75, 0xe5, 0x25, 0x03, 0x2b, 0x90, 0x5e, 0x3c, 0xf3, 0xcc, 0x9b, 0x6e, 0xa0, 0xdc,
+	0x57, 0x31, 0x05, 0x73, 0xb3, 0x9b, 0xe9, 0x86, 0x73, 0x23, 0xbf, 0x3d, 0xf3, 0x02, 0xe9, 0xc3,
+	0xdd, 0xe4, 0x82, 0xbf, 0x81, 0x7a, 0xfb, 0xf0, 0x40, 0xb2, 0x24, 0x23, 0xc1, 0x0d, 0xd8, 0x76,
+	0xe3, 0x25, 0xac, 0x84, 0x81, 0x1b, 0x10, 0xed, 0x41, 0x37, 0x2b, 0x16, 0xdc, 0xdc, 0xbf, 0xa2,
+	0x80, 0x70, 0x03, 0x0a, 0x1a, 0x53, 0xdc, 0x74, 0x0a, 0xe3, 0x15, 0x5b, 0xca, 0x5d, 0xb1, 0xc2,
+	0x8d, 0xe3, 0x78, 0xf2, 0x9d, 0xb9, 0x8a, 0x60, 0x8e, 0x03, 0xd8, 0xcd, 0x98, 0x31, 0x72, 0x47,
+	0xcc, 0xac, 0x20, 0x9d, 0x50, 0x0d, 0x76, 0x37, 0x30, 0xf0, 0x41, 0x1c, 0xab, 0x52, 0x51, 0xf0,
+	0x06, 0x74, 0x87, 0xb0, 0x99, 0x1f, 0xfa, 0xde, 0x9f, 0xef, 0xe1, 0x33, 0x68, 0x2a, 0x9f, 0xe9,
+	0x94, 0xd7, 0xa9, 0x1a, 0x94, 0x7a, 0xaf, 0x76, 0xdb, 0x05, 0x7c, 0x38, 0x18, 0x1e, 0xb6, 0x8b,
+	0xec, 0xa1, 0xf7, 0xba, 0x5d, 0xc2, 0x87, 0xd1, 0xcb, 0x83, 0x76, 0x19, 0xcf, 0x46, 0x3b, 0x47,
+	0x2f, 0x0f, 0xc7, 0xed, 0xca, 0xc3, 0x9f, 0xc1, 0x8a, 0xfa, 0x69, 0x08, 0x4f, 0xc5, 0x3b, 0x47,
+	0xa3, 0xa1, 0xa4, 0xea, 0x1f, 0xe1, 0x8b, 0x59, 0x15, 0x8a, 0xfb, 0xdb, 0xed, 0xe2, 0xf6, 0x9f,
+	0x7e, 0x03, 0x95, 0x03, 0xcc, 0x53, 0x33, 0x3e, 0x81, 0x32, 0xe6, 0x3c, 0x18, 0x75, 0x54, 0x11,
+	0x33, 0xd1, 0xba, 0x2c, 0x67, 0x46, 0xe6, 0x41, 0x90, 0xf5, 0xbf, 0xff, 0xd3, 0xff, 0xfe, 0x6b,
+	0x71, 0x95, 0xd4, 0x1f, 0x5f, 0x7c, 0xfc, 0x18, 0x5f, 0xf5, 0x9f, 0x14, 0x1e, 0x1a, 0xcf, 0x78,
+	0xe2, 0xd1, 0x57, 0x76, 0x28, 0x0f, 0xf8, 0x35, 0x01, 0x4a, 0xa0, 0x3f, 0x64, 0xe8, 0x7b, 0xc4,
+	0x90, 0xe8, 0x18, 0x82, 0x3c, 0x3f, 0x87, 0xd2, 0x9e, 0x15, 0xc4, 0x60, 0xa6, 0x04, 0x26, 0x75,
+	0x11, 0x83, 0x01, 0x57, 0x48, 0x0d, 0x81, 0xe7, 0x16, 0xeb, 0xf5, 0x0b, 0x68, 0x8c, 0x68, 0xc8,
+	0xb2, 0x9d, 0xa8, 0xc1, 0xbc, 0x3c, 0xce, 0x7c, 0xea, 0x46, 0xfa, 0x93, 0x0e, 0x83, 0x1a, 0x64,
+	0x15, 0xa1, 0x81, 0x04, 0x20, 0xc1, 0x73, 0xb8, 0x1d, 0x11, 0x1c, 0xd8, 0x8e, 0x63, 0x07, 0xd7,
+	0xd0, 0x3c, 0x60, 0x34, 0x1d, 0xb2, 0xae, 0xd1, 0x70, 0x18, 0x92, 0x7d, 0x0e, 0x75, 0x5e, 0xd5,
+	0x0b, 0xaf, 0x61, 0xb9, 0xc7, 0x58, 0xd6, 0xc8, 0x0a, 0xb2, 0x50, 0x21, 0x8f, 0xf0, 0x21, 0xb4,
+	0x24, 0xfc, 0x5b, 0x55, 0xd1, 0xac, 0x48, 0x35, 0x14, 0x52, 0xfd, 0x16, 0x2f, 0xf3, 0x43, 0xb4,
+	0xac, 0xb0, 0xcd, 0x5a, 0xc4, 0x24, 0x73, 0xd9, 0x14, 0xb2, 0xfb, 0x8c, 0xec, 0x2e, 0x59, 0x13,
+	0xe3, 0x8a, 0x71, 0xc8, 0xf5, 0x0a, 0xd6, 0x35, 0x2e, 0xa1, 0xdb, 0xb5, 0x8c, 0x84, 0x31, 0xde,
+	0x27, 0xf7, 0x52, 0x8c, 0xb1, 0x8e, 0x1f, 0x41, 0x09, 0xb3, 0xd8, 0x74, 0x37, 0x91, 0x69, 0x3d,
+	0xfa, 0x6c, 0x87, 0xa1, 0x83, 0x88, 0xcf, 0xa0, 0x31, 0x1e, 0xef, 0x8b, 0xfe, 0x73, 0x70, 0xda,
+	0x54, 0x87, 0xa1, 0x13, 0xf7, 0xf7, 0x0b, 0xa8, 0x1d, 0x53, 0x3f, 0xc0, 0x6c, 0x9d, 0x0c, 0xef,
+	0xba, 0xcb, 0x70, 0x6d, 0xd2, 0x44, 0xdc, 0x82, 0xcb, 0x21, 0xaa, 0x07, 0xc0, 0x42, 0x04, 0x4b,
+	0xde, 0xbb, 0x66, 0x42, 0x3e, 0x60, 0xf8, 0x75, 0xd2, 0x42, 0xfc, 0x2c, 0x42, 0x70, 0xb5, 0x9b,
+	0x3c, 0x2c, 0x70, 0x0e, 0xbd, 0x73, 0x06, 0xee, 0x32, 0xf0, 0x06, 0xb9, 0x8d, 0x60, 0x3f, 0x96,
+	0x45, 0xf4, 0xaf, 0xa1, 0xbe, 0x4b, 0xc3, 0x04, 0x94, 0xbd, 0xcf, 0x47, 0xf9, 0x84, 0xba, 0x4b,
+	0xcd, 0x68, 0xdc, 0x75, 0x0f, 0x1a, 0xcf, 0x29, 0x5d, 0xf4, 0x1c, 0xfb, 0x22, 0x1f, 0xad, 0x99,
+	0xec, 0x8d, 0x14, 0x7f, 0x52, 0x78, 0xb8, 0x55, 0xf8, 0xa8, 0x60, 0x3c, 0x82, 0x32, 0x66, 0xcb,
+	0x65, 0xa9, 0xad, 0x05, 0x02, 0x4c, 0xa4, 0x13, 0x2b, 0x0a, 0xe5, 0x71, 0xc6, 0x45, 0x5a, 0xe6,
+	0xbb, 0xae, 0x28, 0x47, 0x87, 0x21, 0xd9, 0x36, 0x54, 0x5f, 0xba, 0x4e, 0x4e, 0xf7, 0x77, 0x18,
+	0xf8, 0x36, 0x01, 0x04, 0x2f, 0x5d, 0xa9, 0x40, 0x8f, 0x27, 0x1d, 0x1e, 0x58, 0xee, 0x95, 0x61,
+	0x08, 0x54, 0xf0, 0xed, 0x2b, 0xd1, 0x11, 0x18, 0x1e, 0x56, 0xe0, 0xa5, 0x2b, 0x2b, 0x0c, 0x2d,
+	0x7e, 0xe5, 0x4d, 0xf9, 0xd2, 0x55, 0x09, 0x3e, 0x87, 0x06, 0x0a, 0xa3, 0x1e, 0x41, 0xd2, 0xee,
+	0x32, 0x31, 0x51, 0xb7, 0xbb, 0x23, 0xc5, 0x85, 0xc7, 0x3c, 0xf3, 0xfc, 0x09, 0xcd, 0x1f, 0xbb,
+	0xe6, 0x31, 0x67, 0xb1, 0x2c, 0x0f, 0xc5, 0xab, 0xbc, 0x30, 0x3e, 0xa7, 0x2e, 0xe6, 0x0c, 0xe9,
+	0xb7, 0x3f, 0x79, 0x0b, 0x7f, 0xa9, 0x62, 0x78, 0x3c, 0x5a, 0xd3, 0x78, 0x70, 0x44, 0x7c, 0x53,
+	0x48, 0x18, 0x62, 0x93, 0xd1, 0x74, 0xc9, 0x9d, 0x14, 0xcd, 0xbe, 0x58, 0x45, 0xdb, 0xec, 0x6e,
+	0x90, 0x86, 0xf4, 0x5b, 0xe7, 0x71, 0xca, 0xc4, 0x10, 0xf3, 0x31, 0x54, 0x76, 0x1c, 0x6a, 0xf9,
+	0xca, 0x3e, 0x14, 0x63, 0x36, 0x18, 0xa6, 0x45, 0x1a, 0x88, 0x99, 0xa0, 0x18, 0x87, 0x94, 0x76,
+	0x69, 0x98, 0x30, 0x78, 0x34, 0x70, 0x3d, 0xa6, 0xcc, 0xf8, 0x20, 0x7f, 0x05, 0xb5, 0x5d, 0x1a,
+	0xe6, 0xcd, 0x33, 0xa6, 0x5e, 0xe9, 0xa1, 0x61, 0xc6, 0x85, 0x11, 0xfa, 0x25, 0xac, 0xee, 0xd2,
+	0x30, 0xde, 0xbe, 0x12, 0x63, 0x63, 0x58, 0xcd, 0xc2, 0x33, 0x55, 0x1a, 0x19, 0x0e, 0xe0, 0xb6,
+	0x60, 0x88, 0xbe, 0x0b, 0x45, 0x1c, 0xe9, 0xcf, 0x6e, 0xfa, 0x6a, 0x99, 0xe9, 0x40, 0xa4, 0xfb,
+	0x1d, 0xac, 0x8b, 0xb1, 0x68, 0x94, 0xfa, 0xb8, 0x8c, 0x14, 0x6f, 0xa0, 0x87, 0xeb, 0x59, 0x9a,
+	0x82, 0x4f, 0x61, 0xe9, 0x5a, 0x5f, 0xd2, 0x8c, 0x1b, 0x70, 0xe3, 0xfe, 0x12, 0x2a, 0x23, 0x1a,
+	0x1e, 0xbe, 0xce, 0x44, 0xb1, 0xb0, 0xab, 0xcd, 0x63, 0x80, 0xb2, 0x88, 0x7b, 0x02, 0xb5, 0x91,
+	0x98, 0x94, 0xc8, 0x94, 0x7c, 0x32, 0xa3, 0xec, 0x45, 0x7d, 0x56, 0x82, 0x78, 0x56, 0xfe, 0x8a,
+	0x7d, 0x20, 0x50, 0xbe, 0x49, 0x1a, 0x2c, 0xd1, 0x30, 0xf3, 0x3b, 0x65, 0x97, 0x45, 0xa6, 0xf8,
+	0x2b, 0xa3, 0xbe, 0xad, 0x4e, 0x34, 0x08, 0xdf, 0x0a, 0xdb, 0x23, 0x1a, 0x0e, 0xcf, 0xd4, 0x4c,
+	0xf8, 0xf4, 0x3c, 0xa5, 0x58, 0xbf, 0xcf, 0x58, 0x3f, 0x20, 0x1b, 0x42, 0x55, 0x8d, 0x80, 0xdb,
+	0xa9, 0xba, 0xcf, 0x72, 0x0e, 0xf2, 0x76, 0x35, 0x6d, 0x89, 0xf0, 0xf4, 0x04, 0x81, 0xdb, 0xa5,
+	0xe1, 0xd0, 0x0d, 0xdf, 0x09, 0x37, 0x63, 0xa2, 0x3c, 0xbe, 0xe0, 0x9e, 0xc2, 0xd2, 0x61, 0x63,
+	0x24, 0xff, 0x6c, 0x1e, 0xa5, 0xc8, 0xa6, 0x36, 0x15, 0xd6, 0x84, 0xe8, 0xbf, 0x80, 0xea, 0x88,
+	0xf7, 0xaa, 0x75, 0x96, 0xb7, 0xa2, 0x83, 0xa8, 0xdb, 0xcf, 0xa1, 0x3e, 0x92, 0xdd, 0x26, 0x7a,
+	0xcb, 0x8b, 0xca, 0x81, 0xd2, 0xef, 0x2e, 0xac, 0x0c, 0xdd, 0x89, 0x4f, 0x31, 0x69, 0x23, 0xdd,
+	0xbb, 0x3e, 0xf0, 0xef, 0x31, 0x92, 0x3b, 0xa4, 0x8d, 0x24, 0xb6, 0x82, 0x12, 0x44, 0x7d, 0x7a,
+	0x13, 0xa2, 0x29, 0xd5, 0x89, 0x8e, 0xa0, 0x15, 0x69, 0x94, 0x3d, 0xac, 0xa4, 0x51, 0x35, 0x07,
+	0xb3, 0x35, 0xac, 0x20, 0xec, 0x53, 0xb5, 0xf2, 0xfd, 0x08, 0xa7, 0x34, 0x49, 0xf8, 0x0b, 0x16,
+	0xde, 0xf6, 0xd3, 0x87, 0x1e, 0xac, 0x4a, 0x45, 0x36, 0x19, 0xae, 0x9f, 0x41, 0x53, 0xa0, 0x58,
+	0x6e, 0xd6, 0x8a, 0x04, 0x60, 0x29, 0x19, 0x54, 0xb5, 0x9d, 0x68, 0x16, 0xa3, 0x90, 0xe7, 0xcf,
+	0xd9, 0x3a, 0xce, 0xdd, 0x37, 0x92, 0x4b, 0x78, 0x3f, 0x3a, 0x73, 0x35, 0x47, 0xb9, 0xdd, 0xe7,
+	0xec, 0x81, 0x81, 0xde, 0xf3, 0x6f, 0x00, 0xb0, 0x78, 0xfd, 0xaa, 0xd2, 0x36, 0x70, 0x27, 0x12,
+	0x57, 0x37, 0x70, 0x96, 0xaa, 0x91, 0xa7, 0x40, 0x7a, 0x03, 0x47, 0x71, 0x71, 0x80, 0x60, 0xf2,
+	0x6e, 0x40, 0xfd, 0x7c, 0x7c, 0xaa, 0x7f, 0x2e, 0xaf, 0x10, 0xf4, 0x16, 0x0b, 0xea, 0x4e, 0xdf,
+	0x9d, 0x80, 0xcb, 0x0b, 0x1b, 0x22, 0xe0, 0xd8, 0x5b, 0xec, 0xd3, 0xb3, 0xfc, 0x2d, 0x51, 0xb3,
+	0xa1, 0x13, 0x03, 0x90, 0x62, 0x07, 0x56, 0x04, 0x85, 0x69, 0xcf, 0xce, 0xf3, 0x39, 0xb4, 0x25,
+	0xe2, 0x28, 0x08, 0x6e, 0xc8, 0x1a, 0x92, 0xe0, 0x3b, 0x9d, 0x3e, 0x0a, 0x7d, 0x2a, 0x34, 0x57,
+	0x70, 0x38, 0x40, 0xb1, 0x83, 0x38, 0x3c, 0xbc, 0xb3, 0x1d, 0xfa, 0xd1, 0x29, 0xe2, 0x39, 0xb4,
+	0x62, 0x82, 0x0c, 0x77, 0xd2, 0xd5, 0xd0, 0x56, 0x93, 0xa3, 0xe1, 0xe2, 0xd5, 0xc4, 0x32, 0xcb,
+	0x33, 0xf6, 0xfa, 0xe4, 0x6a, 0xc2, 0x4a, 0x44, 0xed, 0xc1, 0x8a, 0x40, 0xf1, 0x84, 0xf0, 0x55,
+	0x89, 0x60, 0xc5, 0x6f, 0x5b, 0x4f, 0x7b, 0x56, 0xc0, 0xe4, 0xf8, 0x89, 0x6c, 0x55, 0x65, 0x0a,
+	0x8c, 0xb6, 0x46, 0x35, 0xa2, 0xe1, 0x35, 0x47, 0x8f, 0x18, 0x26, 0xb6, 0x58, 0xac, 0xc0, 0x79,
+	0x49, 0xe8, 0x93, 0xf3, 0x4e, 0x74, 0xce, 0xa5, 0xc5, 0xe2, 0x42, 0xf1, 0xf7, 0x58, 0x5c, 0xe7,
+	0x91, 0xb8, 0x82, 0x17, 0x63, 0xc8, 0xb9, 0x27, 0x48, 0xe1, 0x55, 0xdd, 0x19, 0x5e, 0xe4, 0x2b,
+	0x65, 0xc4, 0xb5, 0x14, 0x96, 0x8b, 0xc6, 0x21, 0x89, 0x4d, 0x61, 0x7c, 0xb4, 0xc8, 0x0f, 0x49,
+	0x72, 0x0e, 0xfb, 0x98, 0x55, 0x97, 0x3f, 0x87, 0x31, 0x81, 0xb6, 0x18, 0x02, 0x05, 0xc2, 0x17,
+	0xe5, 0xea, 0x48, 0x9b, 0xbf, 0x2c, 0x15, 0x92, 0x6f, 0xe3, 0xfa, 0xbc, 0xf5, 0x71, 0xef, 0x72,
+	0xde, 0x57, 0x91, 0xa9, 0x02, 0x11, 0xaf, 0x08, 0xbb, 0x34, 0x54, 0x92, 0xea, 0xf5, 0x53, 0x40,
+	0xdc, 0x90, 0xf2, 0xa2, 0xb8, 0x89, 0x1f, 0x60, 0x95, 0x5c, 0x78, 0xfe, 0x33, 0x3e, 0x23, 0xc1,
+	0xa0, 0xa8, 0xa4, 0x9d, 0x83, 0xc2, 0x04, 0x8e, 0xd3, 0xad, 0xc6, 0xc0, 0xde, 0x74, 0x6a, 0x6c,
+	0xe8, 0x5c, 0x3c, 0xdb, 0x3e, 0xcf, 0x56, 0xa1, 0x0a, 0xe5, 0xc7, 0x35, 0x25, 0x9d, 0xde, 0xc4,
+	0xcb, 0x66, 0x63, 0x5d, 0x27, 0x64, 0x89, 0x80, 0xa9, 0x31, 0x6b, 0xe7, 0xec, 0x50, 0x67, 0xe0,
+	0xfe, 0x5b, 0xc3, 0xbc, 0x74, 0x7c, 0xd5, 0x60, 0x3e, 0x2b, 0xf3, 0xe3, 0x93, 0x4b, 0x59, 0x73,
+	0xa6, 0xbf, 0x09, 0x3c, 0x77, 0x97, 0x1f, 0x8b, 0x9f, 0x70, 0x7c, 0x74, 0x9c, 0x8e, 0xb2, 0xe5,
+	0xf3, 0x1c, 0x11, 0xb1, 0xa3, 0xe8, 0x7d, 0x05, 0xc5, 0xfb, 0xd4, 0x49, 0xf4, 0x7d, 0x0d, 0xb4,
+	0x4f, 0x1d, 0x71, 0x29, 0x84, 0xd2, 0x3d, 0xdf, 0x17, 0xdb, 0x4a, 0xa2, 0x73, 0x7d, 0xfd, 0x6a,
+	0xa6, 0x45, 0x96, 0x08, 0x27, 0x66, 0x4a, 0xa4, 0xe6, 0xe3, 0x01, 0xe8, 0xe9, 0x15, 0x9f, 0xf5,
+	0x38, 0x5b, 0x3f, 0x75, 0x4e, 0x49, 0xd1, 0x45, 0x50, 0x71, 0xf5, 0xb5, 0x4b, 0x43, 0x35, 0x35,
+	0x3e, 0x72, 0x48, 0x25, 0xc3, 0x98, 0xb5, 0xe8, 0x31, 0x7a, 0xa6, 0xa1, 0x90, 0xea, 0x18, 0xd6,
+	0x94, 0x1a, 0xe1, 0x93, 0x49, 0x92, 0xbc, 0x97, 0xd7, 0x8b, 0x24, 0x12, 0x19, 0x07, 0xd0, 0x88,
+	0x94, 0xe3, 0xe3, 0x8c, 0xf3, 0xd6, 0xbb, 0x89, 0xb2, 0x7e, 0x26, 0x88, 0xb4, 0x13, 0x77, 0x95,
+	0xbc, 0x80, 0x8e, 0x9d, 0xa4, 0xc9, 0x39, 0x54, 0x5c, 0x48, 0x00, 0xd7, 0x43, 0x5c, 0xe7, 0x8a,
+	0xdd, 0x30, 0x9f, 0x43, 0x5b, 0xfb, 0x17, 0x0a, 0x86, 0x9f, 0x31, 0x05, 0x0d, 0x4f, 0xda, 0x55,
+	0x6d, 0xc3, 0x97, 0xc3, 0x5a, 0x22, 0xa7, 0x9b, 0x06, 0x59, 0x84, 0x1c, 0x2d, 0x2c, 0xae, 0xe4,
+	0x49, 0xab, 0x16, 0x57, 0xaa, 0xf3, 0x2c, 0x1e, 0x24, 0x91, 0x3c, 0xc8, 0xdd, 0x56, 0xa0, 0x7d,
+	0xdf, 0x5b, 0x64, 0xdd, 0x1b, 0x24, 0xae, 0x63, 0x35, 0x79, 0x7e, 0x7e, 0xa9, 0xaa, 0x43, 0x54,
+	0x52, 0xaf, 0xbb, 0x6b, 0xc9, 0xa4, 0xe5, 0x20, 0xf9, 0xce, 0x22, 0x07, 0x77, 0x00, 0xed, 0x38,
+	0x95, 0x58, 0x8d, 0x70, 0x71, 0x6d, 0x5e, 0x84, 0x3b, 0x4b, 0xe0, 0x84, 0xa3, 0xc7, 0x40, 0x36,
+	0xb0, 0x7c, 0x32, 0xcd, 0xd1, 0xcf, 0x34, 0x14, 0x7f, 0x8b, 0x69, 0x3e, 0xb3, 0xdd, 0xe9, 0xd3,
+	0x2b, 0x06, 0x56, 0x78, 0xf8, 0x10, 0xf5, 0xdd, 0x54, 0xbf, 0x2f, 0x8a, 0x61, 0x48, 0xf4, 0x02,
+	0x87, 0x18, 0xd5, 0xf0, 0x38, 0x79, 0x3d, 0x5b, 0x62, 0x98, 0x3a, 0x96, 0x47, 0xb8, 0xd2, 0xf8,
+	0xd2, 0x35, 0x64, 0x62, 0xa5, 0x7c, 0xdd, 0xbe, 0x1d, 0x95, 0xf9, 0x77, 0x8f, 0xc4, 0x2d, 0xef,
+	0xa5, 0xcb, 0xa3, 0x6b, 0x19, 0x73, 0x04, 0xf9, 0xa4, 0x29, 0xe9, 0x88, 0xdd, 0x76, 0x5c, 0x21,
+	0xe0, 0xda, 0x05, 0x24, 0xbd, 0xb0, 0x1c, 0xee, 0x3c, 0x35, 0x14, 0x1a, 0xed, 0xf5, 0xde, 0x85,
+	0x42, 0x0b, 0x96, 0x94, 0xe3, 0xc4, 0xc1, 0x93, 0xa7, 0x4f, 0xee, 0x7b, 0xd6, 0xd4, 0xe0, 0x3f,
+	0x28, 0x63, 0xe5, 0xae, 0xf2, 0xac, 0x1f, 0x34, 0x82, 0x48, 0x5e, 0xa8, 0x21, 0x12, 0x15, 0xf9,
+	0x2d, 0xa4, 0x9e, 0xb5, 0x78, 0xed, 0x56, 0x31, 0xe1, 0xa2, 0xdc, 0x18, 0x15, 0x96, 0x0c, 0xc7,
+	0x4f, 0x7a, 0x6a, 0xfa, 0x5d, 0xb7, 0x11, 0xa5, 0x6a, 0xe9, 0xb7, 0x28, 0x2c, 0xc9, 0x4a, 0xde,
+	0xde, 0x3e, 0x85, 0xda, 0xf1, 0xf2, 0xd4, 0xb1, 0x83, 0x73, 0x43, 0xf9, 0x89, 0xae, 0xf8, 0x95,
+	0xf3, 0x75, 0x67, 0xf0, 0x05, 0x47, 0xa1, 0x0e, 0x26, 0x34, 0xa2, 0x5f, 0x2f, 0xf3, 0x1d, 0x39,
+	0xf9, 0x63, 0xe6, 0x6e, 0x9a, 0x3b, 0xf1, 0xcd, 0x45, 0x02, 0xa4, 0x5e, 0x47, 0xd0, 0xd2, 0x7e,
+	0x2d, 0xac, 0x9c, 0x40, 0xee, 0xa4, 0x7e, 0x4a, 0x9c, 0xfe, 0x6e, 0xb4, 0xd0, 0xb0, 0xfc, 0x3d,
+	0x03, 0xe2, 0xc4, 0x00, 0x83, 0x71, 0xa4, 0x12, 0x70, 0xba, 0x77, 0x93, 0xd5, 0xc2, 0x09, 0x6e,
+	0x19, 0x5f, 0x42, 0x53, 0xc9, 0x0a, 0x30, 0x22, 0x41, 0x3d, 0x57, 0xa7, 0x7b, 0x2f, 0x55, 0x1f,
+	0x31, 0xec, 0xc0, 0x8a, 0x9a, 0x14, 0x60, 0x44, 0xa2, 0x89, 0xc4, 0x9e, 0x6e, 0x27, 0xdd, 0x10,
+	0x91, 0x7c, 0x06, 0x35, 0xf1, 0xed, 0x3f, 0x56, 0x41, 0xcf, 0xe8, 0xe9, 0xde, 0x4b, 0xd5, 0x27,
+	0xd1, 0x78, 0x2e, 0xd1, 0xd0, 0x71, 0xba, 0x49, 0xf7, 0x5e, 0xaa, 0x3e, 0x42, 0x7f, 0x01, 0x75,
+	0xf9, 0xc1, 0xd6, 0xd0, 0xc4, 0x94, 0x64, 0x93, 0x6e, 0x27, 0xdd, 0x10, 0x11, 0x0c, 0x00, 0xe2,
+	0xe4, 0x00, 0xe3, 0x03, 0x55, 0x52, 0x4b, 0x4c, 0xe9, 0x76, 0xb3, 0x9a, 0x22, 0x9a, 0xdf, 0x83,
+	0x91, 0xce, 0x0e, 0x30, 0x7e, 0xa0, 0x62, 0x32, 0x73, 0x88, 0xba, 0xe4, 0x3a, 0x91, 0x88, 0xfe,
+	0x10, 0x56, 0xb5, 0x74, 0x01, 0xe3, 0xbe, 0x66, 0x92, 0x44, 0x32, 0x51, 0xf7, 0xc3, 0x9c, 0xd6,
+	0x88, 0xef, 0x05, 0xb4, 0xf4, 0xac, 0x01, 0x43, 0x83, 0xa4, 0x32, 0x8b, 0xba, 0x0f, 0xf2, 0x9a,
+	0xd5, 0x79, 0x14, 0xe9, 0x03, 0xf1, 0x3c, 0xea, 0x09, 0x46, 0xdd, 0x7b, 0xa9, 0xfa, 0x24, 0x5a,
+	0xf3, 0x02, 0x3d, 0xe9, 0xa8, 0x7b, 0x2f, 0x55, 0xaf, 0x7a, 0x81, 0x4c, 0x08, 0x30, 0x34, 0xb1,
+	0x4c, 0x2f, 0x48, 0xe6, 0x0e, 0x70, 0x2f, 0x88, 0xbf, 0xce, 0xc7, 0x5e, 0x90, 0x4a, 0x4f, 0xea,
+	0x76, 0xb3, 0x9a, 0x22, 0x9a, 0xaf, 0x61, 0x3d, 0xe3, 0xf3, 0xbc, 0x41, 0x34, 0xcd, 0x33, 0x33,
+	0x98, 0xba, 0x3f, 0xbc, 0x56, 0x26, 0xea, 0x61, 0x02, 0x1b, 0x59, 0x5f, 0xec, 0x0d, 0x0d, 0x9e,
+	0x93, 0xca, 0xd4, 0xfd, 0xd1, 0xf5, 0x42, 0xb2, 0x93, 0xd3, 0x2a, 0xfb, 0x97, 0x2e, 0x9f, 0xfc,
+	0xff, 0x00, 0xbe, 0x57, 0x82, 0xf1, 0x03, 0x46, 0x00, 0x00,
 }
@@ -19,6 +19,7 @@ import (
 	"log"
 	"net/url"
 	"os"
+	"strconv"
 
 	"github.com/coreos/etcd/embed"
 	"github.com/deejross/mydis/mydis"
@@ -32,7 +33,8 @@ var (
 
 func main() {
 	cfg := loadConfig()
-	server := mydis.NewServer(cfg)
+	opts := append(getStorageOptions(), getEvictionOptions()...)
+	server := mydis.NewServer(cfg, opts...)
 	err := server.Start(getAddressHTTP1(), getAddressHTTP2())
 	if err != nil {
 		fmt.Println(err)
@@ -56,6 +58,32 @@ func getAddressHTTP2() string {
 	return defaultAddressHTTP2
 }
 
+func getStorageOptions() []mydis.Option {
+	if os.Getenv("MYDIS_STORAGE") == "memory" {
+		return []mydis.Option{mydis.WithStorage(mydis.NewMemoryStorage())}
+	}
+	return nil
+}
+
+func getEvictionOptions() []mydis.Option {
+	name := os.Getenv("MYDIS_EVICTION")
+	if name == "" {
+		return nil
+	}
+	policy, err := mydis.ParseEvictionPolicy(name)
+	if err != nil {
+		log.Fatalln(err, name)
+	}
+
+	maxBytes := int64(0)
+	if s := os.Getenv("MYDIS_EVICTION_MAX_BYTES"); s != "" {
+		if maxBytes, err = strconv.ParseInt(s, 10, 64); err != nil {
+			log.Fatalln("Invalid MYDIS_EVICTION_MAX_BYTES:", s)
+		}
+	}
+	return []mydis.Option{mydis.WithEviction(policy, maxBytes)}
+}
+
 func loadConfig() *embed.Config {
 	args := os.Args
 	if len(args) > 1 {
@@ -31,4 +31,62 @@ var (
 	ErrListIndexOutOfRange = errors.New("Index out of range")
 	// ErrHashFieldNotFound signals that the hash does not have the given field.
 	ErrHashFieldNotFound = errors.New("Hash field does not exist")
+	// ErrInvalidRetention signals that the given retention period is invalid.
+	ErrInvalidRetention = errors.New("Invalid retention period")
+	// ErrInvalidDownsampleRule signals that a downsampling rule or bucket size is invalid.
+	ErrInvalidDownsampleRule = errors.New("Invalid downsampling rule")
+	// ErrInvalidJSON signals that the given value is not valid JSON.
+	ErrInvalidJSON = errors.New("Invalid JSON")
+	// ErrInvalidJSONPath signals that the given JSON path could not be parsed.
+	ErrInvalidJSONPath = errors.New("Invalid JSON path")
+	// ErrJSONPathNotFound signals that the given JSON path does not exist in the document.
+	ErrJSONPathNotFound = errors.New("JSON path not found")
+	// ErrInvalidVectorIndex signals that the given vector index settings or query options are invalid.
+	ErrInvalidVectorIndex = errors.New("Invalid vector index settings")
+	// ErrVectorDimensionMismatch signals that a vector has a different number of dimensions than its index.
+	ErrVectorDimensionMismatch = errors.New("Vector dimensions do not match index")
+	// ErrVectorNotFound signals that the index does not have a vector with the given ID.
+	ErrVectorNotFound = errors.New("Vector not found")
+	// ErrVectorIndexExists signals that a vector index can't be created because one already exists with the given key.
+	ErrVectorIndexExists = errors.New("Vector index already exists")
+	// ErrInvalidSearchIndex signals that the given search index settings are invalid.
+	ErrInvalidSearchIndex = errors.New("Invalid search index settings")
+	// ErrInvalidSearchQuery signals that the search query is empty or its paging options are invalid.
+	ErrInvalidSearchQuery = errors.New("Invalid search query")
+	// ErrInvalidFieldIndex signals that the given field index settings are invalid.
+	ErrInvalidFieldIndex = errors.New("Invalid field index settings")
+	// ErrFieldIndexNotFound signals that there is no index on the given prefix and field.
+	ErrFieldIndexNotFound = errors.New("Field index does not exist")
+	// ErrInvalidTxn signals that a transaction contains an unknown comparison or operation.
+	ErrInvalidTxn = errors.New("Invalid transaction")
+	// ErrLockNotOwned signals that a lock can't be released because it's held by another lock owner.
+	ErrLockNotOwned = errors.New("Lock is held by another owner")
+	// ErrInvalidExpiration signals that the given expiration is not in the future.
+	ErrInvalidExpiration = errors.New("Invalid expiration")
+	// ErrLeaseNotFound signals that there is no lease with the given name, or that it has expired.
+	ErrLeaseNotFound = errors.New("Lease does not exist")
+	// ErrLeaseExists signals that a lease with the given name already exists.
+	ErrLeaseExists = errors.New("Lease already exists")
+	// ErrWatchOverflow signals that a subscription was canceled because its buffer was full when an event arrived.
+	ErrWatchOverflow = errors.New("Watch subscription buffer overflowed")
+	// ErrSlowConsumer signals that a watch or subscribe stream was disconnected because it fell too far behind.
+	ErrSlowConsumer = errors.New("Stream disconnected for falling behind")
+	// ErrInvalidChannel signals that the given pub/sub channel name is invalid.
+	ErrInvalidChannel = errors.New("Invalid channel name")
+	// ErrScriptNotFound signals that no script has been loaded with the given SHA1 hash.
+	ErrScriptNotFound = errors.New("Script not found")
+	// ErrScriptTimeout signals that a script was stopped for running longer than it's allowed to.
+	ErrScriptTimeout = errors.New("Script exceeded its time limit")
+	// ErrScriptTooManySteps signals that a script was stopped for taking more steps than it's allowed to.
+	ErrScriptTooManySteps = errors.New("Script exceeded its step limit")
+	// ErrInvalidCommand signals that a custom command can't be registered without a name and a handler.
+	ErrInvalidCommand = errors.New("Invalid command")
+	// ErrCommandExists signals that a custom command with the given name is already registered.
+	ErrCommandExists = errors.New("Command already exists")
+	// ErrCommandNotFound signals that no custom command with the given name is registered.
+	ErrCommandNotFound = errors.New("Command not found")
+	// ErrAuthNotSupported signals that the server's storage doesn't support authentication.
+	ErrAuthNotSupported = errors.New("Authentication is not supported by the storage")
+	// ErrInvalidEvictionPolicy signals that the given eviction policy name isn't one of the supported policies.
+	ErrInvalidEvictionPolicy = errors.New("Invalid eviction policy")
 )